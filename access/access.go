@@ -0,0 +1,172 @@
+// Package access decides what a user may do with an account. The HTTP and the gRPC servers share its rules
+// and map its errors to their own status codes
+package access
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+)
+
+// Code tells why an access was refused
+type Code int
+
+// Constants for the codes of a refused access
+const (
+	// NotFound is returned for an account that doesn't exist
+	NotFound Code = iota + 1
+	// Forbidden is returned when the role of the user doesn't grant the permission
+	Forbidden
+	// Frozen is returned when money would move out of a frozen account
+	Frozen
+	// CoolingOff is returned when the amount is too large for a payee that was saved recently
+	CoolingOff
+)
+
+// Error is an access refused to a user, the transports answer it with the status of its code
+type Error struct {
+	Code    Code
+	Message string
+}
+
+func (err *Error) Error() string {
+	return err.Message
+}
+
+func refuse(code Code, format string, args ...any) error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// Access is what a user may do with an account, TransferLimit caps the amount a member of
+// the organisation owning the account can move in one payment
+type Access struct {
+	Role          string
+	TransferLimit sql.NullInt64
+}
+
+// Exceeds tells if the amount is above the transfer limit of the user
+func (access Access) Exceeds(amount int64) bool {
+	return access.TransferLimit.Valid && amount > access.TransferLimit.Int64
+}
+
+// Resolve loads an account and checks the role of the user grants the permission. The role comes from
+// the organisation membership for the accounts of an organisation and from the account holders otherwise,
+// pots are held by the holders of their parent account and no money can be moved out of a frozen account
+func Resolve(ctx context.Context, store db.Store, userID int64, accountID int64, permission string) (db.Account, Access, error) {
+	var access Access
+	account, err := store.GetAccount(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return account, access, refuse(NotFound, "account %d not found", accountID)
+		}
+		return account, access, err
+	}
+
+	if account.OrganisationID.Valid {
+		member, err := store.GetOrganisationMember(ctx, db.GetOrganisationMemberParams{
+			OrganisationID: account.OrganisationID.Int64,
+			UserID:         userID,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return account, access, refuse(Forbidden,
+					"account %d doesn't belong to an organisation of the authenticated user", accountID)
+			}
+			return account, access, err
+		}
+
+		access = Access{Role: member.Role, TransferLimit: member.TransferLimit}
+		if !util.MemberCan(member.Role, permission) {
+			return account, access, refuse(Forbidden,
+				"a %s of organisation %d doesn't have the %s permission", member.Role, member.OrganisationID, permission)
+		}
+	} else {
+		heldID := accountID
+		if account.ParentID.Valid {
+			heldID = account.ParentID.Int64
+		}
+
+		holder, err := store.GetAccountHolder(ctx, db.GetAccountHolderParams{AccountID: heldID, UserID: userID})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return account, access, refuse(Forbidden, "account %d doesn't belong to the authenticated user", accountID)
+			}
+			return account, access, err
+		}
+
+		access = Access{Role: holder.Role}
+		if !util.HolderCan(holder.Role, permission) {
+			return account, access, refuse(Forbidden,
+				"a %s of account %d doesn't have the %s permission", holder.Role, accountID, permission)
+		}
+	}
+
+	if permission == util.AccountPermissionTransact && account.FrozenAt.Valid {
+		return account, access, refuse(Frozen, "account %d is frozen", accountID)
+	}
+	return account, access, nil
+}
+
+// HoldTransfer records a transfer from an organisation account above the transfer limit of its initiator,
+// nothing is moved until another member of the organisation approves it
+func HoldTransfer(ctx context.Context, store db.Store, initiatorID int64, from db.Account, arg db.TransferTxParams) (db.TransferApproval, error) {
+	metadata := arg.Metadata
+	if len(metadata) == 0 {
+		metadata = json.RawMessage(`{}`)
+	}
+
+	return store.CreateTransferApproval(ctx, db.CreateTransferApprovalParams{
+		OrganisationID: from.OrganisationID.Int64,
+		FromAccountID:  arg.FromAccountID,
+		ToAccountID:    arg.ToAccountID,
+		Amount:         arg.Amount,
+		Description:    arg.Description,
+		Reference:      arg.Reference,
+		Metadata:       metadata,
+		InitiatorID:    initiatorID,
+	})
+}
+
+// Destination is where a debit sends the money, an account of the bank or an external beneficiary
+type Destination struct {
+	AccountID     int64
+	BeneficiaryID int64
+}
+
+// CoolingOffPolicy limits the amount a user can send to a destination during Period after saving it as a payee
+type CoolingOffPolicy struct {
+	Period time.Duration
+	Limit  int64
+}
+
+// Check refuses an amount above the limit when the newest payee the user saved for the destination is younger
+// than the cooling-off period, whatever the debit goes through: a payee, an account id or a beneficiary id
+func (policy CoolingOffPolicy) Check(ctx context.Context, store db.Store, userID int64, destination Destination, amount int64) error {
+	if amount <= policy.Limit || destination == (Destination{}) {
+		return nil
+	}
+
+	payee, err := store.GetNewestPayeeOfDestination(ctx, db.GetNewestPayeeOfDestinationParams{
+		UserID:        userID,
+		AccountID:     sql.NullInt64{Int64: destination.AccountID, Valid: destination.AccountID != 0},
+		BeneficiaryID: sql.NullInt64{Int64: destination.BeneficiaryID, Valid: destination.BeneficiaryID != 0},
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	if time.Since(payee.CreatedAt) >= policy.Period {
+		return nil
+	}
+	return refuse(CoolingOff, "payee %d was added less than %s ago, transfers to it are limited to %d until %s",
+		payee.ID, policy.Period, policy.Limit, payee.CreatedAt.Add(policy.Period).UTC().Format(time.RFC3339))
+}
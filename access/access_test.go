@@ -0,0 +1,254 @@
+package access
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResolve(t *testing.T) {
+	const userID = 7
+	customer := db.Account{ID: 1, UserID: userID, Kind: util.AccountKindCustomer, Currency: util.USD}
+	pot := db.Account{ID: 2, UserID: userID, Kind: util.AccountKindPot, Currency: util.USD, ParentID: sql.NullInt64{Int64: 1, Valid: true}}
+	frozen := customer
+	frozen.FrozenAt = sql.NullTime{Time: time.Now(), Valid: true}
+	corporate := db.Account{ID: 3, Kind: util.AccountKindCustomer, Currency: util.USD, OrganisationID: sql.NullInt64{Int64: 9, Valid: true}}
+	limit := sql.NullInt64{Int64: 500, Valid: true}
+
+	testCases := []struct {
+		name           string
+		account        db.Account
+		accountErr     error
+		permission     string
+		setupHolders   func(store *mocks.MockStore)
+		expectedCode   Code
+		expectedErr    bool
+		expectedAccess Access
+	}{
+		{
+			name:       "holder",
+			account:    customer,
+			permission: util.AccountPermissionTransact,
+			setupHolders: func(store *mocks.MockStore) {
+				store.EXPECT().
+					GetAccountHolder(gomock.Any(), db.GetAccountHolderParams{AccountID: 1, UserID: userID}).
+					Return(db.AccountHolder{Role: util.AccountRoleOwner}, nil)
+			},
+			expectedAccess: Access{Role: util.AccountRoleOwner},
+		},
+		{
+			name:       "pot held through its parent",
+			account:    pot,
+			permission: util.AccountPermissionView,
+			setupHolders: func(store *mocks.MockStore) {
+				store.EXPECT().
+					GetAccountHolder(gomock.Any(), db.GetAccountHolderParams{AccountID: 1, UserID: userID}).
+					Return(db.AccountHolder{Role: util.AccountRoleViewer}, nil)
+			},
+			expectedAccess: Access{Role: util.AccountRoleViewer},
+		},
+		{
+			name:       "organisation member with a limit",
+			account:    corporate,
+			permission: util.AccountPermissionTransact,
+			setupHolders: func(store *mocks.MockStore) {
+				store.EXPECT().
+					GetOrganisationMember(gomock.Any(), db.GetOrganisationMemberParams{OrganisationID: 9, UserID: userID}).
+					Return(db.OrganisationMember{OrganisationID: 9, Role: util.OrganisationRoleInitiator, TransferLimit: limit}, nil)
+				store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedAccess: Access{Role: util.OrganisationRoleInitiator, TransferLimit: limit},
+		},
+		{
+			name:       "not a member",
+			account:    corporate,
+			permission: util.AccountPermissionView,
+			setupHolders: func(store *mocks.MockStore) {
+				store.EXPECT().
+					GetOrganisationMember(gomock.Any(), gomock.Any()).
+					Return(db.OrganisationMember{}, sql.ErrNoRows)
+			},
+			expectedCode: Forbidden,
+		},
+		{
+			name:       "not a holder",
+			account:    customer,
+			permission: util.AccountPermissionView,
+			setupHolders: func(store *mocks.MockStore) {
+				store.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Any()).
+					Return(db.AccountHolder{}, sql.ErrNoRows)
+			},
+			expectedCode: Forbidden,
+		},
+		{
+			name:       "role without the permission",
+			account:    customer,
+			permission: util.AccountPermissionTransact,
+			setupHolders: func(store *mocks.MockStore) {
+				store.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Any()).
+					Return(db.AccountHolder{Role: util.AccountRoleViewer}, nil)
+			},
+			expectedCode: Forbidden,
+		},
+		{
+			name:       "frozen",
+			account:    frozen,
+			permission: util.AccountPermissionTransact,
+			setupHolders: func(store *mocks.MockStore) {
+				store.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Any()).
+					Return(db.AccountHolder{Role: util.AccountRoleOwner}, nil)
+			},
+			expectedCode: Frozen,
+		},
+		{
+			name:       "frozen can be viewed",
+			account:    frozen,
+			permission: util.AccountPermissionView,
+			setupHolders: func(store *mocks.MockStore) {
+				store.EXPECT().
+					GetAccountHolder(gomock.Any(), gomock.Any()).
+					Return(db.AccountHolder{Role: util.AccountRoleOwner}, nil)
+			},
+			expectedAccess: Access{Role: util.AccountRoleOwner},
+		},
+		{
+			name:         "not found",
+			accountErr:   sql.ErrNoRows,
+			permission:   util.AccountPermissionView,
+			setupHolders: func(store *mocks.MockStore) {},
+			expectedCode: NotFound,
+		},
+		{
+			name:         "internal error",
+			accountErr:   sql.ErrConnDone,
+			permission:   util.AccountPermissionView,
+			setupHolders: func(store *mocks.MockStore) {},
+			expectedErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mocks.NewMockStore(ctrl)
+			accountID := tc.account.ID
+			if accountID == 0 {
+				accountID = 1
+			}
+			store.EXPECT().GetAccount(gomock.Any(), accountID).Return(tc.account, tc.accountErr)
+			tc.setupHolders(store)
+
+			account, access, err := Resolve(context.Background(), store, userID, accountID, tc.permission)
+			switch {
+			case tc.expectedCode != 0:
+				var accessErr *Error
+				require.True(t, errors.As(err, &accessErr))
+				require.Equal(t, tc.expectedCode, accessErr.Code)
+			case tc.expectedErr:
+				require.Error(t, err)
+				require.False(t, errors.As(err, new(*Error)))
+			default:
+				require.NoError(t, err)
+				require.Equal(t, tc.account, account)
+				require.Equal(t, tc.expectedAccess, access)
+			}
+		})
+	}
+}
+
+func TestAccessExceeds(t *testing.T) {
+	require.False(t, Access{}.Exceeds(1_000_000))
+
+	limited := Access{TransferLimit: sql.NullInt64{Int64: 500, Valid: true}}
+	require.False(t, limited.Exceeds(500))
+	require.True(t, limited.Exceeds(501))
+}
+
+func TestHoldTransfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	from := db.Account{ID: 3, OrganisationID: sql.NullInt64{Int64: 9, Valid: true}}
+	arg := db.TransferTxParams{FromAccountID: 3, ToAccountID: 4, Amount: 900, Description: "rent", Reference: "INV-42"}
+
+	store := mocks.NewMockStore(ctrl)
+	store.EXPECT().
+		CreateTransferApproval(gomock.Any(), db.CreateTransferApprovalParams{
+			OrganisationID: 9,
+			FromAccountID:  3,
+			ToAccountID:    4,
+			Amount:         900,
+			Description:    "rent",
+			Reference:      "INV-42",
+			Metadata:       json.RawMessage(`{}`),
+			InitiatorID:    7,
+		}).
+		Return(db.TransferApproval{ID: 1}, nil)
+
+	approval, err := HoldTransfer(context.Background(), store, 7, from, arg)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), approval.ID)
+}
+
+func TestCoolingOffPolicyCheck(t *testing.T) {
+	policy := CoolingOffPolicy{Period: 24 * time.Hour, Limit: 1000}
+	destination := Destination{AccountID: 2}
+	arg := db.GetNewestPayeeOfDestinationParams{UserID: 7, AccountID: sql.NullInt64{Int64: 2, Valid: true}}
+
+	testCases := []struct {
+		name         string
+		destination  Destination
+		amount       int64
+		payee        db.Payee
+		payeeErr     error
+		payeeTimes   int
+		expectedCode Code
+		expectedErr  bool
+	}{
+		{name: "amount within the limit", destination: destination, amount: 1000},
+		{name: "no destination", amount: 5000},
+		{name: "not a payee", destination: destination, amount: 5000, payeeErr: sql.ErrNoRows, payeeTimes: 1},
+		{name: "old payee", destination: destination, amount: 5000, payee: db.Payee{ID: 3, CreatedAt: time.Now().Add(-48 * time.Hour)}, payeeTimes: 1},
+		{name: "new payee", destination: destination, amount: 5000, payee: db.Payee{ID: 3, CreatedAt: time.Now().Add(-time.Hour)}, payeeTimes: 1, expectedCode: CoolingOff},
+		{name: "db error", destination: destination, amount: 5000, payeeErr: sql.ErrConnDone, payeeTimes: 1, expectedErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mocks.NewMockStore(ctrl)
+			store.EXPECT().
+				GetNewestPayeeOfDestination(gomock.Any(), arg).
+				Return(tc.payee, tc.payeeErr).
+				Times(tc.payeeTimes)
+
+			err := policy.Check(context.Background(), store, 7, tc.destination, tc.amount)
+			switch {
+			case tc.expectedCode != 0:
+				var accessErr *Error
+				require.True(t, errors.As(err, &accessErr))
+				require.Equal(t, tc.expectedCode, accessErr.Code)
+			case tc.expectedErr:
+				require.Error(t, err)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,18 +1,30 @@
 package api
 
 import (
-	"database/sql"
+	"errors"
+	"fmt"
 	"net/http"
 
+	"github.com/Sinothic/simplebank/access"
 	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
 	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
 )
 
+// createAccountRequest opens an account owned by the authenticated user, a user owns
+// at most one account per currency but can hold more as a co-owner or viewer
 type createAccountRequest struct {
-	UserID   int64  `json:"user_id" binding:"required"`
 	Currency string `json:"currency" binding:"required,currency"`
 }
 
+/*
+createAccount opens an empty account owned by the authenticated user
+
+Path: POST /accounts
+
+Body createAccountRequest
+*/
 func (server *Server) createAccount(ctx *gin.Context) {
 	var req createAccountRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -20,25 +32,41 @@ func (server *Server) createAccount(ctx *gin.Context) {
 		return
 	}
 
-	arg := db.CreateAccountParams{
-		UserID:   req.UserID,
+	result, err := server.store.CreateAccountTx(ctx, db.CreateAccountTxParams{
+		UserID:   authPayload(ctx).UserID,
 		Currency: req.Currency,
-		Balance:  0,
-	}
-
-	account, err := server.store.CreateAccount(ctx, arg)
+	})
 	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation" {
+			err := fmt.Errorf("you already own a %s account", req.Currency)
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, account)
+	ctx.JSON(http.StatusOK, result.Account)
+}
+
+// accountResponse adds up the balance of the pots of an account to its own balance
+type accountResponse struct {
+	db.Account
+	PotsBalance  int64 `json:"pots_balance"`
+	TotalBalance int64 `json:"total_balance"`
 }
 
 type getAccountRequest struct {
 	ID int64 `uri:"id" binding:"required,min=1"`
 }
 
+/*
+getAccount returns an account held by the authenticated user with the total balance of its pots
+
+Path: GET /accounts/:id
+*/
 func (server *Server) getAccount(ctx *gin.Context) {
 	var req getAccountRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
@@ -46,18 +74,24 @@ func (server *Server) getAccount(ctx *gin.Context) {
 		return
 	}
 
-	account, err := server.store.GetAccount(ctx, req.ID)
-	if err != nil {
-		if err.Error() == sql.ErrNoRows.Error() {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
+	account, ok := server.heldAccount(ctx, req.ID, util.AccountPermissionView)
+	if !ok {
+		return
+	}
+
+	rsp := accountResponse{Account: account, TotalBalance: account.Balance}
+	if account.Kind == util.AccountKindCustomer {
+		potsBalance, err := server.store.SumPotBalances(ctx, account.ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 			return
 		}
 
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
-		return
+		rsp.PotsBalance = potsBalance
+		rsp.TotalBalance += potsBalance
 	}
 
-	ctx.JSON(http.StatusOK, account)
+	ctx.JSON(http.StatusOK, rsp)
 }
 
 type deleteAccountRequest struct {
@@ -65,7 +99,7 @@ type deleteAccountRequest struct {
 }
 
 /*
-deleteAccount deletes an account by id
+deleteAccount deletes an account owned by the authenticated user
 
 Path: DELETE /accounts/:id
 */
@@ -76,6 +110,10 @@ func (server *Server) deleteAccount(ctx *gin.Context) {
 		return
 	}
 
+	if _, ok := server.heldAccount(ctx, req.ID, util.AccountPermissionManage); !ok {
+		return
+	}
+
 	err := server.store.DeleteAccount(ctx, req.ID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
@@ -90,18 +128,24 @@ type listAccountRequest struct {
 	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
 }
 
+/*
+listAccount lists the accounts the authenticated user owns, co-owns or views
+
+Path: GET /accounts
+*/
 func (server *Server) listAccount(ctx *gin.Context) {
 	var req listAccountRequest
 	if err := ctx.ShouldBindQuery(&req); err != nil {
 		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return
 	}
-	arg := db.ListAccountsParams{
+	arg := db.ListHeldAccountsParams{
+		UserID: authPayload(ctx).UserID,
 		Limit:  req.PageSize,
 		Offset: (req.PageID - 1) * req.PageSize,
 	}
 
-	account, err := server.store.ListAccounts(ctx, arg)
+	account, err := server.store.ListHeldAccounts(ctx, arg)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
@@ -109,3 +153,84 @@ func (server *Server) listAccount(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, account)
 }
+
+// heldAccount loads an account the authenticated user holds with a role granting the permission,
+// writing the error response and returning false otherwise
+func (server *Server) heldAccount(ctx *gin.Context, accountID int64, permission string) (db.Account, bool) {
+	account, _, ok := server.accessAccount(ctx, accountID, permission)
+	return account, ok
+}
+
+// debitAccount loads an account the authenticated user can move the amount out of to the destination,
+// the destination is empty when the money leaves to an account that can't be saved as a payee.
+// Only transfers can wait for an approval: withdrawals, outbound payments and accepted payment requests
+// above the transfer limit of an organisation member are refused
+func (server *Server) debitAccount(ctx *gin.Context, accountID int64, destination access.Destination, amount int64) (db.Account, bool) {
+	account, accountAccess, ok := server.accessAccount(ctx, accountID, util.AccountPermissionTransact)
+	if !ok {
+		return account, false
+	}
+
+	if !server.checkCoolingOff(ctx, destination, amount) {
+		return account, false
+	}
+
+	if !checkTransferLimit(ctx, accountAccess, amount) {
+		return account, false
+	}
+
+	return account, true
+}
+
+// checkTransferLimit refuses a debit that can't be held for an approval when it is above the transfer limit
+// of the authenticated user, writing the error response and returning false
+func checkTransferLimit(ctx *gin.Context, accountAccess access.Access, amount int64) bool {
+	if accountAccess.Exceeds(amount) {
+		err := fmt.Errorf("amount %d exceeds your transfer limit of %d, only transfers can wait for an approval",
+			amount, accountAccess.TransferLimit.Int64)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return false
+	}
+
+	return true
+}
+
+// checkCoolingOff refuses an amount too large for a destination the authenticated user saved as a payee recently,
+// writing the error response and returning false
+func (server *Server) checkCoolingOff(ctx *gin.Context, destination access.Destination, amount int64) bool {
+	policy := access.CoolingOffPolicy{Period: server.config.PayeeCoolingOffPeriod, Limit: server.config.PayeeCoolingOffLimit}
+	if err := policy.Check(ctx, server.store, authPayload(ctx).UserID, destination, amount); err != nil {
+		accessErrorResponse(ctx, err)
+		return false
+	}
+
+	return true
+}
+
+// accessAccount loads an account and what the authenticated user may do with it, following the rules
+// of access.Resolve, writing the error response and returning false when the access is refused
+func (server *Server) accessAccount(ctx *gin.Context, accountID int64, permission string) (db.Account, access.Access, bool) {
+	account, accountAccess, err := access.Resolve(ctx, server.store, authPayload(ctx).UserID, accountID, permission)
+	if err != nil {
+		accessErrorResponse(ctx, err)
+		return account, accountAccess, false
+	}
+
+	return account, accountAccess, true
+}
+
+// accessErrorResponse answers an access refused by the access rules with the status of its code
+func accessErrorResponse(ctx *gin.Context, err error) {
+	var accessErr *access.Error
+	if !errors.As(err, &accessErr) {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	switch accessErr.Code {
+	case access.NotFound:
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+	default:
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+	}
+}
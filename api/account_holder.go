@@ -0,0 +1,327 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
+)
+
+type accountHolderURI struct {
+	ID     int64 `uri:"id" binding:"required,min=1"`
+	UserID int64 `uri:"user_id" binding:"required,min=1"`
+}
+
+/*
+listAccountHolders lists the holders of an account held by the authenticated user with their role
+
+Path: GET /accounts/:id/holders
+*/
+func (server *Server) listAccountHolders(ctx *gin.Context) {
+	var uri accountURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.heldAccount(ctx, uri.ID, util.AccountPermissionView); !ok {
+		return
+	}
+
+	holders, err := server.store.ListAccountHolders(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, holders)
+}
+
+/*
+deleteAccountHolder removes a co-owner or viewer from an account, the owner removes anyone
+but themselves and the other holders can only remove themselves
+
+Path: DELETE /accounts/:id/holders/:user_id
+*/
+func (server *Server) deleteAccountHolder(ctx *gin.Context) {
+	var uri accountHolderURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	permission := util.AccountPermissionManage
+	if uri.UserID == authPayload(ctx).UserID {
+		permission = util.AccountPermissionView
+	}
+
+	if _, ok := server.heldAccount(ctx, uri.ID, permission); !ok {
+		return
+	}
+
+	holder, err := server.store.GetAccountHolder(ctx, db.GetAccountHolderParams{
+		AccountID: uri.ID,
+		UserID:    uri.UserID,
+	})
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			err := fmt.Errorf("user %d doesn't hold account %d", uri.UserID, uri.ID)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if holder.Role == util.AccountRoleOwner {
+		err := errors.New("the owner of an account can't be removed")
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	err = server.store.DeleteAccountHolder(ctx, db.DeleteAccountHolderParams{
+		AccountID: uri.ID,
+		UserID:    uri.UserID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+type createAccountInvitationRequest struct {
+	Username string `json:"username" binding:"required,alphanum"`
+	Role     string `json:"role" binding:"required,oneof=co_owner viewer"`
+}
+
+/*
+createAccountInvitation invites another user to hold an account owned by the authenticated user,
+the invitee becomes a holder once they accept
+
+Path: POST /accounts/:id/invitations
+
+Body createAccountInvitationRequest
+*/
+func (server *Server) createAccountInvitation(ctx *gin.Context) {
+	var uri accountURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req createAccountInvitationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, ok := server.heldAccount(ctx, uri.ID, util.AccountPermissionManage)
+	if !ok {
+		return
+	}
+
+	// a pot is held by the holders of its parent account, the invitation has to be to the parent
+	if account.Kind != util.AccountKindCustomer {
+		err := fmt.Errorf("account %d is not a customer account", account.ID)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if account.OrganisationID.Valid {
+		err := fmt.Errorf("account %d belongs to organisation %d, add members to the organisation instead", account.ID, account.OrganisationID.Int64)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	invitee, err := server.store.GetUser(ctx, req.Username)
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			err := fmt.Errorf("user %s not found", req.Username)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	_, err = server.store.GetAccountHolder(ctx, db.GetAccountHolderParams{
+		AccountID: uri.ID,
+		UserID:    invitee.ID,
+	})
+	if err == nil {
+		err := fmt.Errorf("user %s already holds account %d", req.Username, uri.ID)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
+	}
+	if err.Error() != sql.ErrNoRows.Error() {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	invitation, err := server.store.CreateAccountInvitation(ctx, db.CreateAccountInvitationParams{
+		AccountID: uri.ID,
+		InviterID: authPayload(ctx).UserID,
+		InviteeID: invitee.ID,
+		Role:      req.Role,
+	})
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation" {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, invitation)
+}
+
+/*
+listAccountInvitations lists the pending invitations sent to the authenticated user
+
+Path: GET /users/me/invitations
+*/
+func (server *Server) listAccountInvitations(ctx *gin.Context) {
+	invitations, err := server.store.ListPendingAccountInvitations(ctx, authPayload(ctx).UserID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, invitations)
+}
+
+type invitationURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+/*
+acceptAccountInvitation makes the authenticated user a holder of the account they were invited to
+
+Path: POST /invitations/:id/accept
+*/
+func (server *Server) acceptAccountInvitation(ctx *gin.Context) {
+	var uri invitationURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.receivedInvitation(ctx, uri.ID); !ok {
+		return
+	}
+
+	result, err := server.store.AcceptAccountInvitationTx(ctx, uri.ID)
+	if err != nil {
+		var pqErr *pq.Error
+		switch {
+		case errors.Is(err, db.ErrInvitationNotPending):
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		case errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation":
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+/*
+declineAccountInvitation refuses an invitation sent to the authenticated user
+
+Path: POST /invitations/:id/decline
+*/
+func (server *Server) declineAccountInvitation(ctx *gin.Context) {
+	var uri invitationURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.receivedInvitation(ctx, uri.ID); !ok {
+		return
+	}
+
+	server.closeAccountInvitation(ctx, uri.ID, util.InvitationStatusDeclined)
+}
+
+/*
+revokeAccountInvitation withdraws a pending invitation to an account owned by the authenticated user
+
+Path: POST /invitations/:id/revoke
+*/
+func (server *Server) revokeAccountInvitation(ctx *gin.Context) {
+	var uri invitationURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	invitation, err := server.store.GetAccountInvitation(ctx, uri.ID)
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			err := fmt.Errorf("invitation %d not found", uri.ID)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.heldAccount(ctx, invitation.AccountID, util.AccountPermissionManage); !ok {
+		return
+	}
+
+	server.closeAccountInvitation(ctx, uri.ID, util.InvitationStatusRevoked)
+}
+
+// closeAccountInvitation moves a pending invitation to a final status
+func (server *Server) closeAccountInvitation(ctx *gin.Context, id int64, status string) {
+	invitation, err := server.store.UpdatePendingAccountInvitationStatus(ctx, db.UpdatePendingAccountInvitationStatusParams{
+		ID:     id,
+		Status: status,
+	})
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			ctx.JSON(http.StatusBadRequest, errorResponse(db.ErrInvitationNotPending))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, invitation)
+}
+
+// receivedInvitation loads an invitation sent to the authenticated user, the invitations of other
+// users are reported as not found
+func (server *Server) receivedInvitation(ctx *gin.Context, id int64) (db.AccountInvitation, bool) {
+	invitation, err := server.store.GetAccountInvitation(ctx, id)
+	if err != nil && err.Error() != sql.ErrNoRows.Error() {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return invitation, false
+	}
+
+	if err != nil || invitation.InviteeID != authPayload(ctx).UserID {
+		err := fmt.Errorf("invitation %d not found", id)
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return invitation, false
+	}
+
+	return invitation, true
+}
@@ -0,0 +1,214 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// expectAccountHolders lets the users of roles, by user id, hold account 1 with the given role
+func expectAccountHolders(store *mocks.MockStore, roles map[int64]string) {
+	store.EXPECT().
+		GetAccount(gomock.Any(), int64(1)).
+		Return(db.Account{ID: 1, UserID: 1, Currency: util.USD, Kind: util.AccountKindCustomer}, nil).
+		AnyTimes()
+	store.EXPECT().
+		GetAccountHolder(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.GetAccountHolderParams) (db.AccountHolder, error) {
+			role, ok := roles[arg.UserID]
+			if !ok || arg.AccountID != 1 {
+				return db.AccountHolder{}, sql.ErrNoRows
+			}
+			return db.AccountHolder{AccountID: arg.AccountID, UserID: arg.UserID, Role: role}, nil
+		}).
+		AnyTimes()
+}
+
+func TestCreateAccountInvitation(t *testing.T) {
+	holders := map[int64]string{1: util.AccountRoleOwner, 2: util.AccountRoleCoOwner, 3: util.AccountRoleViewer}
+
+	testCases := []struct {
+		name               string
+		userID             int64
+		accountID          int64
+		body               string
+		invitee            db.User
+		GetUserTimes       int
+		CreateErr          error
+		CreateTimes        int
+		expectedStatusCode int
+	}{
+		{name: "owner role", userID: 1, body: `{"username":"jane","role":"owner"}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "viewer inviting", userID: 3, body: `{"username":"jane","role":"viewer"}`, expectedStatusCode: http.StatusForbidden},
+		{name: "stranger inviting", userID: 4, body: `{"username":"jane","role":"viewer"}`, expectedStatusCode: http.StatusForbidden},
+		{name: "unknown invitee", userID: 1, body: `{"username":"jane","role":"viewer"}`, GetUserTimes: 1, expectedStatusCode: http.StatusNotFound},
+		{name: "invitee already holding the account", userID: 1, body: `{"username":"jane","role":"viewer"}`, invitee: db.User{ID: 3, Username: "jane"}, GetUserTimes: 1, expectedStatusCode: http.StatusForbidden},
+		{name: "invitee already invited", userID: 1, body: `{"username":"jane","role":"viewer"}`, invitee: db.User{ID: 5, Username: "jane"}, GetUserTimes: 1, CreateErr: &pq.Error{Code: "23505"}, CreateTimes: 1, expectedStatusCode: http.StatusForbidden},
+		{name: "owner inviting a co-owner", userID: 1, body: `{"username":"jane","role":"co_owner"}`, invitee: db.User{ID: 5, Username: "jane"}, GetUserTimes: 1, CreateTimes: 1, expectedStatusCode: http.StatusOK},
+		{name: "pot", userID: 1, accountID: 2, body: `{"username":"jane","role":"viewer"}`, expectedStatusCode: http.StatusBadRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			getUserErr := error(nil)
+			if tc.invitee.ID == 0 {
+				getUserErr = sql.ErrNoRows
+			}
+
+			accountID := tc.accountID
+			if accountID == 0 {
+				accountID = 1
+			}
+
+			mockStore := mocks.NewMockStore(ctrl)
+			expectAccountHolders(mockStore, holders)
+			mockStore.EXPECT().
+				GetAccount(gomock.Any(), int64(2)).
+				Return(db.Account{ID: 2, UserID: 1, Currency: util.USD, Kind: util.AccountKindPot, ParentID: sql.NullInt64{Int64: 1, Valid: true}}, nil).
+				AnyTimes()
+			mockStore.EXPECT().
+				GetUser(gomock.Any(), "jane").
+				Return(tc.invitee, getUserErr).
+				Times(tc.GetUserTimes)
+			mockStore.EXPECT().
+				CreateAccountInvitation(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.CreateAccountInvitationParams) (db.AccountInvitation, error) {
+					require.Equal(t, int64(1), arg.AccountID)
+					require.Equal(t, tc.userID, arg.InviterID)
+					require.Equal(t, tc.invitee.ID, arg.InviteeID)
+					return db.AccountInvitation{ID: 8, AccountID: arg.AccountID, InviteeID: arg.InviteeID, Role: arg.Role, Status: util.InvitationStatusPending}, tc.CreateErr
+				}).
+				Times(tc.CreateTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/accounts/%d/invitations", accountID)
+			request, err := http.NewRequest(http.MethodPost, url, strings.NewReader(tc.body))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.userID, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestAnswerAccountInvitation(t *testing.T) {
+	invitation := db.AccountInvitation{ID: 8, AccountID: 1, InviterID: 1, InviteeID: 5, Role: util.AccountRoleCoOwner, Status: util.InvitationStatusPending}
+
+	testCases := []struct {
+		name               string
+		action             string
+		userID             int64
+		AcceptErr          error
+		AcceptTimes        int
+		UpdateErr          error
+		UpdateTimes        int
+		expectedStatus     string
+		expectedStatusCode int
+	}{
+		{name: "stranger accepting", action: "accept", userID: 4, expectedStatusCode: http.StatusNotFound},
+		{name: "accept", action: "accept", userID: 5, AcceptTimes: 1, expectedStatusCode: http.StatusOK},
+		{name: "accept answered invitation", action: "accept", userID: 5, AcceptErr: db.ErrInvitationNotPending, AcceptTimes: 1, expectedStatusCode: http.StatusBadRequest},
+		{name: "inviter declining", action: "decline", userID: 1, expectedStatusCode: http.StatusNotFound},
+		{name: "decline", action: "decline", userID: 5, UpdateTimes: 1, expectedStatus: util.InvitationStatusDeclined, expectedStatusCode: http.StatusOK},
+		{name: "invitee revoking", action: "revoke", userID: 5, expectedStatusCode: http.StatusForbidden},
+		{name: "co-owner revoking", action: "revoke", userID: 2, expectedStatusCode: http.StatusForbidden},
+		{name: "revoke", action: "revoke", userID: 1, UpdateTimes: 1, expectedStatus: util.InvitationStatusRevoked, expectedStatusCode: http.StatusOK},
+		{name: "revoke answered invitation", action: "revoke", userID: 1, UpdateErr: sql.ErrNoRows, UpdateTimes: 1, expectedStatus: util.InvitationStatusRevoked, expectedStatusCode: http.StatusBadRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			expectAccountHolders(mockStore, map[int64]string{1: util.AccountRoleOwner, 2: util.AccountRoleCoOwner})
+			mockStore.EXPECT().
+				GetAccountInvitation(gomock.Any(), invitation.ID).
+				Return(invitation, nil).
+				Times(1)
+			mockStore.EXPECT().
+				AcceptAccountInvitationTx(gomock.Any(), invitation.ID).
+				Return(db.AcceptAccountInvitationTxResult{}, tc.AcceptErr).
+				Times(tc.AcceptTimes)
+			mockStore.EXPECT().
+				UpdatePendingAccountInvitationStatus(gomock.Any(), db.UpdatePendingAccountInvitationStatusParams{ID: invitation.ID, Status: tc.expectedStatus}).
+				Return(db.AccountInvitation{}, tc.UpdateErr).
+				Times(tc.UpdateTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/invitations/%d/%s", invitation.ID, tc.action)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.userID, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestDeleteAccountHolder(t *testing.T) {
+	holders := map[int64]string{1: util.AccountRoleOwner, 2: util.AccountRoleCoOwner, 3: util.AccountRoleViewer}
+
+	testCases := []struct {
+		name               string
+		userID             int64
+		holderID           int64
+		DeleteTimes        int
+		expectedStatusCode int
+	}{
+		{name: "owner removing a viewer", userID: 1, holderID: 3, DeleteTimes: 1, expectedStatusCode: http.StatusOK},
+		{name: "owner leaving", userID: 1, holderID: 1, expectedStatusCode: http.StatusBadRequest},
+		{name: "co-owner removing the owner", userID: 2, holderID: 1, expectedStatusCode: http.StatusForbidden},
+		{name: "viewer removing a co-owner", userID: 3, holderID: 2, expectedStatusCode: http.StatusForbidden},
+		{name: "viewer leaving", userID: 3, holderID: 3, DeleteTimes: 1, expectedStatusCode: http.StatusOK},
+		{name: "stranger leaving", userID: 4, holderID: 4, expectedStatusCode: http.StatusForbidden},
+		{name: "owner removing a non holder", userID: 1, holderID: 4, expectedStatusCode: http.StatusNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			expectAccountHolders(mockStore, holders)
+			mockStore.EXPECT().
+				DeleteAccountHolder(gomock.Any(), db.DeleteAccountHolderParams{AccountID: 1, UserID: tc.holderID}).
+				Return(nil).
+				Times(tc.DeleteTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/accounts/1/holders/%d", tc.holderID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.userID, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
@@ -7,11 +7,14 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	db "github.com/Sinothic/simplebank/db/sqlc"
 	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -29,6 +32,7 @@ func TestGetAccountApi(t *testing.T) {
 	testCases := []struct {
 		name               string
 		GetAccount         apiTest[int64, db.Account]
+		roles              map[int64]string
 		expectedStatusCode int
 	}{
 		{
@@ -54,6 +58,15 @@ func TestGetAccountApi(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name: "account held by another user",
+			GetAccount: apiTest[int64, db.Account]{
+				Argument: 1,
+				Response: randomAccount,
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
 		{
 			name: "successful request",
 			GetAccount: apiTest[int64, db.Account]{
@@ -61,6 +74,17 @@ func TestGetAccountApi(t *testing.T) {
 				Response: randomAccount,
 				Times:    1,
 			},
+			roles:              map[int64]string{1: util.AccountRoleOwner},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "successful request by a viewer",
+			GetAccount: apiTest[int64, db.Account]{
+				Argument: 1,
+				Response: randomAccount,
+				Times:    1,
+			},
+			roles:              map[int64]string{1: util.AccountRoleViewer},
 			expectedStatusCode: http.StatusOK,
 		},
 	}
@@ -75,21 +99,27 @@ func TestGetAccountApi(t *testing.T) {
 				GetAccount(gomock.Any(), tc.GetAccount.Argument).
 				Return(tc.GetAccount.Response, tc.GetAccount.Err).
 				Times(tc.GetAccount.Times)
+			expectHolders(mockStore, tc.roles)
+			mockStore.EXPECT().
+				SumPotBalances(gomock.Any(), tc.GetAccount.Argument).
+				Return(int64(300), nil).
+				AnyTimes()
 
-			server := NewServer(mockStore)
+			server := newTestServer(t, mockStore)
 			recorder := httptest.NewRecorder()
 
 			url := fmt.Sprintf("/accounts/%d", tc.GetAccount.Argument)
 			request, err := http.NewRequest(http.MethodGet, url, nil)
 			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
 
 			server.router.ServeHTTP(recorder, request)
 			require.Equal(t, tc.expectedStatusCode, recorder.Code)
-			if tc.GetAccount.Response != (db.Account{}) {
+			if tc.expectedStatusCode == http.StatusOK {
 				body, err := ioutil.ReadAll(recorder.Body)
 				require.NoError(t, err)
 
-				var response db.Account
+				var response accountResponse
 				err = json.Unmarshal(body, &response)
 				require.NoError(t, err)
 
@@ -98,6 +128,8 @@ func TestGetAccountApi(t *testing.T) {
 				require.Equal(t, tc.GetAccount.Response.Balance, response.Balance)
 				require.Equal(t, tc.GetAccount.Response.Currency, response.Currency)
 				require.WithinDuration(t, tc.GetAccount.Response.CreatedAt, response.CreatedAt, time.Second)
+				require.Equal(t, int64(300), response.PotsBalance)
+				require.Equal(t, tc.GetAccount.Response.Balance+300, response.TotalBalance)
 
 			}
 
@@ -105,12 +137,90 @@ func TestGetAccountApi(t *testing.T) {
 	}
 }
 
+func TestCreateAccountApi(t *testing.T) {
+	testCases := []struct {
+		name               string
+		body               string
+		err                error
+		times              int
+		expectedStatusCode int
+	}{
+		{name: "unsupported currency", body: `{"currency":"JPY"}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "second account in the currency", body: `{"currency":"USD"}`, err: &pq.Error{Code: "23505"}, times: 1, expectedStatusCode: http.StatusForbidden},
+		{name: "successful request", body: `{"currency":"USD"}`, times: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				CreateAccountTx(gomock.Any(), db.CreateAccountTxParams{UserID: 1, Currency: "USD"}).
+				Return(db.CreateAccountTxResult{Account: createRandomAccount()}, tc.err).
+				Times(tc.times)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/accounts", strings.NewReader(tc.body))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestDeleteAccountApi(t *testing.T) {
+	testCases := []struct {
+		name               string
+		role               string
+		times              int
+		expectedStatusCode int
+	}{
+		{name: "co-owner", role: util.AccountRoleCoOwner, expectedStatusCode: http.StatusForbidden},
+		{name: "owner", role: util.AccountRoleOwner, times: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				GetAccount(gomock.Any(), int64(1)).
+				Return(createRandomAccount(), nil).
+				Times(1)
+			expectHolders(mockStore, map[int64]string{1: tc.role})
+			mockStore.EXPECT().
+				DeleteAccount(gomock.Any(), int64(1)).
+				Return(nil).
+				Times(tc.times)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodDelete, "/accounts/1", nil)
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 2, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
+
 func createRandomAccount() db.Account {
 	return db.Account{
 		ID:        1,
 		UserID:    1,
 		Balance:   544,
 		Currency:  "USD",
+		Kind:      util.AccountKindCustomer,
 		CreatedAt: time.Now(),
 	}
 }
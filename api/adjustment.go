@@ -0,0 +1,74 @@
+package api
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/gin-gonic/gin"
+)
+
+type createAdjustmentURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type createAdjustmentRequest struct {
+	Amount        int64  `json:"amount" binding:"required"`
+	ReasonCode    string `json:"reason_code" binding:"required,reason_code"`
+	Justification string `json:"justification" binding:"required,min=10,max=1000"`
+}
+
+/*
+createAdjustment corrects the balance of an account with an entry posted against the suspense account
+
+Path: POST /admin/accounts/:id/adjustments
+
+Body createAdjustmentRequest
+*/
+func (server *Server) createAdjustment(ctx *gin.Context) {
+	var uri createAdjustmentURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req createAdjustmentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, err := server.store.GetAccount(ctx, uri.ID)
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if account.Kind != util.AccountKindCustomer {
+		err := fmt.Errorf("account %d is an internal %s account", account.ID, account.Kind)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	arg := db.AdjustBalanceTxParams{
+		AccountID:     account.ID,
+		Amount:        req.Amount,
+		ReasonCode:    req.ReasonCode,
+		Justification: req.Justification,
+	}
+
+	result, err := server.store.AdjustBalanceTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
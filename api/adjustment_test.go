@@ -0,0 +1,203 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateAdjustment(t *testing.T) {
+
+	type apiTest[A any, R any] struct {
+		Argument A
+		Response R
+		Err      error
+		Times    int
+	}
+
+	validRequest := createAdjustmentRequest{
+		Amount:        -50,
+		ReasonCode:    util.ReasonCorrection,
+		Justification: "reverse duplicated card settlement",
+	}
+
+	testCases := []struct {
+		name               string
+		accountID          int64
+		role               string
+		request            createAdjustmentRequest
+		GetAccount         apiTest[int64, db.Account]
+		AdjustBalanceTx    apiTest[db.AdjustBalanceTxParams, db.AdjustBalanceTxResult]
+		expectedStatusCode int
+	}{
+		{
+			name:               "missing access token",
+			accountID:          1,
+			request:            validRequest,
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:               "support role",
+			accountID:          1,
+			role:               util.UserRoleSupport,
+			request:            validRequest,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:      "unsupported reason code",
+			accountID: 1,
+			role:      util.UserRoleAdmin,
+			request: createAdjustmentRequest{
+				Amount:        10,
+				ReasonCode:    "because",
+				Justification: "reverse duplicated card settlement",
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:      "zero amount",
+			accountID: 1,
+			role:      util.UserRoleAdmin,
+			request: createAdjustmentRequest{
+				ReasonCode:    util.ReasonCorrection,
+				Justification: "reverse duplicated card settlement",
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:      "account not found",
+			accountID: 1,
+			role:      util.UserRoleAdmin,
+			request:   validRequest,
+			GetAccount: apiTest[int64, db.Account]{
+				Err:   sql.ErrNoRows,
+				Times: 1,
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:      "internal account",
+			accountID: 1,
+			role:      util.UserRoleAdmin,
+			request:   validRequest,
+			GetAccount: apiTest[int64, db.Account]{
+				Response: db.Account{ID: 1, Currency: util.USD, Kind: util.AccountKindSuspense},
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:      "error while adjusting the balance",
+			accountID: 1,
+			role:      util.UserRoleAdmin,
+			request:   validRequest,
+			GetAccount: apiTest[int64, db.Account]{
+				Response: db.Account{ID: 1, Currency: util.USD, Kind: util.AccountKindCustomer},
+				Times:    1,
+			},
+			AdjustBalanceTx: apiTest[db.AdjustBalanceTxParams, db.AdjustBalanceTxResult]{
+				Argument: db.AdjustBalanceTxParams{
+					AccountID:     1,
+					Amount:        validRequest.Amount,
+					ReasonCode:    validRequest.ReasonCode,
+					Justification: validRequest.Justification,
+				},
+				Err:   sql.ErrConnDone,
+				Times: 1,
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:      "successfully adjust the balance",
+			accountID: 1,
+			role:      util.UserRoleAdmin,
+			request:   validRequest,
+			GetAccount: apiTest[int64, db.Account]{
+				Response: db.Account{ID: 1, Balance: 100, Currency: util.USD, Kind: util.AccountKindCustomer},
+				Times:    1,
+			},
+			AdjustBalanceTx: apiTest[db.AdjustBalanceTxParams, db.AdjustBalanceTxResult]{
+				Argument: db.AdjustBalanceTxParams{
+					AccountID:     1,
+					Amount:        validRequest.Amount,
+					ReasonCode:    validRequest.ReasonCode,
+					Justification: validRequest.Justification,
+				},
+				Response: db.AdjustBalanceTxResult{
+					Adjustment: db.BalanceAdjustment{
+						ID:                1,
+						AccountID:         1,
+						SuspenseAccountID: 2,
+						AccountEntryID:    1,
+						SuspenseEntryID:   2,
+						Amount:            -50,
+						ReasonCode:        util.ReasonCorrection,
+						Justification:     validRequest.Justification,
+					},
+					Account:         db.Account{ID: 1, Balance: 50, Currency: util.USD, Kind: util.AccountKindCustomer},
+					SuspenseAccount: db.Account{ID: 2, Balance: 50, Currency: util.USD, Kind: util.AccountKindSuspense},
+					AccountEntry:    db.Entry{ID: 1, AccountID: 1, Amount: -50, Metadata: json.RawMessage(`{}`)},
+					SuspenseEntry:   db.Entry{ID: 2, AccountID: 2, Amount: 50, Metadata: json.RawMessage(`{}`)},
+				},
+				Times: 1,
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			expectAdminAuditLogs(mockStore)
+			mockStore.EXPECT().
+				GetAccount(gomock.Any(), tc.accountID).
+				Return(tc.GetAccount.Response, tc.GetAccount.Err).
+				Times(tc.GetAccount.Times)
+
+			mockStore.EXPECT().
+				AdjustBalanceTx(gomock.Any(), tc.AdjustBalanceTx.Argument).
+				Return(tc.AdjustBalanceTx.Response, tc.AdjustBalanceTx.Err).
+				Times(tc.AdjustBalanceTx.Times)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/accounts/%d/adjustments", tc.accountID)
+			bodyJson, err := json.Marshal(tc.request)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(bodyJson))
+			require.NoError(t, err)
+			if tc.role != "" {
+				addRoleAuthorization(t, request, server.tokenMaker, 1, tc.role)
+			}
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+
+			if tc.expectedStatusCode == http.StatusOK {
+				responseBody, err := io.ReadAll(recorder.Body)
+				require.NoError(t, err)
+
+				var response db.AdjustBalanceTxResult
+				err = json.Unmarshal(responseBody, &response)
+				require.NoError(t, err)
+				require.Equal(t, tc.AdjustBalanceTx.Response, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,327 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/gin-gonic/gin"
+)
+
+type searchUsersRequest struct {
+	Query    string `form:"q" binding:"max=100"`
+	PageID   int32  `form:"page_id" binding:"required,min=1"`
+	PageSize int32  `form:"page_size" binding:"required,min=5,max=50"`
+}
+
+/*
+searchUsers finds the users whose username, full name or email contains the query, ignoring case
+
+Path: GET /admin/users?q=smith&page_id=1&page_size=20
+*/
+func (server *Server) searchUsers(ctx *gin.Context) {
+	var req searchUsersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	users, err := server.store.SearchUsers(ctx, db.SearchUsersParams{
+		Query:      req.Query,
+		PageLimit:  req.PageSize,
+		PageOffset: (req.PageID - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rsp := make([]userResponse, 0, len(users))
+	for _, user := range users {
+		rsp = append(rsp, newUserResponse(user))
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+type userURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type updateUserRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=customer support admin"`
+}
+
+/*
+updateUserRole changes the role of another user, it applies from their next login
+
+Path: PUT /admin/users/:id/role
+
+Body updateUserRoleRequest
+*/
+func (server *Server) updateUserRole(ctx *gin.Context) {
+	var uri userURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req updateUserRoleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if uri.ID == authPayload(ctx).UserID {
+		err := errors.New("admins can't change their own role")
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	user, err := server.store.UpdateUserRole(ctx, db.UpdateUserRoleParams{
+		ID:   uri.ID,
+		Role: req.Role,
+	})
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			err := fmt.Errorf("user %d not found", uri.ID)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newUserResponse(user))
+}
+
+// searchAccountsRequest filters the accounts of every user, each filter is optional
+type searchAccountsRequest struct {
+	UserID   int64  `form:"user_id" binding:"omitempty,min=1"`
+	Currency string `form:"currency" binding:"omitempty,currency"`
+	Kind     string `form:"kind" binding:"max=20"`
+	Frozen   bool   `form:"frozen"`
+	PageID   int32  `form:"page_id" binding:"required,min=1"`
+	PageSize int32  `form:"page_size" binding:"required,min=5,max=50"`
+}
+
+/*
+searchAccounts lists the accounts matching the filters, internal accounts included
+
+Path: GET /admin/accounts?user_id=7&currency=EUR&kind=customer&frozen=true&page_id=1&page_size=20
+*/
+func (server *Server) searchAccounts(ctx *gin.Context) {
+	var req searchAccountsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	accounts, err := server.store.SearchAccounts(ctx, db.SearchAccountsParams{
+		UserID:     sql.NullInt64{Int64: req.UserID, Valid: req.UserID != 0},
+		Currency:   sql.NullString{String: req.Currency, Valid: req.Currency != ""},
+		Kind:       sql.NullString{String: req.Kind, Valid: req.Kind != ""},
+		FrozenOnly: req.Frozen,
+		PageLimit:  req.PageSize,
+		PageOffset: (req.PageID - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, accounts)
+}
+
+/*
+adminListAccountEntries lists the entries of any account matching the search filters
+
+Path: GET /admin/accounts/:id/entries?page_id=1&page_size=10&reference=INV-42&q=rent&metadata[order_id]=42
+*/
+func (server *Server) adminListAccountEntries(ctx *gin.Context) {
+	uri, req, metadata, ok := bindSearch(ctx)
+	if !ok {
+		return
+	}
+
+	if _, ok := server.existingAccount(ctx, uri.ID); !ok {
+		return
+	}
+
+	server.searchAccountEntries(ctx, uri.ID, req, metadata)
+}
+
+type freezeAccountRequest struct {
+	Reason string `json:"reason" binding:"required,max=200,description"`
+}
+
+/*
+freezeAccount stops any money from moving out of a customer account and its pots
+
+Path: POST /admin/accounts/:id/freeze
+
+Body freezeAccountRequest
+*/
+func (server *Server) freezeAccount(ctx *gin.Context) {
+	var uri accountURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req freezeAccountRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, ok := server.existingAccount(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	if account.Kind != util.AccountKindCustomer {
+		err := fmt.Errorf("account %d is not a customer account", account.ID)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	accounts, err := server.store.FreezeAccount(ctx, db.FreezeAccountParams{
+		ID:     account.ID,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, accounts)
+}
+
+/*
+unfreezeAccount lets money move out of a frozen customer account and its pots again
+
+Path: POST /admin/accounts/:id/unfreeze
+*/
+func (server *Server) unfreezeAccount(ctx *gin.Context) {
+	var uri accountURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, ok := server.existingAccount(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	if !account.FrozenAt.Valid {
+		err := fmt.Errorf("account %d is not frozen", account.ID)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	accounts, err := server.store.UnfreezeAccount(ctx, account.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, accounts)
+}
+
+type listAdminAuditLogsRequest struct {
+	ActorID  int64 `form:"actor_id" binding:"omitempty,min=1"`
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=50"`
+}
+
+/*
+listAdminAuditLogs lists the calls to the back office, most recent first
+
+Path: GET /admin/audit-logs?actor_id=3&page_id=1&page_size=20
+*/
+func (server *Server) listAdminAuditLogs(ctx *gin.Context) {
+	var req listAdminAuditLogsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	logs, err := server.store.ListAdminAuditLogs(ctx, db.ListAdminAuditLogsParams{
+		ActorID:    sql.NullInt64{Int64: req.ActorID, Valid: req.ActorID != 0},
+		PageLimit:  req.PageSize,
+		PageOffset: (req.PageID - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, logs)
+}
+
+// existingAccount loads any account for the back office
+func (server *Server) existingAccount(ctx *gin.Context, id int64) (db.Account, bool) {
+	account, err := server.store.GetAccount(ctx, id)
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return account, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return account, false
+	}
+
+	return account, true
+}
+
+// listAuditEventsRequest filters the audit events, each filter is optional and From and To bound their creation time
+type listAuditEventsRequest struct {
+	ActorID      int64     `form:"actor_id" binding:"omitempty,min=1"`
+	Action       string    `form:"action" binding:"omitempty,oneof=insert update delete"`
+	ResourceType string    `form:"resource_type" binding:"max=63"`
+	ResourceID   string    `form:"resource_id" binding:"max=63"`
+	RequestID    string    `form:"request_id" binding:"max=64"`
+	From         time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To           time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	PageID       int32     `form:"page_id" binding:"required,min=1"`
+	PageSize     int32     `form:"page_size" binding:"required,min=5,max=50"`
+}
+
+/*
+listAuditEvents lists the changes made to the database with the request they were made for, most recent first
+
+Path: GET /admin/audit-events?resource_type=accounts&resource_id=1&action=update&from=2024-05-01T00:00:00Z&page_id=1&page_size=20
+*/
+func (server *Server) listAuditEvents(ctx *gin.Context) {
+	var req listAuditEventsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	events, err := server.store.ListAuditEvents(ctx, db.ListAuditEventsParams{
+		ActorID:      sql.NullInt64{Int64: req.ActorID, Valid: req.ActorID != 0},
+		Action:       sql.NullString{String: req.Action, Valid: req.Action != ""},
+		ResourceType: sql.NullString{String: req.ResourceType, Valid: req.ResourceType != ""},
+		ResourceID:   sql.NullString{String: req.ResourceID, Valid: req.ResourceID != ""},
+		RequestID:    sql.NullString{String: req.RequestID, Valid: req.RequestID != ""},
+		FromTime:     sql.NullTime{Time: req.From, Valid: !req.From.IsZero()},
+		ToTime:       sql.NullTime{Time: req.To, Valid: !req.To.IsZero()},
+		PageLimit:    req.PageSize,
+		PageOffset:   (req.PageID - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, events)
+}
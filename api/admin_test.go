@@ -0,0 +1,280 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAdminRoles(t *testing.T) {
+	testCases := []struct {
+		name               string
+		role               string
+		path               string
+		SearchTimes        int
+		ListLogsTimes      int
+		expectedStatusCode int
+	}{
+		{name: "customer", role: util.UserRoleCustomer, path: "/admin/users?page_id=1&page_size=5", expectedStatusCode: http.StatusForbidden},
+		{name: "support searches users", role: util.UserRoleSupport, path: "/admin/users?q=smith&page_id=1&page_size=5", SearchTimes: 1, expectedStatusCode: http.StatusOK},
+		{name: "support views the audit logs", role: util.UserRoleSupport, path: "/admin/audit-logs?page_id=1&page_size=5", expectedStatusCode: http.StatusForbidden},
+		{name: "admin views the audit logs", role: util.UserRoleAdmin, path: "/admin/audit-logs?page_id=1&page_size=5", ListLogsTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				CreateAdminAuditLog(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.CreateAdminAuditLogParams) (db.AdminAuditLog, error) {
+					require.Equal(t, int64(3), arg.ActorID)
+					require.Equal(t, tc.path, arg.Path)
+					require.Equal(t, int32(tc.expectedStatusCode), arg.StatusCode)
+					return db.AdminAuditLog{}, nil
+				}).
+				Times(1)
+			mockStore.EXPECT().
+				SearchUsers(gomock.Any(), db.SearchUsersParams{Query: "smith", PageLimit: 5}).
+				Return([]db.User{{ID: 7, Username: "smith", HashedPassword: "secret", Role: util.UserRoleCustomer}}, nil).
+				Times(tc.SearchTimes)
+			mockStore.EXPECT().
+				ListAdminAuditLogs(gomock.Any(), db.ListAdminAuditLogsParams{PageLimit: 5}).
+				Return([]db.AdminAuditLog{}, nil).
+				Times(tc.ListLogsTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, tc.path, nil)
+			require.NoError(t, err)
+			addRoleAuthorization(t, request, server.tokenMaker, 3, tc.role)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			require.NotContains(t, recorder.Body.String(), "secret")
+		})
+	}
+}
+
+func TestFreezeAccount(t *testing.T) {
+	customer := db.Account{ID: 1, UserID: 7, Currency: util.EUR, Kind: util.AccountKindCustomer}
+	suspense := db.Account{ID: 2, UserID: 1, Currency: util.EUR, Kind: util.AccountKindSuspense}
+
+	testCases := []struct {
+		name               string
+		accountID          int64
+		body               string
+		FreezeTimes        int
+		expectedStatusCode int
+	}{
+		{name: "missing reason", accountID: customer.ID, body: `{}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "account not found", accountID: 3, body: `{"reason":"suspected fraud"}`, expectedStatusCode: http.StatusNotFound},
+		{name: "internal account", accountID: suspense.ID, body: `{"reason":"suspected fraud"}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "customer account", accountID: customer.ID, body: `{"reason":"suspected fraud"}`, FreezeTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			expectAdminAuditLogs(mockStore)
+			mockStore.EXPECT().GetAccount(gomock.Any(), customer.ID).Return(customer, nil).AnyTimes()
+			mockStore.EXPECT().GetAccount(gomock.Any(), suspense.ID).Return(suspense, nil).AnyTimes()
+			mockStore.EXPECT().GetAccount(gomock.Any(), int64(3)).Return(db.Account{}, sql.ErrNoRows).AnyTimes()
+			mockStore.EXPECT().
+				FreezeAccount(gomock.Any(), db.FreezeAccountParams{ID: customer.ID, Reason: "suspected fraud"}).
+				Return([]db.Account{{ID: customer.ID, FrozenAt: sql.NullTime{Time: time.Now(), Valid: true}, FrozenReason: "suspected fraud"}}, nil).
+				Times(tc.FreezeTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/accounts/%d/freeze", tc.accountID)
+			request, err := http.NewRequest(http.MethodPost, url, strings.NewReader(tc.body))
+			require.NoError(t, err)
+			addRoleAuthorization(t, request, server.tokenMaker, 3, util.UserRoleSupport)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestUnfreezeAccount(t *testing.T) {
+	frozen := db.Account{ID: 1, UserID: 7, Currency: util.EUR, Kind: util.AccountKindCustomer, FrozenAt: sql.NullTime{Time: time.Now(), Valid: true}}
+	active := db.Account{ID: 2, UserID: 7, Currency: util.USD, Kind: util.AccountKindCustomer}
+
+	testCases := []struct {
+		name               string
+		role               string
+		accountID          int64
+		UnfreezeTimes      int
+		expectedStatusCode int
+	}{
+		{name: "support", role: util.UserRoleSupport, accountID: frozen.ID, expectedStatusCode: http.StatusForbidden},
+		{name: "not frozen", role: util.UserRoleAdmin, accountID: active.ID, expectedStatusCode: http.StatusBadRequest},
+		{name: "admin", role: util.UserRoleAdmin, accountID: frozen.ID, UnfreezeTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			expectAdminAuditLogs(mockStore)
+			mockStore.EXPECT().GetAccount(gomock.Any(), frozen.ID).Return(frozen, nil).AnyTimes()
+			mockStore.EXPECT().GetAccount(gomock.Any(), active.ID).Return(active, nil).AnyTimes()
+			mockStore.EXPECT().
+				UnfreezeAccount(gomock.Any(), frozen.ID).
+				Return([]db.Account{active}, nil).
+				Times(tc.UnfreezeTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/accounts/%d/unfreeze", tc.accountID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+			addRoleAuthorization(t, request, server.tokenMaker, 3, tc.role)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestUpdateUserRole(t *testing.T) {
+	testCases := []struct {
+		name               string
+		userID             int64
+		body               string
+		UpdateTimes        int
+		expectedStatusCode int
+	}{
+		{name: "unknown role", userID: 7, body: `{"role":"root"}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "own role", userID: 3, body: `{"role":"customer"}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "promote to support", userID: 7, body: `{"role":"support"}`, UpdateTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			expectAdminAuditLogs(mockStore)
+			mockStore.EXPECT().
+				UpdateUserRole(gomock.Any(), db.UpdateUserRoleParams{ID: tc.userID, Role: util.UserRoleSupport}).
+				Return(db.User{ID: tc.userID, Role: util.UserRoleSupport}, nil).
+				Times(tc.UpdateTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/users/%d/role", tc.userID)
+			request, err := http.NewRequest(http.MethodPut, url, strings.NewReader(tc.body))
+			require.NoError(t, err)
+			addRoleAuthorization(t, request, server.tokenMaker, 3, util.UserRoleAdmin)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+
+			if tc.expectedStatusCode == http.StatusOK {
+				var response userResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, util.UserRoleSupport, response.Role)
+			}
+		})
+	}
+}
+
+func TestTransferFromFrozenAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	from := db.Account{ID: 1, UserID: 1, Balance: 1000, Currency: util.EUR, Kind: util.AccountKindCustomer, FrozenAt: sql.NullTime{Time: time.Now(), Valid: true}}
+
+	mockStore := mocks.NewMockStore(ctrl)
+	mockStore.EXPECT().GetAccount(gomock.Any(), from.ID).Return(from, nil)
+	expectHolders(mockStore, map[int64]string{from.ID: util.AccountRoleOwner})
+	mockStore.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+
+	server := newTestServer(t, mockStore)
+	recorder := httptest.NewRecorder()
+
+	body := `{"from_account_id":1,"to_account_id":2,"amount":100,"currency":"EUR"}`
+	request, err := http.NewRequest(http.MethodPost, "/transfers", strings.NewReader(body))
+	require.NoError(t, err)
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "frozen")
+}
+
+func TestListAuditEvents(t *testing.T) {
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name               string
+		role               string
+		query              string
+		ListTimes          int
+		expectedStatusCode int
+	}{
+		{name: "support", role: util.UserRoleSupport, query: "page_id=1&page_size=5", expectedStatusCode: http.StatusForbidden},
+		{name: "unknown action", role: util.UserRoleAdmin, query: "action=select&page_id=1&page_size=5", expectedStatusCode: http.StatusBadRequest},
+		{name: "invalid time", role: util.UserRoleAdmin, query: "from=yesterday&page_id=1&page_size=5", expectedStatusCode: http.StatusBadRequest},
+		{name: "changes of an account", role: util.UserRoleAdmin, query: "resource_type=accounts&resource_id=1&action=update&from=2024-05-01T00:00:00Z&page_id=1&page_size=5", ListTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			expectAdminAuditLogs(mockStore)
+			mockStore.EXPECT().
+				ListAuditEvents(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.ListAuditEventsParams) ([]db.AuditEvent, error) {
+					require.Equal(t, sql.NullString{String: "accounts", Valid: true}, arg.ResourceType)
+					require.Equal(t, sql.NullString{String: "1", Valid: true}, arg.ResourceID)
+					require.Equal(t, sql.NullString{String: "update", Valid: true}, arg.Action)
+					require.False(t, arg.ActorID.Valid)
+					require.True(t, from.Equal(arg.FromTime.Time))
+					require.False(t, arg.ToTime.Valid)
+					return []db.AuditEvent{{ID: 1, Action: "update", ResourceType: "accounts", ResourceID: "1"}}, nil
+				}).
+				Times(tc.ListTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/admin/audit-events?"+tc.query, nil)
+			require.NoError(t, err)
+			addRoleAuthorization(t, request, server.tokenMaker, 3, tc.role)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"net/http"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/gin-gonic/gin"
+)
+
+// createBeneficiaryRequest describes a USD bank account paid by ACH, identified by routing and account
+// number, or a EUR bank account paid by SEPA credit transfer, identified by the IBAN of a SEPA country and BIC
+type createBeneficiaryRequest struct {
+	Name          string `json:"name" binding:"required,max=70"`
+	Currency      string `json:"currency" binding:"omitempty,oneof=USD EUR"`
+	RoutingNumber string `json:"routing_number" binding:"required_unless=Currency EUR,omitempty,routing_number"`
+	AccountNumber string `json:"account_number" binding:"required_unless=Currency EUR,omitempty,numeric,max=17"`
+	AccountType   string `json:"account_type" binding:"required_unless=Currency EUR,omitempty,bank_account_type"`
+	IBAN          string `json:"iban" binding:"required_if=Currency EUR,omitempty,sepa_iban"`
+	BIC           string `json:"bic" binding:"required_if=Currency EUR,omitempty,bic"`
+}
+
+/*
+createBeneficiary saves an external bank account the authenticated user can pay out to,
+currency defaults to USD
+
+Path: POST /beneficiaries
+
+Body createBeneficiaryRequest
+*/
+func (server *Server) createBeneficiary(ctx *gin.Context) {
+	var req createBeneficiaryRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	arg := db.CreateBeneficiaryParams{
+		UserID:   authPayload(ctx).UserID,
+		Name:     req.Name,
+		Currency: req.Currency,
+	}
+	if req.Currency == util.EUR {
+		arg.Iban = req.IBAN
+		arg.Bic = req.BIC
+	} else {
+		arg.Currency = util.USD
+		arg.RoutingNumber = req.RoutingNumber
+		arg.AccountNumber = req.AccountNumber
+		arg.AccountType = req.AccountType
+	}
+
+	beneficiary, err := server.store.CreateBeneficiary(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, beneficiary)
+}
+
+type listBeneficiariesRequest struct {
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
+}
+
+/*
+listBeneficiaries lists the external bank accounts saved by the authenticated user
+
+Path: GET /beneficiaries
+*/
+func (server *Server) listBeneficiaries(ctx *gin.Context) {
+	var req listBeneficiariesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	payload := authPayload(ctx)
+	beneficiaries, err := server.store.ListBeneficiaries(ctx, db.ListBeneficiariesParams{
+		UserID: payload.UserID,
+		Limit:  req.PageSize,
+		Offset: (req.PageID - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, beneficiaries)
+}
@@ -0,0 +1,171 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateBeneficiary(t *testing.T) {
+
+	type apiTest[A any, R any] struct {
+		Argument A
+		Response R
+		Err      error
+		Times    int
+	}
+
+	valid := createBeneficiaryRequest{
+		Name:          "Jane Doe",
+		RoutingNumber: "021000021",
+		AccountNumber: "123456789",
+		AccountType:   util.BankAccountTypeChecking,
+	}
+	arg := db.CreateBeneficiaryParams{
+		UserID:        1,
+		Name:          valid.Name,
+		RoutingNumber: valid.RoutingNumber,
+		AccountNumber: valid.AccountNumber,
+		AccountType:   valid.AccountType,
+		Currency:      util.USD,
+	}
+
+	sepa := createBeneficiaryRequest{
+		Name:     "Jean Dupont",
+		Currency: util.EUR,
+		IBAN:     "FR1420041010050500013M02606",
+		BIC:      "PSSTFRPPPAR",
+	}
+	sepaArg := db.CreateBeneficiaryParams{
+		UserID:   1,
+		Name:     sepa.Name,
+		Currency: util.EUR,
+		Iban:     sepa.IBAN,
+		Bic:      sepa.BIC,
+	}
+
+	withIBAN := sepa
+	withIBAN.IBAN = "FR1520041010050500013M02606"
+	outsideSEPA := sepa
+	outsideSEPA.IBAN = "BR1800360305000010009795493C1"
+	withoutBIC := sepa
+	withoutBIC.BIC = ""
+	withCurrency := valid
+	withCurrency.Currency = util.CAD
+
+	withRoutingNumber := valid
+	withRoutingNumber.RoutingNumber = "021000022"
+	withAccountType := valid
+	withAccountType.AccountType = "brokerage"
+
+	testCases := []struct {
+		name               string
+		request            createBeneficiaryRequest
+		CreateBeneficiary  apiTest[db.CreateBeneficiaryParams, db.Beneficiary]
+		expectedStatusCode int
+	}{
+		{
+			name:               "invalid routing number check digit",
+			request:            withRoutingNumber,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "unsupported account type",
+			request:            withAccountType,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "invalid iban check digits",
+			request:            withIBAN,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "iban outside of SEPA",
+			request:            outsideSEPA,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "missing bic for a EUR beneficiary",
+			request:            withoutBIC,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "unsupported currency",
+			request:            withCurrency,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:    "db error",
+			request: valid,
+			CreateBeneficiary: apiTest[db.CreateBeneficiaryParams, db.Beneficiary]{
+				Argument: arg,
+				Err:      sql.ErrConnDone,
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:    "successful request",
+			request: valid,
+			CreateBeneficiary: apiTest[db.CreateBeneficiaryParams, db.Beneficiary]{
+				Argument: arg,
+				Response: db.Beneficiary{ID: 3, UserID: 1, Name: valid.Name, RoutingNumber: valid.RoutingNumber, AccountNumber: valid.AccountNumber, AccountType: valid.AccountType, Currency: util.USD},
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:    "successful EUR request",
+			request: sepa,
+			CreateBeneficiary: apiTest[db.CreateBeneficiaryParams, db.Beneficiary]{
+				Argument: sepaArg,
+				Response: db.Beneficiary{ID: 4, UserID: 1, Name: sepa.Name, Currency: util.EUR, Iban: sepa.IBAN, Bic: sepa.BIC},
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				CreateBeneficiary(gomock.Any(), tc.CreateBeneficiary.Argument).
+				Return(tc.CreateBeneficiary.Response, tc.CreateBeneficiary.Err).
+				Times(tc.CreateBeneficiary.Times)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			bodyJson, err := json.Marshal(tc.request)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/beneficiaries", bytes.NewReader(bodyJson))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+
+			if tc.expectedStatusCode == http.StatusOK {
+				var response db.Beneficiary
+				err = json.Unmarshal(recorder.Body.Bytes(), &response)
+				require.NoError(t, err)
+				require.Equal(t, tc.CreateBeneficiary.Response, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/Sinothic/simplebank/access"
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/payment"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/gin-gonic/gin"
+)
+
+type externalTransferURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type externalTransferRequest struct {
+	Amount   int64  `json:"amount" binding:"required,gt=0"`
+	Currency string `json:"currency" binding:"required,currency"`
+}
+
+/*
+createDeposit collects money from the payment rail into an account
+
+Path: POST /accounts/:id/deposits
+
+Body externalTransferRequest
+*/
+func (server *Server) createDeposit(ctx *gin.Context) {
+	server.createExternalTransfer(ctx, util.DirectionDeposit)
+}
+
+/*
+createWithdrawal pays money out of an account through the payment rail. The withdrawal is only failed and refunded
+when the rail rejects it, it stays pending when the rail can't be reached
+
+Path: POST /accounts/:id/withdrawals
+
+Body externalTransferRequest
+*/
+func (server *Server) createWithdrawal(ctx *gin.Context) {
+	server.createExternalTransfer(ctx, util.DirectionWithdrawal)
+}
+
+func (server *Server) createExternalTransfer(ctx *gin.Context, direction string) {
+	var uri externalTransferURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req externalTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var account db.Account
+	var ok bool
+	if direction == util.DirectionWithdrawal {
+		account, ok = server.debitAccount(ctx, uri.ID, access.Destination{}, req.Amount)
+	} else {
+		account, ok = server.heldAccount(ctx, uri.ID, util.AccountPermissionTransact)
+	}
+	if !ok {
+		return
+	}
+
+	if !checkAccount(ctx, account, req.Currency) {
+		return
+	}
+
+	started, err := server.store.StartExternalTransferTx(ctx, db.StartExternalTransferTxParams{
+		AccountID: uri.ID,
+		Direction: direction,
+		Amount:    req.Amount,
+		Rail:      server.rail.Name(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	railRequest := payment.Request{
+		TransferID: started.ExternalTransfer.ID,
+		AccountID:  uri.ID,
+		Amount:     req.Amount,
+		Currency:   req.Currency,
+	}
+
+	var railResult payment.Result
+	if direction == util.DirectionDeposit {
+		railResult, err = server.rail.Collect(ctx, railRequest)
+	} else {
+		railResult, err = server.rail.Payout(ctx, railRequest)
+	}
+	if err != nil {
+		// an error such as a timeout doesn't tell whether the rail moved the money, failing the transfer
+		// would refund a withdrawal that may have been paid out, so it is left pending until an admin settles it
+		log.Printf("cannot submit %s %d to the %s rail, left pending: %v", direction, started.ExternalTransfer.ID, server.rail.Name(), err)
+		ctx.JSON(http.StatusAccepted, started)
+		return
+	}
+
+	switch railResult.Status {
+	case util.PaymentStatusCompleted:
+		result, err := server.store.CompleteExternalTransferTx(ctx, db.CompleteExternalTransferTxParams{
+			ID:                started.ExternalTransfer.ID,
+			ExternalReference: railResult.Reference,
+		})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusOK, result)
+	case util.PaymentStatusFailed:
+		_, err := server.store.FailExternalTransferTx(ctx, db.FailExternalTransferTxParams{
+			ID:                started.ExternalTransfer.ID,
+			ExternalReference: railResult.Reference,
+			FailureReason:     railResult.FailureReason,
+		})
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		err = fmt.Errorf("%s %d failed on the %s rail: %s", direction, started.ExternalTransfer.ID, server.rail.Name(), railResult.FailureReason)
+		ctx.JSON(http.StatusBadGateway, errorResponse(err))
+	default:
+		ctx.JSON(http.StatusAccepted, started)
+	}
+}
+
+// completeExternalTransferRequest settles a pending deposit or withdrawal the rail confirmed
+type completeExternalTransferRequest struct {
+	ExternalReference string `json:"external_reference" binding:"required,max=140"`
+}
+
+/*
+completeExternalTransfer settles a deposit or withdrawal left pending once the rail confirmed it moved the money,
+the account of a deposit is credited
+
+Path: POST /admin/external-transfers/:id/complete
+
+Body completeExternalTransferRequest
+*/
+func (server *Server) completeExternalTransfer(ctx *gin.Context) {
+	var uri externalTransferURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req completeExternalTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := server.store.CompleteExternalTransferTx(ctx, db.CompleteExternalTransferTxParams{
+		ID:                uri.ID,
+		ExternalReference: req.ExternalReference,
+	})
+	if err != nil {
+		settleExternalTransferErrorResponse(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// failExternalTransferRequest settles a pending deposit or withdrawal the rail rejected
+type failExternalTransferRequest struct {
+	ExternalReference string `json:"external_reference" binding:"max=140"`
+	FailureReason     string `json:"failure_reason" binding:"required,max=500"`
+}
+
+/*
+failExternalTransfer settles a deposit or withdrawal left pending once the rail told it didn't move the money,
+the money of a withdrawal is given back to the account
+
+Path: POST /admin/external-transfers/:id/fail
+
+Body failExternalTransferRequest
+*/
+func (server *Server) failExternalTransfer(ctx *gin.Context) {
+	var uri externalTransferURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req failExternalTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := server.store.FailExternalTransferTx(ctx, db.FailExternalTransferTxParams{
+		ID:                uri.ID,
+		ExternalReference: req.ExternalReference,
+		FailureReason:     req.FailureReason,
+	})
+	if err != nil {
+		settleExternalTransferErrorResponse(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+func settleExternalTransferErrorResponse(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+	case errors.Is(err, db.ErrExternalTransferNotPending):
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+	default:
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+	}
+}
+
+type listExternalTransfersRequest struct {
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
+}
+
+/*
+listExternalTransfers lists the deposits and withdrawals of an account with their status
+
+Path: GET /accounts/:id/external-transfers
+*/
+func (server *Server) listExternalTransfers(ctx *gin.Context) {
+	var uri externalTransferURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req listExternalTransfersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.heldAccount(ctx, uri.ID, util.AccountPermissionView); !ok {
+		return
+	}
+
+	transfers, err := server.store.ListExternalTransfers(ctx, db.ListExternalTransfersParams{
+		AccountID: uri.ID,
+		Limit:     req.PageSize,
+		Offset:    (req.PageID - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, transfers)
+}
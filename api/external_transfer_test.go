@@ -0,0 +1,315 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/payment"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateExternalTransfer(t *testing.T) {
+
+	type apiTest[A any, R any] struct {
+		Argument A
+		Response R
+		Err      error
+		Times    int
+	}
+
+	account := db.Account{ID: 1, Balance: 100, Currency: util.USD, Kind: util.AccountKindCustomer}
+	pending := db.ExternalTransfer{ID: 7, AccountID: 1, ClearingAccountID: 3, Amount: 40, Currency: util.USD, Rail: payment.FakeRailName, Status: util.PaymentStatusPending}
+
+	testCases := []struct {
+		name                       string
+		path                       string
+		role                       string
+		request                    externalTransferRequest
+		railResult                 *payment.Result
+		railErr                    error
+		GetAccount                 apiTest[int64, db.Account]
+		StartExternalTransferTx    apiTest[db.StartExternalTransferTxParams, db.ExternalTransferTxResult]
+		CompleteExternalTransferTx apiTest[db.CompleteExternalTransferTxParams, db.ExternalTransferTxResult]
+		FailExternalTransferTx     apiTest[db.FailExternalTransferTxParams, db.ExternalTransferTxResult]
+		expectedStatusCode         int
+	}{
+		{
+			name:               "bad request error (negative amount)",
+			path:               "deposits",
+			request:            externalTransferRequest{Amount: -1, Currency: util.USD},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:    "viewer of the account",
+			path:    "deposits",
+			role:    util.AccountRoleViewer,
+			request: externalTransferRequest{Amount: 40, Currency: util.USD},
+			GetAccount: apiTest[int64, db.Account]{
+				Response: account,
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:    "currency mismatch",
+			path:    "deposits",
+			request: externalTransferRequest{Amount: 40, Currency: util.EUR},
+			GetAccount: apiTest[int64, db.Account]{
+				Response: account,
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:    "insufficient funds",
+			path:    "withdrawals",
+			request: externalTransferRequest{Amount: 400, Currency: util.USD},
+			GetAccount: apiTest[int64, db.Account]{
+				Response: account,
+				Times:    1,
+			},
+			StartExternalTransferTx: apiTest[db.StartExternalTransferTxParams, db.ExternalTransferTxResult]{
+				Argument: db.StartExternalTransferTxParams{AccountID: 1, Direction: util.DirectionWithdrawal, Amount: 400, Rail: payment.FakeRailName},
+				Err:      db.ErrInsufficientFunds,
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:    "successful deposit",
+			path:    "deposits",
+			request: externalTransferRequest{Amount: 40, Currency: util.USD},
+			GetAccount: apiTest[int64, db.Account]{
+				Response: account,
+				Times:    1,
+			},
+			StartExternalTransferTx: apiTest[db.StartExternalTransferTxParams, db.ExternalTransferTxResult]{
+				Argument: db.StartExternalTransferTxParams{AccountID: 1, Direction: util.DirectionDeposit, Amount: 40, Rail: payment.FakeRailName},
+				Response: db.ExternalTransferTxResult{ExternalTransfer: pending, Account: account},
+				Times:    1,
+			},
+			CompleteExternalTransferTx: apiTest[db.CompleteExternalTransferTxParams, db.ExternalTransferTxResult]{
+				Argument: db.CompleteExternalTransferTxParams{ID: 7, ExternalReference: "fake-1"},
+				Response: db.ExternalTransferTxResult{
+					ExternalTransfer: db.ExternalTransfer{ID: 7, Status: util.PaymentStatusCompleted},
+					Account:          db.Account{ID: 1, Balance: 140},
+					AccountEntry:     db.Entry{ID: 3, AccountID: 1, Amount: 40, Metadata: json.RawMessage(`{}`)},
+					ClearingEntry:    db.Entry{ID: 4, AccountID: 9, Amount: -40, Metadata: json.RawMessage(`{}`)},
+				},
+				Times: 1,
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:       "pending withdrawal",
+			path:       "withdrawals",
+			request:    externalTransferRequest{Amount: 40, Currency: util.USD},
+			railResult: &payment.Result{Status: util.PaymentStatusPending},
+			GetAccount: apiTest[int64, db.Account]{
+				Response: account,
+				Times:    1,
+			},
+			StartExternalTransferTx: apiTest[db.StartExternalTransferTxParams, db.ExternalTransferTxResult]{
+				Argument: db.StartExternalTransferTxParams{AccountID: 1, Direction: util.DirectionWithdrawal, Amount: 40, Rail: payment.FakeRailName},
+				Response: db.ExternalTransferTxResult{ExternalTransfer: pending, Account: account},
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusAccepted,
+		},
+		{
+			name:    "withdrawal rejected by the rail",
+			path:    "withdrawals",
+			request: externalTransferRequest{Amount: 40, Currency: util.USD},
+			railResult: &payment.Result{
+				Reference:     "fake-1",
+				Status:        util.PaymentStatusFailed,
+				FailureReason: "account closed",
+			},
+			GetAccount: apiTest[int64, db.Account]{
+				Response: account,
+				Times:    1,
+			},
+			StartExternalTransferTx: apiTest[db.StartExternalTransferTxParams, db.ExternalTransferTxResult]{
+				Argument: db.StartExternalTransferTxParams{AccountID: 1, Direction: util.DirectionWithdrawal, Amount: 40, Rail: payment.FakeRailName},
+				Response: db.ExternalTransferTxResult{ExternalTransfer: pending, Account: account},
+				Times:    1,
+			},
+			FailExternalTransferTx: apiTest[db.FailExternalTransferTxParams, db.ExternalTransferTxResult]{
+				Argument: db.FailExternalTransferTxParams{ID: 7, ExternalReference: "fake-1", FailureReason: "account closed"},
+				Response: db.ExternalTransferTxResult{ExternalTransfer: db.ExternalTransfer{ID: 7, Status: util.PaymentStatusFailed}},
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusBadGateway,
+		},
+		{
+			name:    "rail unreachable",
+			path:    "withdrawals",
+			request: externalTransferRequest{Amount: 40, Currency: util.USD},
+			railErr: errors.New("connection reset"),
+			GetAccount: apiTest[int64, db.Account]{
+				Response: account,
+				Times:    1,
+			},
+			StartExternalTransferTx: apiTest[db.StartExternalTransferTxParams, db.ExternalTransferTxResult]{
+				Argument: db.StartExternalTransferTxParams{AccountID: 1, Direction: util.DirectionWithdrawal, Amount: 40, Rail: payment.FakeRailName},
+				Response: db.ExternalTransferTxResult{ExternalTransfer: pending, Account: account},
+				Times:    1,
+			},
+			// the payout may have gone through, the withdrawal isn't refunded
+			expectedStatusCode: http.StatusAccepted,
+		},
+		{
+			name:    "error while completing the deposit",
+			path:    "deposits",
+			request: externalTransferRequest{Amount: 40, Currency: util.USD},
+			GetAccount: apiTest[int64, db.Account]{
+				Response: account,
+				Times:    1,
+			},
+			StartExternalTransferTx: apiTest[db.StartExternalTransferTxParams, db.ExternalTransferTxResult]{
+				Argument: db.StartExternalTransferTxParams{AccountID: 1, Direction: util.DirectionDeposit, Amount: 40, Rail: payment.FakeRailName},
+				Response: db.ExternalTransferTxResult{ExternalTransfer: pending, Account: account},
+				Times:    1,
+			},
+			CompleteExternalTransferTx: apiTest[db.CompleteExternalTransferTxParams, db.ExternalTransferTxResult]{
+				Argument: db.CompleteExternalTransferTxParams{ID: 7, ExternalReference: "fake-1"},
+				Err:      sql.ErrConnDone,
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				GetAccount(gomock.Any(), int64(1)).
+				Return(tc.GetAccount.Response, tc.GetAccount.Err).
+				Times(tc.GetAccount.Times)
+
+			mockStore.EXPECT().
+				StartExternalTransferTx(gomock.Any(), tc.StartExternalTransferTx.Argument).
+				Return(tc.StartExternalTransferTx.Response, tc.StartExternalTransferTx.Err).
+				Times(tc.StartExternalTransferTx.Times)
+
+			mockStore.EXPECT().
+				CompleteExternalTransferTx(gomock.Any(), tc.CompleteExternalTransferTx.Argument).
+				Return(tc.CompleteExternalTransferTx.Response, tc.CompleteExternalTransferTx.Err).
+				Times(tc.CompleteExternalTransferTx.Times)
+
+			mockStore.EXPECT().
+				FailExternalTransferTx(gomock.Any(), tc.FailExternalTransferTx.Argument).
+				Return(tc.FailExternalTransferTx.Response, tc.FailExternalTransferTx.Err).
+				Times(tc.FailExternalTransferTx.Times)
+
+			role := tc.role
+			if role == "" {
+				role = util.AccountRoleOwner
+			}
+			expectHolders(mockStore, map[int64]string{1: role})
+
+			rail := payment.NewFakeRail()
+			if tc.railResult != nil {
+				rail.SetNextResult(*tc.railResult)
+			}
+			rail.SetError(tc.railErr)
+
+			server := newTestServerWithRail(t, mockStore, rail)
+			recorder := httptest.NewRecorder()
+
+			bodyJson, err := json.Marshal(tc.request)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/accounts/%d/%s", 1, tc.path)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(bodyJson))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+
+			if tc.expectedStatusCode == http.StatusOK {
+				var response db.ExternalTransferTxResult
+				err = json.Unmarshal(recorder.Body.Bytes(), &response)
+				require.NoError(t, err)
+				require.Equal(t, tc.CompleteExternalTransferTx.Response, response)
+			}
+		})
+	}
+}
+
+func TestSettleExternalTransfer(t *testing.T) {
+	completed := db.ExternalTransfer{ID: 7, AccountID: 1, Amount: 40, Currency: util.USD, Status: util.PaymentStatusCompleted}
+	failed := db.ExternalTransfer{ID: 7, AccountID: 1, Amount: 40, Currency: util.USD, Status: util.PaymentStatusFailed}
+
+	testCases := []struct {
+		name               string
+		role               string
+		path               string
+		body               string
+		CompleteTimes      int
+		FailTimes          int
+		storeErr           error
+		expectedStatusCode int
+		expectedStatus     string
+	}{
+		{name: "support", role: util.UserRoleSupport, path: "complete", body: `{"external_reference":"rail-42"}`, expectedStatusCode: http.StatusForbidden},
+		{name: "complete without reference", role: util.UserRoleAdmin, path: "complete", body: `{}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "complete", role: util.UserRoleAdmin, path: "complete", body: `{"external_reference":"rail-42"}`, CompleteTimes: 1, expectedStatusCode: http.StatusOK, expectedStatus: util.PaymentStatusCompleted},
+		{name: "not found", role: util.UserRoleAdmin, path: "complete", body: `{"external_reference":"rail-42"}`, CompleteTimes: 1, storeErr: sql.ErrNoRows, expectedStatusCode: http.StatusNotFound},
+		{name: "already settled", role: util.UserRoleAdmin, path: "complete", body: `{"external_reference":"rail-42"}`, CompleteTimes: 1, storeErr: fmt.Errorf("%w: 7 is failed", db.ErrExternalTransferNotPending), expectedStatusCode: http.StatusBadRequest},
+		{name: "fail without reason", role: util.UserRoleAdmin, path: "fail", body: `{"external_reference":"rail-42"}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "fail", role: util.UserRoleAdmin, path: "fail", body: `{"external_reference":"rail-42","failure_reason":"account closed"}`, FailTimes: 1, expectedStatusCode: http.StatusOK, expectedStatus: util.PaymentStatusFailed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			expectAdminAuditLogs(mockStore)
+			mockStore.EXPECT().
+				CompleteExternalTransferTx(gomock.Any(), db.CompleteExternalTransferTxParams{ID: 7, ExternalReference: "rail-42"}).
+				Return(db.ExternalTransferTxResult{ExternalTransfer: completed}, tc.storeErr).
+				Times(tc.CompleteTimes)
+			mockStore.EXPECT().
+				FailExternalTransferTx(gomock.Any(), db.FailExternalTransferTxParams{ID: 7, ExternalReference: "rail-42", FailureReason: "account closed"}).
+				Return(db.ExternalTransferTxResult{ExternalTransfer: failed}, tc.storeErr).
+				Times(tc.FailTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/admin/external-transfers/7/%s", tc.path)
+			request, err := http.NewRequest(http.MethodPost, url, strings.NewReader(tc.body))
+			require.NoError(t, err)
+			addRoleAuthorization(t, request, server.tokenMaker, 3, tc.role)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+
+			if tc.expectedStatusCode == http.StatusOK {
+				var response db.ExternalTransferTxResult
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, tc.expectedStatus, response.ExternalTransfer.Status)
+			}
+		})
+	}
+}
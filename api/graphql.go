@@ -0,0 +1,38 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/Sinothic/simplebank/graph"
+	"github.com/gin-gonic/gin"
+)
+
+type graphqlRequest struct {
+	Query         string         `json:"query" binding:"required"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+/*
+executeGraphQL runs a read only GraphQL query on behalf of the authenticated user. The errors of the query,
+including the fields the user can't read, are reported in the errors of the response with a status OK
+
+Path: POST /graphql
+
+Body graphqlRequest
+*/
+func (server *Server) executeGraphQL(ctx *gin.Context) {
+	var req graphqlRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	payload := authPayload(ctx)
+	result := server.graph.Execute(ctx, graph.Viewer{UserID: payload.UserID, Role: payload.Role}, graph.Request{
+		Query:         req.Query,
+		OperationName: req.OperationName,
+		Variables:     req.Variables,
+	})
+	ctx.JSON(http.StatusOK, result)
+}
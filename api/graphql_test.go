@@ -0,0 +1,94 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExecuteGraphQL(t *testing.T) {
+	testCases := []struct {
+		name               string
+		body               string
+		setupAuth          bool
+		UsersTimes         int
+		expectedStatusCode int
+		checkResponse      func(t *testing.T, rsp map[string]any)
+	}{
+		{
+			name:               "no authorization",
+			body:               `{"query":"{ me { username } }"}`,
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:               "missing query",
+			body:               `{"variables":{}}`,
+			setupAuth:          true,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "OK",
+			body:               `{"query":"query Me { me { id username email } }","operationName":"Me"}`,
+			setupAuth:          true,
+			UsersTimes:         1,
+			expectedStatusCode: http.StatusOK,
+			checkResponse: func(t *testing.T, rsp map[string]any) {
+				require.NotContains(t, rsp, "errors")
+				me := rsp["data"].(map[string]any)["me"].(map[string]any)
+				require.Equal(t, "1", me["id"])
+				require.Equal(t, "user1", me["username"])
+				require.Equal(t, "user1@example.com", me["email"])
+			},
+		},
+		{
+			name:               "query error",
+			body:               `{"query":"query($id: ID!) { user(id: $id) { username } }","variables":{"id":"2"}}`,
+			setupAuth:          true,
+			expectedStatusCode: http.StatusOK,
+			checkResponse: func(t *testing.T, rsp map[string]any) {
+				require.Len(t, rsp["errors"], 1)
+				require.Equal(t, map[string]any{"user": nil}, rsp["data"])
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				ListUsersByIDs(gomock.Any(), json.RawMessage(`[1]`)).
+				Return([]db.User{{ID: 1, Username: "user1", Email: "user1@example.com", Role: util.UserRoleCustomer}}, nil).
+				Times(tc.UsersTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/graphql", strings.NewReader(tc.body))
+			require.NoError(t, err)
+			if tc.setupAuth {
+				addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+			}
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+
+			if tc.checkResponse != nil {
+				var rsp map[string]any
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+				tc.checkResponse(t, rsp)
+			}
+		})
+	}
+}
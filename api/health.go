@@ -0,0 +1,37 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/Sinothic/simplebank/health"
+	"github.com/gin-gonic/gin"
+)
+
+type livenessResponse struct {
+	Status string `json:"status"`
+}
+
+/*
+getLiveness tells the orchestrator the process is alive and serving. It checks no dependency, so that an outage
+of the database makes the replicas unready instead of getting them all restarted
+
+Path: GET /healthz
+*/
+func (server *Server) getLiveness(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, livenessResponse{Status: health.StatusOK})
+}
+
+/*
+getReadiness tells the load balancer whether to send requests to the server, it answers Service Unavailable
+when a check fails or when the server is draining before it shuts down
+
+Path: GET /readyz
+*/
+func (server *Server) getReadiness(ctx *gin.Context) {
+	report, ready := server.probes.Ready(ctx)
+	if !ready {
+		ctx.JSON(http.StatusServiceUnavailable, report)
+		return
+	}
+	ctx.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/health"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetLiveness(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTestServer(t, mocks.NewMockStore(ctrl))
+	// a failing check doesn't make the process dead
+	server.probes.AddCheck("database", func(ctx context.Context) error {
+		return errors.New("connection refused")
+	})
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/healthz", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.JSONEq(t, `{"status":"ok"}`, recorder.Body.String())
+}
+
+func TestGetReadiness(t *testing.T) {
+	testCases := []struct {
+		name               string
+		databaseErr        error
+		drain              bool
+		expectedStatusCode int
+		expectedReport     health.Report
+	}{
+		{
+			name:               "OK",
+			expectedStatusCode: http.StatusOK,
+			expectedReport: health.Report{
+				Status: health.StatusOK,
+				Checks: map[string]string{"database": health.StatusOK, "workers": health.StatusOK},
+			},
+		},
+		{
+			name:               "failing check",
+			databaseErr:        errors.New("connection refused"),
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedReport: health.Report{
+				Status: health.StatusFailing,
+				Checks: map[string]string{"database": "connection refused", "workers": health.StatusOK},
+			},
+		},
+		{
+			name:               "draining",
+			drain:              true,
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedReport:     health.Report{Status: health.StatusDraining},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := newTestServer(t, mocks.NewMockStore(ctrl))
+			server.probes.AddCheck("database", func(ctx context.Context) error {
+				return tc.databaseErr
+			})
+			server.probes.AddCheck("workers", func(ctx context.Context) error {
+				return nil
+			})
+			if tc.drain {
+				server.probes.Drain()
+			}
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/readyz", nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+
+			var report health.Report
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &report))
+			require.Equal(t, tc.expectedReport, report)
+		})
+	}
+}
@@ -1,12 +1,59 @@
 package api
 
 import (
+	"context"
+	"database/sql"
 	"os"
 	"testing"
+	"time"
 
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/health"
+	"github.com/Sinothic/simplebank/payment"
+	"github.com/Sinothic/simplebank/stream"
+	"github.com/Sinothic/simplebank/util"
 	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
+func newTestConfig() util.Config {
+	return util.Config{
+		TokenSymmetricKey:     "test-token-symmetric-key-0123456789",
+		AccessTokenDuration:   time.Minute,
+		PayeeCoolingOffPeriod: 24 * time.Hour,
+		PayeeCoolingOffLimit:  100000,
+		PaymentRequestTTL:     7 * 24 * time.Hour,
+	}
+}
+
+func newTestServer(t *testing.T, store db.Store) *Server {
+	return newTestServerWithRail(t, store, payment.NewFakeRail())
+}
+
+func newTestServerWithRail(t *testing.T, store db.Store, rail payment.PaymentRail) *Server {
+	server, err := NewServer(newTestConfig(), store, rail, stream.NewHub(), health.NewProbes())
+	require.NoError(t, err)
+
+	return server
+}
+
+// expectHolders lets the authenticated user hold the accounts of roles, by account id, with the given role,
+// they hold no other account
+func expectHolders(store *mocks.MockStore, roles map[int64]string) {
+	store.EXPECT().
+		GetAccountHolder(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.GetAccountHolderParams) (db.AccountHolder, error) {
+			role, ok := roles[arg.AccountID]
+			if !ok {
+				return db.AccountHolder{}, sql.ErrNoRows
+			}
+			return db.AccountHolder{AccountID: arg.AccountID, UserID: arg.UserID, Role: role}, nil
+		}).
+		AnyTimes()
+}
+
 func TestMain(m *testing.M) {
 	gin.SetMode(gin.TestMode)
 	os.Exit(m.Run())
@@ -0,0 +1,170 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/token"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func addAuthorization(t *testing.T, request *http.Request, tokenMaker token.Maker, authorizationType string, userID int64, username string, duration time.Duration) {
+	accessToken, payload, err := tokenMaker.CreateToken(userID, username, util.UserRoleCustomer, duration)
+	require.NoError(t, err)
+	require.NotEmpty(t, payload)
+
+	request.Header.Set(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationType, accessToken))
+}
+
+// addRoleAuthorization authenticates the request as a user with a staff role
+func addRoleAuthorization(t *testing.T, request *http.Request, tokenMaker token.Maker, userID int64, role string) {
+	accessToken, _, err := tokenMaker.CreateToken(userID, "staff", role, time.Minute)
+	require.NoError(t, err)
+
+	request.Header.Set(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeBearer, accessToken))
+}
+
+// expectAdminAuditLogs accepts the audit logs recorded for the calls to the back office
+func expectAdminAuditLogs(store *mocks.MockStore) {
+	store.EXPECT().
+		CreateAdminAuditLog(gomock.Any(), gomock.Any()).
+		Return(db.AdminAuditLog{}, nil).
+		AnyTimes()
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	testCases := []struct {
+		name               string
+		setupAuth          func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		expectedStatusCode int
+	}{
+		{
+			name: "authorized",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "no authorization",
+			setupAuth:          func(t *testing.T, request *http.Request, tokenMaker token.Maker) {},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "unsupported authorization",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, "basic", 1, "user", time.Minute)
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "invalid authorization format",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, "", 1, "user", time.Minute)
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "expired token",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, 1, "user", -time.Minute)
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := newTestServer(t, mocks.NewMockStore(ctrl))
+
+			authPath := "/auth"
+			server.router.GET(authPath, authMiddleware(server.tokenMaker), func(ctx *gin.Context) {
+				require.Equal(t, int64(1), authPayload(ctx).UserID)
+				ctx.JSON(http.StatusOK, gin.H{})
+			})
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, authPath, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestRequestAuditMiddleware(t *testing.T) {
+	testCases := []struct {
+		name      string
+		requestID string
+		generated bool
+	}{
+		{name: "request id of the client", requestID: "req-42"},
+		{name: "generated request id", generated: true},
+		{name: "request id too long", requestID: strings.Repeat("x", maxRequestIDLength+1), generated: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				DeletePayee(gomock.Any(), int64(1)).
+				DoAndReturn(func(ctx context.Context, _ int64) error {
+					audit, ok := db.AuditContextFrom(ctx)
+					require.True(t, ok)
+					require.Equal(t, int64(1), audit.ActorID)
+					require.Equal(t, "DELETE /audited/:id", audit.Route)
+					require.Equal(t, "simplebank-test", audit.UserAgent)
+					require.NotEmpty(t, audit.IP)
+					if !tc.generated {
+						require.Equal(t, tc.requestID, audit.RequestID)
+					}
+					return nil
+				})
+
+			server := newTestServer(t, mockStore)
+			server.router.DELETE("/audited/:id", authMiddleware(server.tokenMaker), func(ctx *gin.Context) {
+				err := server.store.DeletePayee(ctx, 1)
+				require.NoError(t, err)
+				ctx.JSON(http.StatusOK, nil)
+			})
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodDelete, "/audited/1", nil)
+			require.NoError(t, err)
+			request.RemoteAddr = "203.0.113.7:4321"
+			request.Header.Set("User-Agent", "simplebank-test")
+			if tc.requestID != "" {
+				request.Header.Set(requestIDHeader, tc.requestID)
+			}
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, http.StatusOK, recorder.Code)
+
+			requestID := recorder.Header().Get(requestIDHeader)
+			if tc.generated {
+				require.Len(t, requestID, 36)
+			} else {
+				require.Equal(t, tc.requestID, requestID)
+			}
+		})
+	}
+}
@@ -0,0 +1,950 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/health"
+	"github.com/Sinothic/simplebank/nacha"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+)
+
+// operation describes a route of the API, its parameters and schemas are derived from the structs
+// the handler binds and responds with so the specification follows the validation of the requests
+type operation struct {
+	Summary string
+	// Public routes don't need a bearer token
+	Public bool
+	URI    any
+	Query  any
+	// Params are the parameters the handler reads without binding them
+	Params []gin.H
+	Body   any
+	// BodyMedia is the media type of a body the handler reads as is instead of binding Body
+	BodyMedia string
+	Response  any
+	// ResponseMedia are the media types of a response the handler writes as is instead of Response
+	ResponseMedia []string
+	// Accepted is answered with 202 Accepted when the request isn't done yet
+	Accepted any
+	// Unavailable is answered with 503 Service Unavailable when the server can't take the request
+	Unavailable any
+}
+
+// oneOf is a response that can be any of its values
+type oneOf []any
+
+// metadataParam is the metadata filter of the search endpoints, bound with ctx.QueryMap
+var metadataParam = gin.H{
+	"name":        "metadata",
+	"in":          "query",
+	"description": "metadata[key]=value pairs the results must all match",
+	"style":       "deepObject",
+	"explode":     true,
+	"schema": gin.H{
+		"type":                 "object",
+		"additionalProperties": gin.H{"type": "string"},
+	},
+}
+
+// operations lists every route of NewServer by method and path
+var operations = map[string]operation{
+	"POST /users": {
+		Summary:  "Create a user",
+		Public:   true,
+		Body:     createUserRequest{},
+		Response: userResponse{},
+	},
+	"POST /users/login": {
+		Summary:  "Log a user in and get an access token",
+		Public:   true,
+		Body:     loginUserRequest{},
+		Response: loginUserResponse{},
+	},
+	"GET /healthz": {
+		Summary:  "Tell whether the process is alive",
+		Public:   true,
+		Response: livenessResponse{},
+	},
+	"GET /readyz": {
+		Summary:     "Tell whether the server is ready to take traffic, with the result of each of its checks",
+		Public:      true,
+		Response:    health.Report{},
+		Unavailable: health.Report{},
+	},
+
+	"POST /accounts": {
+		Summary:  "Open an account owned by the authenticated user",
+		Body:     createAccountRequest{},
+		Response: db.Account{},
+	},
+	"DELETE /accounts/:id": {
+		Summary: "Delete an account",
+		URI:     deleteAccountRequest{},
+	},
+	"GET /accounts/:id": {
+		Summary:  "Get an account with the balance of its pots",
+		URI:      getAccountRequest{},
+		Response: accountResponse{},
+	},
+	"GET /accounts": {
+		Summary:  "List the accounts the authenticated user holds",
+		Query:    listAccountRequest{},
+		Response: []db.Account{},
+	},
+	"POST /accounts/:id/deposits": {
+		Summary:  "Collect money from the payment rail into an account",
+		URI:      externalTransferURI{},
+		Body:     externalTransferRequest{},
+		Response: db.ExternalTransferTxResult{},
+		Accepted: db.ExternalTransferTxResult{},
+	},
+	"POST /accounts/:id/withdrawals": {
+		Summary:  "Pay money out of an account through the payment rail",
+		URI:      externalTransferURI{},
+		Body:     externalTransferRequest{},
+		Response: db.ExternalTransferTxResult{},
+		Accepted: db.ExternalTransferTxResult{},
+	},
+	"GET /accounts/:id/external-transfers": {
+		Summary:  "List the deposits and withdrawals of an account",
+		URI:      externalTransferURI{},
+		Query:    listExternalTransfersRequest{},
+		Response: []db.ExternalTransfer{},
+	},
+	"GET /accounts/:id/statement": {
+		Summary:       "Export a camt.053 or MT940 statement of an account",
+		URI:           getStatementURI{},
+		Query:         getStatementRequest{},
+		ResponseMedia: []string{"application/xml", "text/plain"},
+	},
+	"GET /accounts/:id/transfers": {
+		Summary:  "Search the transfers from or to an account",
+		URI:      accountURI{},
+		Query:    searchRequest{},
+		Params:   []gin.H{metadataParam},
+		Response: []db.Transfer{},
+	},
+	"GET /accounts/:id/entries": {
+		Summary:  "Search the entries of an account",
+		URI:      accountURI{},
+		Query:    searchRequest{},
+		Params:   []gin.H{metadataParam},
+		Response: []db.Entry{},
+	},
+	"GET /accounts/:id/stream": {
+		Summary: "Stream the balance and the new entries of an account over SSE or a WebSocket",
+		URI:     streamAccountRequest{},
+		Params: []gin.H{
+			{"name": "last_event_id", "in": "query", "schema": gin.H{"type": "integer", "format": "int64"}},
+			{"name": "Last-Event-ID", "in": "header", "schema": gin.H{"type": "integer", "format": "int64"}},
+		},
+		ResponseMedia: []string{"text/event-stream"},
+	},
+	"GET /accounts/:id/holders": {
+		Summary:  "List the holders of an account",
+		URI:      accountURI{},
+		Response: []db.AccountHolder{},
+	},
+	"DELETE /accounts/:id/holders/:user_id": {
+		Summary: "Remove a holder from an account",
+		URI:     accountHolderURI{},
+	},
+	"POST /accounts/:id/invitations": {
+		Summary:  "Invite a user to hold an account",
+		URI:      accountURI{},
+		Body:     createAccountInvitationRequest{},
+		Response: db.AccountInvitation{},
+	},
+	"POST /accounts/:id/pots": {
+		Summary:  "Create a savings pot in an account",
+		URI:      accountURI{},
+		Body:     potRequest{},
+		Response: potResponse{},
+	},
+	"GET /accounts/:id/pots": {
+		Summary:  "List the pots of an account",
+		URI:      accountURI{},
+		Response: []potResponse{},
+	},
+	"PUT /pots/:id": {
+		Summary:  "Rename a pot or change its goal",
+		URI:      accountURI{},
+		Body:     potRequest{},
+		Response: potResponse{},
+	},
+	"POST /pots/:id/moves": {
+		Summary:  "Move money between a pot and its account",
+		URI:      accountURI{},
+		Body:     movePotRequest{},
+		Response: db.TransferTxResult{},
+	},
+	"GET /users/me/invitations": {
+		Summary:  "List the pending invitations of the authenticated user",
+		Response: []db.AccountInvitation{},
+	},
+	"POST /invitations/:id/accept": {
+		Summary:  "Accept an invitation to hold an account",
+		URI:      invitationURI{},
+		Response: db.AcceptAccountInvitationTxResult{},
+	},
+	"POST /invitations/:id/decline": {
+		Summary:  "Decline an invitation to hold an account",
+		URI:      invitationURI{},
+		Response: db.AccountInvitation{},
+	},
+	"POST /invitations/:id/revoke": {
+		Summary:  "Revoke an invitation to hold an account",
+		URI:      invitationURI{},
+		Response: db.AccountInvitation{},
+	},
+	"POST /transfers": {
+		Summary:  "Transfer money to an account or a payee",
+		Body:     transferRequest{},
+		Response: oneOf{db.TransferTxResult{}, db.OutboundPaymentTxResult{}},
+		Accepted: db.TransferApproval{},
+	},
+	"POST /payment-batches": {
+		Summary:       "Execute the credit transfers of a pain.001 file",
+		BodyMedia:     "application/xml",
+		ResponseMedia: []string{"application/xml"},
+	},
+	"POST /beneficiaries": {
+		Summary:  "Add an external beneficiary",
+		Body:     createBeneficiaryRequest{},
+		Response: db.Beneficiary{},
+	},
+	"GET /beneficiaries": {
+		Summary:  "List the beneficiaries of the authenticated user",
+		Query:    listBeneficiariesRequest{},
+		Response: []db.Beneficiary{},
+	},
+	"POST /ach-payments": {
+		Summary:  "Pay a beneficiary from a USD account through ACH",
+		Body:     createOutboundPaymentRequest{},
+		Response: db.OutboundPaymentTxResult{},
+	},
+	"GET /accounts/:id/ach-payments": {
+		Summary:  "List the ACH payments of an account",
+		URI:      externalTransferURI{},
+		Query:    listOutboundPaymentsRequest{},
+		Response: []db.OutboundPayment{},
+	},
+	"POST /sepa-payments": {
+		Summary:  "Pay a beneficiary from a EUR account through SEPA",
+		Body:     createOutboundPaymentRequest{},
+		Response: db.OutboundPaymentTxResult{},
+	},
+	"GET /accounts/:id/sepa-payments": {
+		Summary:  "List the SEPA payments of an account",
+		URI:      externalTransferURI{},
+		Query:    listOutboundPaymentsRequest{},
+		Response: []db.OutboundPayment{},
+	},
+	"POST /users/me/payees": {
+		Summary:  "Save an account or a beneficiary as a payee",
+		Body:     createPayeeRequest{},
+		Response: db.Payee{},
+	},
+	"GET /users/me/payees": {
+		Summary:  "List the payees of the authenticated user",
+		Query:    listPayeesRequest{},
+		Response: []db.Payee{},
+	},
+	"GET /users/me/payees/:id": {
+		Summary:  "Get a payee",
+		URI:      payeeURI{},
+		Response: db.Payee{},
+	},
+	"PATCH /users/me/payees/:id": {
+		Summary:  "Rename a payee",
+		URI:      payeeURI{},
+		Body:     updatePayeeRequest{},
+		Response: db.Payee{},
+	},
+	"DELETE /users/me/payees/:id": {
+		Summary: "Delete a payee",
+		URI:     payeeURI{},
+	},
+	"POST /payment-requests": {
+		Summary:  "Request money from another user",
+		Body:     createPaymentRequestRequest{},
+		Response: db.PaymentRequest{},
+	},
+	"GET /payment-requests": {
+		Summary:  "List the incoming or outgoing payment requests",
+		Query:    listPaymentRequestsRequest{},
+		Response: []db.PaymentRequest{},
+	},
+	"POST /payment-requests/:id/accept": {
+		Summary:  "Pay a payment request",
+		URI:      paymentRequestURI{},
+		Body:     acceptPaymentRequestRequest{},
+		Response: db.AcceptPaymentRequestTxResult{},
+	},
+	"POST /payment-requests/:id/decline": {
+		Summary:  "Decline a payment request",
+		URI:      paymentRequestURI{},
+		Response: db.PaymentRequest{},
+	},
+	"POST /payment-requests/:id/cancel": {
+		Summary:  "Cancel a payment request",
+		URI:      paymentRequestURI{},
+		Response: db.PaymentRequest{},
+	},
+	"POST /organisations": {
+		Summary:  "Create an organisation",
+		Body:     createOrganisationRequest{},
+		Response: db.CreateOrganisationTxResult{},
+	},
+	"GET /organisations": {
+		Summary:  "List the organisations of the authenticated user",
+		Response: []db.Organisation{},
+	},
+	"GET /organisations/:id/members": {
+		Summary:  "List the members of an organisation",
+		URI:      organisationURI{},
+		Response: []db.OrganisationMember{},
+	},
+	"POST /organisations/:id/members": {
+		Summary:  "Add a member to an organisation",
+		URI:      organisationURI{},
+		Body:     addOrganisationMemberRequest{},
+		Response: db.OrganisationMember{},
+	},
+	"PUT /organisations/:id/members/:user_id": {
+		Summary:  "Change the role or the transfer limit of a member",
+		URI:      organisationMemberURI{},
+		Body:     organisationMemberRequest{},
+		Response: db.OrganisationMember{},
+	},
+	"DELETE /organisations/:id/members/:user_id": {
+		Summary: "Remove a member from an organisation",
+		URI:     organisationMemberURI{},
+	},
+	"POST /organisations/:id/accounts": {
+		Summary:  "Open an account owned by an organisation",
+		URI:      organisationURI{},
+		Body:     createOrganisationAccountRequest{},
+		Response: db.Account{},
+	},
+	"GET /organisations/:id/accounts": {
+		Summary:  "List the accounts of an organisation",
+		URI:      organisationURI{},
+		Response: []db.Account{},
+	},
+	"GET /organisations/:id/approvals": {
+		Summary:  "List the transfers of an organisation waiting for approval",
+		URI:      organisationURI{},
+		Response: []db.TransferApproval{},
+	},
+	"POST /transfer-approvals/:id/approve": {
+		Summary:  "Approve a held transfer",
+		URI:      transferApprovalURI{},
+		Response: db.ApproveTransferTxResult{},
+	},
+	"POST /transfer-approvals/:id/reject": {
+		Summary:  "Reject a held transfer",
+		URI:      transferApprovalURI{},
+		Response: db.TransferApproval{},
+	},
+	"POST /webhooks": {
+		Summary:  "Subscribe a URL to events about the accounts of the authenticated user",
+		Body:     webhookRequest{},
+		Response: webhookResponse{},
+	},
+	"GET /webhooks": {
+		Summary:  "List the webhook subscriptions of the authenticated user",
+		Response: []webhookResponse{},
+	},
+	"PUT /webhooks/:id": {
+		Summary:  "Replace a webhook subscription",
+		URI:      webhookURI{},
+		Body:     updateWebhookRequest{},
+		Response: webhookResponse{},
+	},
+	"DELETE /webhooks/:id": {
+		Summary: "Delete a webhook subscription",
+		URI:     webhookURI{},
+	},
+	"GET /webhooks/:id/deliveries": {
+		Summary:  "List the deliveries of a webhook subscription",
+		URI:      webhookURI{},
+		Query:    listWebhookDeliveriesRequest{},
+		Response: []db.WebhookDelivery{},
+	},
+	"POST /webhooks/:id/deliveries/:delivery_id/replay": {
+		Summary:  "Send a webhook delivery again",
+		URI:      webhookDeliveryURI{},
+		Response: db.WebhookDelivery{},
+	},
+	"POST /graphql": {
+		Summary:  "Run a read only GraphQL query over the users, accounts and transfers",
+		Body:     graphqlRequest{},
+		Response: graphql.Result{},
+	},
+
+	"GET /admin/users": {
+		Summary:  "Search the users",
+		Query:    searchUsersRequest{},
+		Response: []userResponse{},
+	},
+	"PUT /admin/users/:id/role": {
+		Summary:  "Change the role of a user",
+		URI:      userURI{},
+		Body:     updateUserRoleRequest{},
+		Response: userResponse{},
+	},
+	"GET /admin/accounts": {
+		Summary:  "Search the accounts",
+		Query:    searchAccountsRequest{},
+		Response: []db.Account{},
+	},
+	"GET /admin/accounts/:id/entries": {
+		Summary:  "Search the entries of any account",
+		URI:      accountURI{},
+		Query:    searchRequest{},
+		Params:   []gin.H{metadataParam},
+		Response: []db.Entry{},
+	},
+	"POST /admin/accounts/:id/freeze": {
+		Summary:  "Freeze an account and its pots",
+		URI:      accountURI{},
+		Body:     freezeAccountRequest{},
+		Response: []db.Account{},
+	},
+	"POST /admin/accounts/:id/unfreeze": {
+		Summary:  "Unfreeze an account and its pots",
+		URI:      accountURI{},
+		Response: []db.Account{},
+	},
+	"POST /admin/accounts/:id/adjustments": {
+		Summary:  "Correct the balance of an account",
+		URI:      createAdjustmentURI{},
+		Body:     createAdjustmentRequest{},
+		Response: db.AdjustBalanceTxResult{},
+	},
+	"POST /admin/external-transfers/:id/complete": {
+		Summary:  "Settle a pending deposit or withdrawal the payment rail confirmed",
+		URI:      externalTransferURI{},
+		Body:     completeExternalTransferRequest{},
+		Response: db.ExternalTransferTxResult{},
+	},
+	"POST /admin/external-transfers/:id/fail": {
+		Summary:  "Settle a pending deposit or withdrawal the payment rail rejected",
+		URI:      externalTransferURI{},
+		Body:     failExternalTransferRequest{},
+		Response: db.ExternalTransferTxResult{},
+	},
+	"POST /admin/ach/returns": {
+		Summary:   "Import a NACHA return file",
+		BodyMedia: "text/plain",
+		Response:  nacha.ReturnsResult{},
+	},
+	"GET /admin/audit-logs": {
+		Summary:  "List the requests made by the support and admin users",
+		Query:    listAdminAuditLogsRequest{},
+		Response: []db.AdminAuditLog{},
+	},
+	"GET /admin/audit-events": {
+		Summary:  "List the changes made to the database",
+		Query:    listAuditEventsRequest{},
+		Response: []db.AuditEvent{},
+	},
+}
+
+// openAPISpec is built once, the first time it is served
+var openAPISpec = sync.OnceValue(newOpenAPISpec)
+
+// newOpenAPISpec builds the OpenAPI 3 specification of the operations
+func newOpenAPISpec() gin.H {
+	gen := newSchemaGenerator()
+	paths := gin.H{}
+	for route, op := range operations {
+		method, path, _ := strings.Cut(route, " ")
+		specPath := openAPIPath(path)
+		item, ok := paths[specPath].(gin.H)
+		if !ok {
+			item = gin.H{}
+			paths[specPath] = item
+		}
+		item[strings.ToLower(method)] = gen.operation(method, path, op)
+	}
+
+	return gin.H{
+		"openapi": "3.1.0",
+		"info": gin.H{
+			"title":       "Simple Bank API",
+			"description": "Accounts, transfers and payments of Simple Bank",
+			"version":     "1.0.0",
+		},
+		"paths": paths,
+		"components": gin.H{
+			"schemas": gen.schemas,
+			"responses": gin.H{
+				"Error": gin.H{
+					"description": "The request failed",
+					"content": gin.H{
+						"application/json": gin.H{"schema": gin.H{"$ref": "#/components/schemas/ErrorResponse"}},
+					},
+				},
+			},
+			"securitySchemes": gin.H{
+				"bearerAuth": gin.H{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+			},
+		},
+	}
+}
+
+// OpenAPISpec returns the specification as indented JSON, the way it is committed in docs/openapi.json
+func OpenAPISpec() ([]byte, error) {
+	spec, err := json.MarshalIndent(openAPISpec(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(spec, '\n'), nil
+}
+
+// openAPIPath turns the :name parameters of a gin path into {name}
+func openAPIPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if name, ok := strings.CutPrefix(segment, ":"); ok {
+			segments[i] = "{" + name + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// openAPITag groups the operations by the first segment of their path, admin routes are all grouped together
+func openAPITag(path string) string {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	return segments[0]
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// customRules are the schemas of the validators registered by NewServer
+var customRules = map[string]gin.H{
+	"currency":          {"enum": []any{util.USD, util.EUR, util.CAD}},
+	"reason_code":       {"enum": []any{util.ReasonCorrection, util.ReasonFeeRefund, util.ReasonGoodwill, util.ReasonChargeback, util.ReasonWriteOff, util.ReasonMigration}},
+	"bank_account_type": {"enum": []any{util.BankAccountTypeChecking, util.BankAccountTypeSavings}},
+	"routing_number":    {"pattern": "^[0-9]{9}$", "format": "aba-routing-number"},
+	"iban":              {"pattern": "^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$", "format": "iban"},
+	"bic":               {"pattern": "^[A-Z]{6}[A-Z0-9]{2}([A-Z0-9]{3})?$", "format": "bic"},
+	"description":       {"pattern": `^[^\x00-\x1F\x7F]*$`},
+	"reference":         {"pattern": `^([a-zA-Z0-9/\-?:().,'+]([a-zA-Z0-9/\-?:().,'+ ]*[a-zA-Z0-9/\-?:().,'+])?)?$`},
+	"metadata_key":      {"pattern": "^[a-zA-Z0-9_.-]+$"},
+}
+
+// schemaGenerator derives JSON schemas from Go types, named structs are added to the components
+type schemaGenerator struct {
+	schemas gin.H
+	names   map[reflect.Type]string
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		schemas: gin.H{
+			"ErrorResponse": gin.H{
+				"type":       "object",
+				"properties": gin.H{"error": gin.H{"type": "string"}},
+				"required":   []string{"error"},
+			},
+		},
+		names: map[reflect.Type]string{},
+	}
+}
+
+func (gen *schemaGenerator) operation(method, path string, op operation) gin.H {
+	spec := gin.H{
+		"summary":     op.Summary,
+		"operationId": strings.ToLower(method) + operationName(path),
+		"tags":        []string{openAPITag(path)},
+	}
+
+	parameters := []gin.H{}
+	if op.URI != nil {
+		parameters = append(parameters, gen.parameters(reflect.TypeOf(op.URI), "path", "uri")...)
+	}
+	if op.Query != nil {
+		parameters = append(parameters, gen.parameters(reflect.TypeOf(op.Query), "query", "form")...)
+	}
+	parameters = append(parameters, op.Params...)
+	if len(parameters) > 0 {
+		spec["parameters"] = parameters
+	}
+
+	switch {
+	case op.Body != nil:
+		spec["requestBody"] = gin.H{
+			"required": true,
+			"content":  gin.H{"application/json": gin.H{"schema": gen.schema(reflect.TypeOf(op.Body))}},
+		}
+	case op.BodyMedia != "":
+		spec["requestBody"] = gin.H{
+			"required": true,
+			"content":  gin.H{op.BodyMedia: gin.H{"schema": gin.H{"type": "string"}}},
+		}
+	}
+
+	ok := gin.H{"description": "OK"}
+	switch {
+	case op.Response != nil:
+		ok["content"] = gin.H{"application/json": gin.H{"schema": gen.response(op.Response)}}
+	case len(op.ResponseMedia) > 0:
+		content := gin.H{}
+		for _, media := range op.ResponseMedia {
+			content[media] = gin.H{"schema": gin.H{"type": "string"}}
+		}
+		ok["content"] = content
+	}
+
+	responses := gin.H{
+		"200": ok,
+		"400": gin.H{"$ref": "#/components/responses/Error"},
+		"404": gin.H{"$ref": "#/components/responses/Error"},
+		"500": gin.H{"$ref": "#/components/responses/Error"},
+	}
+	if op.Accepted != nil {
+		responses["202"] = gin.H{
+			"description": "Accepted",
+			"content":     gin.H{"application/json": gin.H{"schema": gen.response(op.Accepted)}},
+		}
+	}
+	if op.Unavailable != nil {
+		responses["503"] = gin.H{
+			"description": "Service Unavailable",
+			"content":     gin.H{"application/json": gin.H{"schema": gen.response(op.Unavailable)}},
+		}
+	}
+	if !op.Public {
+		responses["401"] = gin.H{"$ref": "#/components/responses/Error"}
+		responses["403"] = gin.H{"$ref": "#/components/responses/Error"}
+		spec["security"] = []gin.H{{"bearerAuth": []string{}}}
+	}
+	spec["responses"] = responses
+
+	return spec
+}
+
+// operationName makes a camel case name out of the static segments of a path
+func operationName(path string) string {
+	var name strings.Builder
+	for _, segment := range strings.FieldsFunc(path, func(r rune) bool { return r == '/' || r == '-' }) {
+		if strings.HasPrefix(segment, ":") {
+			segment = "by_" + segment[1:]
+		}
+		for _, word := range strings.Split(segment, "_") {
+			if word == "" {
+				continue
+			}
+			runes := []rune(word)
+			runes[0] = unicode.ToUpper(runes[0])
+			name.WriteString(string(runes))
+		}
+	}
+	return name.String()
+}
+
+func (gen *schemaGenerator) response(value any) gin.H {
+	alternatives, ok := value.(oneOf)
+	if !ok {
+		return gen.schema(reflect.TypeOf(value))
+	}
+
+	schemas := make([]gin.H, 0, len(alternatives))
+	for _, alternative := range alternatives {
+		schemas = append(schemas, gen.schema(reflect.TypeOf(alternative)))
+	}
+	return gin.H{"oneOf": schemas}
+}
+
+// parameters describes the fields of a struct bound from the path or the query by their tag
+func (gen *schemaGenerator) parameters(t reflect.Type, in, tag string) []gin.H {
+	var parameters []gin.H
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Tag.Get(tag)
+		if name == "" || name == "-" {
+			continue
+		}
+
+		schema := gen.schema(field.Type)
+		if field.Type == timeType && field.Tag.Get("time_format") == time.DateOnly {
+			schema["format"] = "date"
+		}
+		required := gen.applyRules(schema, field.Type, strings.Split(field.Tag.Get("binding"), ","))
+
+		parameters = append(parameters, gin.H{
+			"name":     name,
+			"in":       in,
+			"required": required || in == "path",
+			"schema":   schema,
+		})
+	}
+	return parameters
+}
+
+// schema describes a type the way encoding/json marshals it
+func (gen *schemaGenerator) schema(t reflect.Type) gin.H {
+	switch t {
+	case timeType:
+		return gin.H{"type": "string", "format": "date-time"}
+	case rawMessageType:
+		return gin.H{}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return gen.schema(t.Elem())
+	case reflect.Bool:
+		return gin.H{"type": "boolean"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return gin.H{"type": "integer", "format": "int64"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return gin.H{"type": "integer", "format": "int32"}
+	case reflect.Float32, reflect.Float64:
+		return gin.H{"type": "number"}
+	case reflect.String:
+		return gin.H{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return gin.H{"type": "string", "format": "byte"}
+		}
+		return gin.H{"type": "array", "items": gen.schema(t.Elem())}
+	case reflect.Map:
+		return gin.H{"type": "object", "additionalProperties": gen.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return gen.object(t)
+		}
+		return gin.H{"$ref": "#/components/schemas/" + gen.component(t)}
+	}
+	return gin.H{}
+}
+
+// component adds a named struct to the schemas of the components, the name of its package
+// tells apart structs with the same name
+func (gen *schemaGenerator) component(t reflect.Type) string {
+	if name, ok := gen.names[t]; ok {
+		return name
+	}
+
+	name := exportedName(t.Name())
+	if _, taken := gen.schemas[name]; taken {
+		pkg := t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:]
+		name = exportedName(pkg) + name
+	}
+
+	gen.names[t] = name
+	gen.schemas[name] = gin.H{}
+	gen.schemas[name] = gen.object(t)
+	return name
+}
+
+func exportedName(name string) string {
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+// object describes the JSON fields of a struct, the fields of embedded structs are flattened
+// and the binding rules of the fields become constraints
+func (gen *schemaGenerator) object(t reflect.Type) gin.H {
+	properties := gin.H{}
+	var required []string
+	gen.addFields(t, properties, &required)
+
+	schema := gin.H{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+func (gen *schemaGenerator) addFields(t reflect.Type, properties gin.H, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			gen.addFields(field.Type, properties, required)
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		schema := gen.schema(field.Type)
+		if gen.applyRules(schema, field.Type, strings.Split(field.Tag.Get("binding"), ",")) {
+			*required = append(*required, name)
+		}
+		properties[name] = schema
+	}
+}
+
+// applyRules adds the validator rules of a binding tag to the schema of a field and tells if the field is required.
+// The rules after dive apply to the elements of a slice or the values of a map, the rules between keys and endkeys
+// to the keys of a map. Conditional rules such as required_if can't be expressed and are left out
+func (gen *schemaGenerator) applyRules(schema gin.H, t reflect.Type, rules []string) bool {
+	if _, ok := schema["$ref"]; ok {
+		return len(rules) > 0 && rules[0] == "required"
+	}
+
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	required := false
+	for i := 0; i < len(rules); i++ {
+		name, param, _ := strings.Cut(rules[i], "=")
+		switch name {
+		case "required":
+			required = true
+		case "dive":
+			rest := rules[i+1:]
+			if t.Kind() != reflect.Map {
+				gen.applyRules(schema["items"].(gin.H), t.Elem(), rest)
+				return required
+			}
+
+			if len(rest) > 0 && rest[0] == "keys" {
+				if end := slices.Index(rest, "endkeys"); end > 0 {
+					keys := gin.H{"type": "string"}
+					gen.applyRules(keys, t.Key(), rest[1:end])
+					schema["propertyNames"] = keys
+					rest = rest[end+1:]
+				}
+			}
+			gen.applyRules(schema["additionalProperties"].(gin.H), t.Elem(), rest)
+			return required
+		case "min", "max", "len":
+			limitSchema(schema, t, name, param)
+		case "gt":
+			// an integer greater than n is at least n+1, which the generators of OpenAPI 3.0 clients understand
+			if limit, err := strconv.ParseInt(param, 10, 64); err == nil && schema["type"] == "integer" {
+				schema["minimum"] = float64(limit + 1)
+			} else if limit, err := strconv.ParseFloat(param, 64); err == nil {
+				schema["exclusiveMinimum"] = limit
+			}
+		case "oneof":
+			var values []any
+			for _, value := range strings.Fields(param) {
+				if n, err := strconv.ParseInt(value, 10, 64); err == nil && t.Kind() != reflect.String {
+					values = append(values, n)
+					continue
+				}
+				values = append(values, value)
+			}
+			schema["enum"] = values
+		case "unique":
+			schema["uniqueItems"] = true
+		case "email":
+			schema["format"] = "email"
+		case "url":
+			schema["format"] = "uri"
+		case "datetime":
+			schema["format"] = "date-time"
+			if param == time.DateOnly {
+				schema["format"] = "date"
+			}
+		case "alphanum":
+			schema["pattern"] = "^[a-zA-Z0-9]+$"
+		case "numeric":
+			schema["pattern"] = "^[0-9]+$"
+		default:
+			for key, value := range customRules[name] {
+				schema[key] = value
+			}
+		}
+	}
+	return required
+}
+
+// limitSchema turns a min, max or len rule into the keyword of the kind of the field
+func limitSchema(schema gin.H, t reflect.Type, rule, param string) {
+	limit, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return
+	}
+
+	var min, max string
+	switch t.Kind() {
+	case reflect.String:
+		min, max = "minLength", "maxLength"
+	case reflect.Slice, reflect.Array:
+		min, max = "minItems", "maxItems"
+	case reflect.Map:
+		min, max = "minProperties", "maxProperties"
+	default:
+		min, max = "minimum", "maximum"
+	}
+
+	switch rule {
+	case "min":
+		schema[min] = limit
+	case "max":
+		schema[max] = limit
+	case "len":
+		schema[min] = limit
+		schema[max] = limit
+	}
+}
+
+/*
+getOpenAPISpec serves the OpenAPI 3 specification of the API
+
+Path: GET /openapi.json
+*/
+func (server *Server) getOpenAPISpec(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, openAPISpec())
+}
+
+// apiDocsPage renders the specification with Swagger UI
+const apiDocsPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>Simple Bank API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({ url: "/openapi.json", dom_id: "#swagger-ui" });
+  </script>
+</body>
+</html>
+`
+
+/*
+getAPIDocs serves a Swagger UI page browsing the OpenAPI specification
+
+Path: GET /docs
+*/
+func (server *Server) getAPIDocs(ctx *gin.Context) {
+	ctx.Data(http.StatusOK, "text/html; charset=utf-8", []byte(apiDocsPage))
+}
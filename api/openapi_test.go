@@ -0,0 +1,253 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/oapi-codegen/oapi-codegen/v2/pkg/codegen"
+	oapiutil "github.com/oapi-codegen/oapi-codegen/v2/pkg/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/yaml.v3"
+)
+
+// docsRoutes serve the specification and aren't part of it
+var docsRoutes = map[string]bool{
+	"GET /openapi.json": true,
+	"GET /docs":         true,
+}
+
+func getOpenAPISpecResponse(t *testing.T, server *Server) map[string]any {
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/openapi.json", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var spec map[string]any
+	err = json.Unmarshal(recorder.Body.Bytes(), &spec)
+	require.NoError(t, err)
+	require.Equal(t, "3.1.0", spec["openapi"])
+	return spec
+}
+
+func TestOpenAPISpecMatchesRoutes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTestServer(t, mocks.NewMockStore(ctrl))
+	spec := getOpenAPISpecResponse(t, server)
+	paths := spec["paths"].(map[string]any)
+
+	routes := map[string]bool{}
+	for _, route := range server.router.Routes() {
+		key := route.Method + " " + route.Path
+		if docsRoutes[key] {
+			continue
+		}
+		routes[key] = true
+
+		item, ok := paths[openAPIPath(route.Path)].(map[string]any)
+		require.Truef(t, ok, "route %s is missing from the OpenAPI spec", key)
+		op, ok := item[strings.ToLower(route.Method)].(map[string]any)
+		require.Truef(t, ok, "route %s is missing from the OpenAPI spec", key)
+
+		pathParams := map[string]bool{}
+		if parameters, ok := op["parameters"].([]any); ok {
+			for _, parameter := range parameters {
+				parameter := parameter.(map[string]any)
+				if parameter["in"] == "path" {
+					pathParams[parameter["name"].(string)] = true
+				}
+			}
+		}
+
+		for _, segment := range strings.Split(route.Path, "/") {
+			if name, ok := strings.CutPrefix(segment, ":"); ok {
+				require.Truef(t, pathParams[name], "path parameter %s of %s is missing from the OpenAPI spec", name, key)
+				delete(pathParams, name)
+			}
+		}
+		require.Emptyf(t, pathParams, "the OpenAPI spec of %s has unknown path parameters", key)
+	}
+
+	for key := range operations {
+		require.Truef(t, routes[key], "the OpenAPI spec describes %s which isn't a route", key)
+	}
+}
+
+func TestOpenAPISpecSchemas(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	spec := getOpenAPISpecResponse(t, newTestServer(t, mocks.NewMockStore(ctrl)))
+	schemas := spec["components"].(map[string]any)["schemas"].(map[string]any)
+	paths := spec["paths"].(map[string]any)
+
+	testCases := []struct {
+		name  string
+		check func(t *testing.T)
+	}{
+		{
+			name: "RequiredFields",
+			check: func(t *testing.T) {
+				schema := schemas["TransferRequest"].(map[string]any)
+				require.ElementsMatch(t, []any{"from_account_id", "amount", "currency"}, schema["required"])
+			},
+		},
+		{
+			name: "ValidatorRules",
+			check: func(t *testing.T) {
+				properties := schemas["TransferRequest"].(map[string]any)["properties"].(map[string]any)
+
+				amount := properties["amount"].(map[string]any)
+				require.Equal(t, "integer", amount["type"])
+				require.Equal(t, float64(1), amount["minimum"])
+				require.NotContains(t, amount, "exclusiveMinimum")
+
+				currency := properties["currency"].(map[string]any)
+				require.Equal(t, []any{"USD", "EUR", "CAD"}, currency["enum"])
+
+				description := properties["description"].(map[string]any)
+				require.Equal(t, float64(140), description["maxLength"])
+			},
+		},
+		{
+			name: "MapKeysAndValues",
+			check: func(t *testing.T) {
+				properties := schemas["TransferRequest"].(map[string]any)["properties"].(map[string]any)
+				metadata := properties["metadata"].(map[string]any)
+				require.Equal(t, float64(20), metadata["maxProperties"])
+
+				keys := metadata["propertyNames"].(map[string]any)
+				require.Equal(t, float64(40), keys["maxLength"])
+				require.NotEmpty(t, keys["pattern"])
+
+				values := metadata["additionalProperties"].(map[string]any)
+				require.Equal(t, float64(500), values["maxLength"])
+			},
+		},
+		{
+			name: "SliceElements",
+			check: func(t *testing.T) {
+				properties := schemas["WebhookRequest"].(map[string]any)["properties"].(map[string]any)
+				eventTypes := properties["event_types"].(map[string]any)
+				require.Equal(t, float64(1), eventTypes["minItems"])
+				require.Equal(t, true, eventTypes["uniqueItems"])
+
+				items := eventTypes["items"].(map[string]any)
+				require.Equal(t, []any{"AccountCreated", "TransferPosted", "BalanceAdjusted", "ExternalTransferPosted", "OutboundPaymentCreated", "OutboundPaymentSubmitted", "OutboundPaymentReturned"}, items["enum"])
+			},
+		},
+		{
+			name: "EmbeddedStruct",
+			check: func(t *testing.T) {
+				schema := schemas["UpdateWebhookRequest"].(map[string]any)
+				properties := schema["properties"].(map[string]any)
+				require.Contains(t, properties, "url")
+				require.Contains(t, properties, "event_types")
+				require.Contains(t, properties, "enabled")
+				require.ElementsMatch(t, []any{"url", "event_types", "enabled"}, schema["required"])
+			},
+		},
+		{
+			name: "QueryParameters",
+			check: func(t *testing.T) {
+				op := paths["/accounts"].(map[string]any)["get"].(map[string]any)
+				parameters := op["parameters"].([]any)
+				require.Len(t, parameters, 2)
+
+				pageSize := parameters[1].(map[string]any)
+				require.Equal(t, "page_size", pageSize["name"])
+				require.Equal(t, "query", pageSize["in"])
+				require.Equal(t, true, pageSize["required"])
+
+				schema := pageSize["schema"].(map[string]any)
+				require.Equal(t, float64(5), schema["minimum"])
+				require.Equal(t, float64(10), schema["maximum"])
+			},
+		},
+		{
+			name: "Security",
+			check: func(t *testing.T) {
+				login := paths["/users/login"].(map[string]any)["post"].(map[string]any)
+				require.NotContains(t, login, "security")
+
+				transfer := paths["/transfers"].(map[string]any)["post"].(map[string]any)
+				require.Contains(t, transfer, "security")
+				require.Contains(t, transfer["responses"], "202")
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			tc.check(t)
+		})
+	}
+}
+
+func TestGetAPIDocs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	server := newTestServer(t, mocks.NewMockStore(ctrl))
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/docs", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Contains(t, recorder.Header().Get("Content-Type"), "text/html")
+	require.Contains(t, recorder.Body.String(), "/openapi.json")
+}
+
+// the committed specification and client are regenerated with make openapi-client
+const (
+	openAPISpecFile   = "../docs/openapi.json"
+	openAPIClientFile = "../client/client.gen.go"
+	openAPIClientConf = "../client/oapi-codegen.yaml"
+)
+
+var generatedHeader = regexp.MustCompile(`(?m)^// Code generated by .* DO NOT EDIT\.$`)
+
+func TestOpenAPISpecFile(t *testing.T) {
+	spec, err := OpenAPISpec()
+	require.NoError(t, err)
+
+	committed, err := os.ReadFile(openAPISpecFile)
+	require.NoError(t, err)
+	require.Equal(t, string(committed), string(spec), "docs/openapi.json is out of date, run make openapi-client")
+}
+
+func TestOpenAPIClientFile(t *testing.T) {
+	content, err := os.ReadFile(openAPIClientConf)
+	require.NoError(t, err)
+
+	var config codegen.Configuration
+	require.NoError(t, yaml.Unmarshal(content, &config))
+	config = config.UpdateDefaults()
+	require.NoError(t, config.Validate())
+
+	swagger, err := oapiutil.LoadSwagger(openAPISpecFile)
+	require.NoError(t, err)
+	code, err := codegen.Generate(swagger, config)
+	require.NoError(t, err)
+
+	// the header names the module running the generator, the binary of make openapi-client or this test
+	committed, err := os.ReadFile(openAPIClientFile)
+	require.NoError(t, err)
+	require.Equal(t,
+		generatedHeader.ReplaceAllString(string(committed), ""),
+		generatedHeader.ReplaceAllString(code, ""),
+		"client/client.gen.go is out of date, run make openapi-client")
+}
@@ -0,0 +1,375 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
+)
+
+type createOrganisationRequest struct {
+	Name string `json:"name" binding:"required,max=100,description"`
+}
+
+/*
+createOrganisation creates a business with the authenticated user as its first admin
+
+Path: POST /organisations
+
+Body createOrganisationRequest
+*/
+func (server *Server) createOrganisation(ctx *gin.Context) {
+	var req createOrganisationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := server.store.CreateOrganisationTx(ctx, db.CreateOrganisationTxParams{
+		UserID: authPayload(ctx).UserID,
+		Name:   req.Name,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+/*
+listOrganisations lists the organisations the authenticated user is a member of
+
+Path: GET /organisations
+*/
+func (server *Server) listOrganisations(ctx *gin.Context) {
+	organisations, err := server.store.ListMemberOrganisations(ctx, authPayload(ctx).UserID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, organisations)
+}
+
+type organisationURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type organisationMemberURI struct {
+	ID     int64 `uri:"id" binding:"required,min=1"`
+	UserID int64 `uri:"user_id" binding:"required,min=1"`
+}
+
+/*
+listOrganisationMembers lists the members of an organisation with their role and transfer limit
+
+Path: GET /organisations/:id/members
+*/
+func (server *Server) listOrganisationMembers(ctx *gin.Context) {
+	var uri organisationURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.organisationMember(ctx, uri.ID, util.AccountPermissionView); !ok {
+		return
+	}
+
+	members, err := server.store.ListOrganisationMembers(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, members)
+}
+
+// organisationMemberRequest gives a member a role and optionally caps the amount they can move in one payment
+type organisationMemberRequest struct {
+	Role          string `json:"role" binding:"required,oneof=admin initiator approver viewer"`
+	TransferLimit *int64 `json:"transfer_limit" binding:"omitempty,min=0"`
+}
+
+func (req organisationMemberRequest) transferLimit() sql.NullInt64 {
+	if req.TransferLimit == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: *req.TransferLimit, Valid: true}
+}
+
+type addOrganisationMemberRequest struct {
+	Username string `json:"username" binding:"required,alphanum"`
+	organisationMemberRequest
+}
+
+/*
+addOrganisationMember adds a user to an organisation administered by the authenticated user
+
+Path: POST /organisations/:id/members
+
+Body addOrganisationMemberRequest
+*/
+func (server *Server) addOrganisationMember(ctx *gin.Context) {
+	var uri organisationURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req addOrganisationMemberRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.organisationMember(ctx, uri.ID, util.AccountPermissionManage); !ok {
+		return
+	}
+
+	user, err := server.store.GetUser(ctx, req.Username)
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			err := fmt.Errorf("user %s not found", req.Username)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	member, err := server.store.CreateOrganisationMember(ctx, db.CreateOrganisationMemberParams{
+		OrganisationID: uri.ID,
+		UserID:         user.ID,
+		Role:           req.Role,
+		TransferLimit:  req.transferLimit(),
+	})
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation" {
+			err := fmt.Errorf("user %s is already a member of organisation %d", req.Username, uri.ID)
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, member)
+}
+
+/*
+updateOrganisationMember replaces the role and the transfer limit of another member of an organisation
+
+Path: PUT /organisations/:id/members/:user_id
+
+Body organisationMemberRequest
+*/
+func (server *Server) updateOrganisationMember(ctx *gin.Context) {
+	var uri organisationMemberURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req organisationMemberRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.organisationMember(ctx, uri.ID, util.AccountPermissionManage); !ok {
+		return
+	}
+
+	// another admin has to change an admin, so an organisation always keeps one
+	if uri.UserID == authPayload(ctx).UserID {
+		err := errors.New("admins can't change their own membership")
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	member, err := server.store.UpdateOrganisationMember(ctx, db.UpdateOrganisationMemberParams{
+		OrganisationID: uri.ID,
+		UserID:         uri.UserID,
+		Role:           req.Role,
+		TransferLimit:  req.transferLimit(),
+	})
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			err := fmt.Errorf("user %d is not a member of organisation %d", uri.UserID, uri.ID)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, member)
+}
+
+/*
+deleteOrganisationMember removes a member from an organisation, admins remove other members
+and the other members can only leave
+
+Path: DELETE /organisations/:id/members/:user_id
+*/
+func (server *Server) deleteOrganisationMember(ctx *gin.Context) {
+	var uri organisationMemberURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	permission := util.AccountPermissionManage
+	if uri.UserID == authPayload(ctx).UserID {
+		permission = util.AccountPermissionView
+	}
+
+	self, ok := server.organisationMember(ctx, uri.ID, permission)
+	if !ok {
+		return
+	}
+
+	if uri.UserID == self.UserID && self.Role == util.OrganisationRoleAdmin {
+		err := errors.New("admins can't leave an organisation, another admin has to remove them")
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	_, err := server.store.GetOrganisationMember(ctx, db.GetOrganisationMemberParams{
+		OrganisationID: uri.ID,
+		UserID:         uri.UserID,
+	})
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			err := fmt.Errorf("user %d is not a member of organisation %d", uri.UserID, uri.ID)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	err = server.store.DeleteOrganisationMember(ctx, db.DeleteOrganisationMemberParams{
+		OrganisationID: uri.ID,
+		UserID:         uri.UserID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+type createOrganisationAccountRequest struct {
+	Currency string `json:"currency" binding:"required,currency"`
+}
+
+/*
+createOrganisationAccount opens an empty account owned by an organisation, an organisation
+owns at most one account per currency
+
+Path: POST /organisations/:id/accounts
+
+Body createOrganisationAccountRequest
+*/
+func (server *Server) createOrganisationAccount(ctx *gin.Context) {
+	var uri organisationURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req createOrganisationAccountRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.organisationMember(ctx, uri.ID, util.AccountPermissionManage); !ok {
+		return
+	}
+
+	account, err := server.store.CreateOrganisationAccount(ctx, db.CreateOrganisationAccountParams{
+		UserID:         authPayload(ctx).UserID,
+		Currency:       req.Currency,
+		OrganisationID: uri.ID,
+	})
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation" {
+			err := fmt.Errorf("organisation %d already owns a %s account", uri.ID, req.Currency)
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, account)
+}
+
+/*
+listOrganisationAccounts lists the accounts of an organisation
+
+Path: GET /organisations/:id/accounts
+*/
+func (server *Server) listOrganisationAccounts(ctx *gin.Context) {
+	var uri organisationURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.organisationMember(ctx, uri.ID, util.AccountPermissionView); !ok {
+		return
+	}
+
+	accounts, err := server.store.ListOrganisationAccounts(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, accounts)
+}
+
+// organisationMember loads the membership of the authenticated user in an organisation and checks its role
+// grants the permission, the organisations of other users are reported as not found
+func (server *Server) organisationMember(ctx *gin.Context, organisationID int64, permission string) (db.OrganisationMember, bool) {
+	member, err := server.store.GetOrganisationMember(ctx, db.GetOrganisationMemberParams{
+		OrganisationID: organisationID,
+		UserID:         authPayload(ctx).UserID,
+	})
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			err := fmt.Errorf("organisation %d not found", organisationID)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return member, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return member, false
+	}
+
+	if !util.MemberCan(member.Role, permission) {
+		err := fmt.Errorf("a %s of organisation %d doesn't have the %s permission", member.Role, organisationID, permission)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return member, false
+	}
+
+	return member, true
+}
@@ -0,0 +1,235 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// expectOrganisationMembers makes members, by user id, the members of organisation 1
+func expectOrganisationMembers(store *mocks.MockStore, members map[int64]db.OrganisationMember) {
+	store.EXPECT().
+		GetOrganisationMember(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.GetOrganisationMemberParams) (db.OrganisationMember, error) {
+			member, ok := members[arg.UserID]
+			if !ok || arg.OrganisationID != 1 {
+				return db.OrganisationMember{}, sql.ErrNoRows
+			}
+			member.OrganisationID = arg.OrganisationID
+			member.UserID = arg.UserID
+			return member, nil
+		}).
+		AnyTimes()
+}
+
+func TestAddOrganisationMember(t *testing.T) {
+	members := map[int64]db.OrganisationMember{
+		1: {Role: util.OrganisationRoleAdmin},
+		2: {Role: util.OrganisationRoleInitiator},
+	}
+
+	testCases := []struct {
+		name               string
+		userID             int64
+		body               string
+		GetUserTimes       int
+		GetUserErr         error
+		CreateMemberErr    error
+		CreateMemberTimes  int
+		expectedStatusCode int
+	}{
+		{name: "unknown role", userID: 1, body: `{"username":"bob","role":"owner"}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "negative transfer limit", userID: 1, body: `{"username":"bob","role":"initiator","transfer_limit":-1}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "not a member", userID: 3, body: `{"username":"bob","role":"viewer"}`, expectedStatusCode: http.StatusNotFound},
+		{name: "initiator", userID: 2, body: `{"username":"bob","role":"viewer"}`, expectedStatusCode: http.StatusForbidden},
+		{name: "unknown user", userID: 1, body: `{"username":"bob","role":"viewer"}`, GetUserTimes: 1, GetUserErr: sql.ErrNoRows, expectedStatusCode: http.StatusNotFound},
+		{name: "already a member", userID: 1, body: `{"username":"bob","role":"initiator","transfer_limit":5000}`, GetUserTimes: 1, CreateMemberErr: &pq.Error{Code: "23505"}, CreateMemberTimes: 1, expectedStatusCode: http.StatusForbidden},
+		{name: "initiator with a transfer limit", userID: 1, body: `{"username":"bob","role":"initiator","transfer_limit":5000}`, GetUserTimes: 1, CreateMemberTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			expectOrganisationMembers(mockStore, members)
+			mockStore.EXPECT().
+				GetUser(gomock.Any(), "bob").
+				Return(db.User{ID: 4, Username: "bob"}, tc.GetUserErr).
+				Times(tc.GetUserTimes)
+			mockStore.EXPECT().
+				CreateOrganisationMember(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.CreateOrganisationMemberParams) (db.OrganisationMember, error) {
+					require.Equal(t, int64(4), arg.UserID)
+					require.Equal(t, util.OrganisationRoleInitiator, arg.Role)
+					require.Equal(t, sql.NullInt64{Int64: 5000, Valid: true}, arg.TransferLimit)
+					return db.OrganisationMember{OrganisationID: arg.OrganisationID, UserID: arg.UserID, Role: arg.Role, TransferLimit: arg.TransferLimit}, tc.CreateMemberErr
+				}).
+				Times(tc.CreateMemberTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/organisations/1/members", strings.NewReader(tc.body))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.userID, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestUpdateOrganisationMember(t *testing.T) {
+	members := map[int64]db.OrganisationMember{
+		1: {Role: util.OrganisationRoleAdmin},
+		2: {Role: util.OrganisationRoleApprover},
+	}
+
+	testCases := []struct {
+		name               string
+		userID             int64
+		memberID           int64
+		UpdateErr          error
+		UpdateTimes        int
+		expectedStatusCode int
+	}{
+		{name: "approver", userID: 2, memberID: 3, expectedStatusCode: http.StatusForbidden},
+		{name: "own membership", userID: 1, memberID: 1, expectedStatusCode: http.StatusBadRequest},
+		{name: "not a member", userID: 1, memberID: 3, UpdateErr: sql.ErrNoRows, UpdateTimes: 1, expectedStatusCode: http.StatusNotFound},
+		{name: "admin", userID: 1, memberID: 2, UpdateTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			expectOrganisationMembers(mockStore, members)
+			mockStore.EXPECT().
+				UpdateOrganisationMember(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.UpdateOrganisationMemberParams) (db.OrganisationMember, error) {
+					require.Equal(t, tc.memberID, arg.UserID)
+					require.Equal(t, util.OrganisationRoleViewer, arg.Role)
+					require.False(t, arg.TransferLimit.Valid)
+					return db.OrganisationMember{OrganisationID: arg.OrganisationID, UserID: arg.UserID, Role: arg.Role}, tc.UpdateErr
+				}).
+				Times(tc.UpdateTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/organisations/1/members/%d", tc.memberID)
+			request, err := http.NewRequest(http.MethodPut, url, strings.NewReader(`{"role":"viewer"}`))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.userID, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestDeleteOrganisationMember(t *testing.T) {
+	members := map[int64]db.OrganisationMember{
+		1: {Role: util.OrganisationRoleAdmin},
+		2: {Role: util.OrganisationRoleViewer},
+		3: {Role: util.OrganisationRoleInitiator},
+	}
+
+	testCases := []struct {
+		name               string
+		userID             int64
+		memberID           int64
+		DeleteTimes        int
+		expectedStatusCode int
+	}{
+		{name: "viewer removes another member", userID: 2, memberID: 3, expectedStatusCode: http.StatusForbidden},
+		{name: "admin leaves", userID: 1, memberID: 1, expectedStatusCode: http.StatusBadRequest},
+		{name: "not a member", userID: 1, memberID: 4, expectedStatusCode: http.StatusNotFound},
+		{name: "viewer leaves", userID: 2, memberID: 2, DeleteTimes: 1, expectedStatusCode: http.StatusOK},
+		{name: "admin removes a member", userID: 1, memberID: 3, DeleteTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			expectOrganisationMembers(mockStore, members)
+			mockStore.EXPECT().
+				DeleteOrganisationMember(gomock.Any(), db.DeleteOrganisationMemberParams{OrganisationID: 1, UserID: tc.memberID}).
+				Return(nil).
+				Times(tc.DeleteTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/organisations/1/members/%d", tc.memberID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.userID, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestCreateOrganisationAccount(t *testing.T) {
+	members := map[int64]db.OrganisationMember{
+		1: {Role: util.OrganisationRoleAdmin},
+		2: {Role: util.OrganisationRoleApprover},
+	}
+
+	testCases := []struct {
+		name               string
+		userID             int64
+		CreateErr          error
+		CreateTimes        int
+		expectedStatusCode int
+	}{
+		{name: "approver", userID: 2, expectedStatusCode: http.StatusForbidden},
+		{name: "currency already owned", userID: 1, CreateErr: &pq.Error{Code: "23505"}, CreateTimes: 1, expectedStatusCode: http.StatusForbidden},
+		{name: "admin", userID: 1, CreateTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			expectOrganisationMembers(mockStore, members)
+			mockStore.EXPECT().
+				CreateOrganisationAccount(gomock.Any(), db.CreateOrganisationAccountParams{UserID: tc.userID, Currency: util.EUR, OrganisationID: 1}).
+				Return(db.Account{ID: 5, UserID: tc.userID, Currency: util.EUR, Kind: util.AccountKindCustomer, OrganisationID: sql.NullInt64{Int64: 1, Valid: true}}, tc.CreateErr).
+				Times(tc.CreateTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/organisations/1/accounts", strings.NewReader(`{"currency":"EUR"}`))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.userID, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Sinothic/simplebank/access"
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/nacha"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/gin-gonic/gin"
+)
+
+// createOutboundPaymentRequest pays a beneficiary, the amount must fit in the 10 digit amount field
+// of a NACHA entry (nacha.MaxEntryAmount)
+type createOutboundPaymentRequest struct {
+	AccountID     int64 `json:"account_id" binding:"required,min=1"`
+	BeneficiaryID int64 `json:"beneficiary_id" binding:"required,min=1"`
+	Amount        int64 `json:"amount" binding:"required,gt=0,max=9999999999"`
+}
+
+/*
+createACHPayment debits a USD account of the authenticated user to pay one of their beneficiaries,
+the payment is sent with the next daily NACHA file
+
+Path: POST /ach-payments
+
+Body createOutboundPaymentRequest
+*/
+func (server *Server) createACHPayment(ctx *gin.Context) {
+	server.createOutboundPayment(ctx, util.USD)
+}
+
+/*
+createSEPAPayment debits a EUR account of the authenticated user to pay one of their beneficiaries,
+the payment is sent with the next pain.001 SEPA credit transfer batch
+
+Path: POST /sepa-payments
+
+Body createOutboundPaymentRequest
+*/
+func (server *Server) createSEPAPayment(ctx *gin.Context) {
+	server.createOutboundPayment(ctx, util.EUR)
+}
+
+func (server *Server) createOutboundPayment(ctx *gin.Context, currency string) {
+	var req createOutboundPaymentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, ok := server.debitAccount(ctx, req.AccountID, access.Destination{BeneficiaryID: req.BeneficiaryID}, req.Amount)
+	if !ok {
+		return
+	}
+
+	if account.Kind != util.AccountKindCustomer || account.Currency != currency {
+		err := fmt.Errorf("these payments can only be sent from %s customer accounts", currency)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := server.store.CreateOutboundPaymentTx(ctx, db.CreateOutboundPaymentTxParams{
+		UserID:        authPayload(ctx).UserID,
+		AccountID:     req.AccountID,
+		BeneficiaryID: req.BeneficiaryID,
+		Amount:        req.Amount,
+	})
+	if err != nil {
+		outboundPaymentErrorResponse(ctx, err, req.BeneficiaryID)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// outboundPaymentErrorResponse maps the errors of CreateOutboundPaymentTx to a response
+func outboundPaymentErrorResponse(ctx *gin.Context, err error, beneficiaryID int64) {
+	switch {
+	case err.Error() == sql.ErrNoRows.Error():
+		ctx.JSON(http.StatusNotFound, errorResponse(fmt.Errorf("beneficiary %d not found", beneficiaryID)))
+	case errors.Is(err, db.ErrBeneficiaryNotOwned):
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+	case errors.Is(err, db.ErrBeneficiaryCurrencyMismatch), errors.Is(err, db.ErrBeneficiaryOutsideSEPA):
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+	case errors.Is(err, db.ErrInsufficientFunds):
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+	default:
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+	}
+}
+
+type listOutboundPaymentsRequest struct {
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
+}
+
+/*
+listOutboundPayments lists the outbound payments of an account of the authenticated user with their status
+
+Path: GET /accounts/:id/ach-payments
+
+Path: GET /accounts/:id/sepa-payments
+*/
+func (server *Server) listOutboundPayments(ctx *gin.Context) {
+	var uri externalTransferURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req listOutboundPaymentsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.heldAccount(ctx, uri.ID, util.AccountPermissionView); !ok {
+		return
+	}
+
+	payments, err := server.store.ListOutboundPayments(ctx, db.ListOutboundPaymentsParams{
+		AccountID: uri.ID,
+		Limit:     req.PageSize,
+		Offset:    (req.PageID - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, payments)
+}
+
+/*
+importACHReturns gives back the money of the payments returned or rejected by the receiving banks
+
+Path: POST /admin/ach/returns
+
+Body NACHA return file
+*/
+func (server *Server) importACHReturns(ctx *gin.Context) {
+	returns, err := nacha.ParseReturns(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxPaymentBatchSize))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := nacha.NewExporter(server.store, server.config).ImportReturns(ctx, returns)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,256 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/nacha"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateOutboundPayment(t *testing.T) {
+
+	type apiTest[A any, R any] struct {
+		Argument A
+		Response R
+		Err      error
+		Times    int
+	}
+
+	account := db.Account{ID: 1, UserID: 1, Balance: 100, Currency: util.USD, Kind: util.AccountKindCustomer}
+	request := createOutboundPaymentRequest{AccountID: 1, BeneficiaryID: 3, Amount: 40}
+	arg := db.CreateOutboundPaymentTxParams{AccountID: 1, UserID: 1, BeneficiaryID: 3, Amount: 40}
+
+	testCases := []struct {
+		name                    string
+		path                    string
+		request                 createOutboundPaymentRequest
+		GetAccount              apiTest[int64, db.Account]
+		CreateOutboundPaymentTx apiTest[db.CreateOutboundPaymentTxParams, db.OutboundPaymentTxResult]
+		expectedStatusCode      int
+	}{
+		{
+			name:               "bad request error (missing beneficiary)",
+			request:            createOutboundPaymentRequest{AccountID: 1, Amount: 40},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "bad request error (amount too large for a NACHA entry)",
+			request:            createOutboundPaymentRequest{AccountID: 1, BeneficiaryID: 3, Amount: nacha.MaxEntryAmount + 1},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:    "account of another user",
+			request: request,
+			GetAccount: apiTest[int64, db.Account]{
+				Response: db.Account{ID: 1, UserID: 2, Currency: util.USD, Kind: util.AccountKindCustomer},
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:    "not a USD account",
+			request: request,
+			GetAccount: apiTest[int64, db.Account]{
+				Response: db.Account{ID: 1, UserID: 1, Currency: util.EUR, Kind: util.AccountKindCustomer},
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:    "beneficiary not found",
+			request: request,
+			GetAccount: apiTest[int64, db.Account]{
+				Response: account,
+				Times:    1,
+			},
+			CreateOutboundPaymentTx: apiTest[db.CreateOutboundPaymentTxParams, db.OutboundPaymentTxResult]{
+				Argument: arg,
+				Err:      sql.ErrNoRows,
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:    "beneficiary of another user",
+			request: request,
+			GetAccount: apiTest[int64, db.Account]{
+				Response: account,
+				Times:    1,
+			},
+			CreateOutboundPaymentTx: apiTest[db.CreateOutboundPaymentTxParams, db.OutboundPaymentTxResult]{
+				Argument: arg,
+				Err:      db.ErrBeneficiaryNotOwned,
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:    "insufficient funds",
+			request: request,
+			GetAccount: apiTest[int64, db.Account]{
+				Response: account,
+				Times:    1,
+			},
+			CreateOutboundPaymentTx: apiTest[db.CreateOutboundPaymentTxParams, db.OutboundPaymentTxResult]{
+				Argument: arg,
+				Err:      db.ErrInsufficientFunds,
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:    "beneficiary in another currency",
+			request: request,
+			GetAccount: apiTest[int64, db.Account]{
+				Response: account,
+				Times:    1,
+			},
+			CreateOutboundPaymentTx: apiTest[db.CreateOutboundPaymentTxParams, db.OutboundPaymentTxResult]{
+				Argument: arg,
+				Err:      db.ErrBeneficiaryCurrencyMismatch,
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:    "sepa payment from a USD account",
+			path:    "/sepa-payments",
+			request: request,
+			GetAccount: apiTest[int64, db.Account]{
+				Response: account,
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:    "successful sepa payment",
+			path:    "/sepa-payments",
+			request: request,
+			GetAccount: apiTest[int64, db.Account]{
+				Response: db.Account{ID: 1, UserID: 1, Balance: 100, Currency: util.EUR, Kind: util.AccountKindCustomer},
+				Times:    1,
+			},
+			CreateOutboundPaymentTx: apiTest[db.CreateOutboundPaymentTxParams, db.OutboundPaymentTxResult]{
+				Argument: arg,
+				Response: db.OutboundPaymentTxResult{
+					OutboundPayment: db.OutboundPayment{ID: 10, AccountID: 1, BeneficiaryID: 3, Amount: 40, Currency: util.EUR, Rail: util.OutboundRailSEPA, Status: util.OutboundPaymentStatusPending},
+					AccountEntry:    db.Entry{ID: 5, AccountID: 1, Amount: -40, Metadata: json.RawMessage(`{}`)},
+					ClearingEntry:   db.Entry{ID: 6, AccountID: 8, Amount: 40, Metadata: json.RawMessage(`{}`)},
+				},
+				Times: 1,
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:    "successful request",
+			request: request,
+			GetAccount: apiTest[int64, db.Account]{
+				Response: account,
+				Times:    1,
+			},
+			CreateOutboundPaymentTx: apiTest[db.CreateOutboundPaymentTxParams, db.OutboundPaymentTxResult]{
+				Argument: arg,
+				Response: db.OutboundPaymentTxResult{
+					OutboundPayment: db.OutboundPayment{ID: 9, AccountID: 1, BeneficiaryID: 3, Amount: 40, Currency: util.USD, Status: util.OutboundPaymentStatusPending},
+					Account:         db.Account{ID: 1, UserID: 1, Balance: 60, Currency: util.USD, Kind: util.AccountKindCustomer},
+					AccountEntry:    db.Entry{ID: 3, AccountID: 1, Amount: -40, Metadata: json.RawMessage(`{}`)},
+					ClearingEntry:   db.Entry{ID: 4, AccountID: 7, Amount: 40, Metadata: json.RawMessage(`{}`)},
+				},
+				Times: 1,
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				GetAccount(gomock.Any(), int64(1)).
+				Return(tc.GetAccount.Response, tc.GetAccount.Err).
+				Times(tc.GetAccount.Times)
+			if tc.GetAccount.Response.UserID == 1 {
+				expectHolders(mockStore, map[int64]string{1: util.AccountRoleOwner})
+			} else {
+				expectHolders(mockStore, nil)
+			}
+
+			mockStore.EXPECT().
+				CreateOutboundPaymentTx(gomock.Any(), tc.CreateOutboundPaymentTx.Argument).
+				Return(tc.CreateOutboundPaymentTx.Response, tc.CreateOutboundPaymentTx.Err).
+				Times(tc.CreateOutboundPaymentTx.Times)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			bodyJson, err := json.Marshal(tc.request)
+			require.NoError(t, err)
+
+			path := tc.path
+			if path == "" {
+				path = "/ach-payments"
+			}
+
+			request, err := http.NewRequest(http.MethodPost, path, bytes.NewReader(bodyJson))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+
+			if tc.expectedStatusCode == http.StatusOK {
+				var response db.OutboundPaymentTxResult
+				err = json.Unmarshal(recorder.Body.Bytes(), &response)
+				require.NoError(t, err)
+				require.Equal(t, tc.CreateOutboundPaymentTx.Response, response)
+			}
+		})
+	}
+}
+
+func TestImportACHReturns(t *testing.T) {
+	returns, err := os.ReadFile("../nacha/testdata/returns.ach")
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockStore(ctrl)
+	expectAdminAuditLogs(mockStore)
+	mockStore.EXPECT().
+		ReturnOutboundPaymentTx(gomock.Any(), db.ReturnOutboundPaymentTxParams{ID: 1, TraceNumber: "011000010000001", ReturnCode: "R01"}).
+		Return(db.OutboundPaymentTxResult{OutboundPayment: db.OutboundPayment{ID: 1, Status: util.OutboundPaymentStatusReturned}}, nil)
+	mockStore.EXPECT().
+		ReturnOutboundPaymentTx(gomock.Any(), db.ReturnOutboundPaymentTxParams{ID: 3, TraceNumber: "011000010000003", ReturnCode: "R03"}).
+		Return(db.OutboundPaymentTxResult{}, sql.ErrNoRows)
+
+	server := newTestServer(t, mockStore)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodPost, "/admin/ach/returns", bytes.NewReader(returns))
+	require.NoError(t, err)
+	addRoleAuthorization(t, request, server.tokenMaker, 1, util.UserRoleAdmin)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var response nacha.ReturnsResult
+	err = json.Unmarshal(recorder.Body.Bytes(), &response)
+	require.NoError(t, err)
+	require.Len(t, response.Returned, 1)
+	require.Len(t, response.Skipped, 1)
+}
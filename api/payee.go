@@ -0,0 +1,243 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
+)
+
+// createPayeeRequest saves either an account of the bank or one of the user's external beneficiaries.
+// HolderName is checked against the owner of the account to verify the payee
+type createPayeeRequest struct {
+	Nickname      string `json:"nickname" binding:"required,max=50"`
+	AccountID     int64  `json:"account_id" binding:"required_without=BeneficiaryID,excluded_with=BeneficiaryID,omitempty,min=1"`
+	BeneficiaryID int64  `json:"beneficiary_id" binding:"omitempty,min=1"`
+	HolderName    string `json:"holder_name"`
+}
+
+/*
+createPayee saves a payee the authenticated user can transfer to
+
+Path: POST /users/me/payees
+
+Body createPayeeRequest
+*/
+func (server *Server) createPayee(ctx *gin.Context) {
+	var req createPayeeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	payload := authPayload(ctx)
+	arg := db.CreatePayeeParams{
+		UserID:   payload.UserID,
+		Nickname: req.Nickname,
+	}
+
+	if req.AccountID != 0 {
+		account, err := server.store.GetAccount(ctx, req.AccountID)
+		if err != nil {
+			if err.Error() == sql.ErrNoRows.Error() {
+				ctx.JSON(http.StatusNotFound, errorResponse(err))
+				return
+			}
+
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		if account.Kind != util.AccountKindCustomer {
+			err := fmt.Errorf("account %d is not a customer account", req.AccountID)
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		holder, err := server.store.GetUserByID(ctx, account.UserID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		arg.AccountID = sql.NullInt64{Int64: account.ID, Valid: true}
+		arg.Verified = req.HolderName != "" && strings.EqualFold(strings.TrimSpace(req.HolderName), holder.FullName)
+	} else {
+		beneficiary, err := server.store.GetBeneficiary(ctx, req.BeneficiaryID)
+		if err != nil && err.Error() != sql.ErrNoRows.Error() {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+
+		if err != nil || beneficiary.UserID != payload.UserID {
+			err := fmt.Errorf("beneficiary %d not found", req.BeneficiaryID)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		arg.BeneficiaryID = sql.NullInt64{Int64: beneficiary.ID, Valid: true}
+	}
+
+	payee, err := server.store.CreatePayee(ctx, arg)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation" {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, payee)
+}
+
+type payeeURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+/*
+getPayee returns a payee of the authenticated user
+
+Path: GET /users/me/payees/:id
+*/
+func (server *Server) getPayee(ctx *gin.Context) {
+	var uri payeeURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	payee, ok := server.ownedPayee(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, payee)
+}
+
+type listPayeesRequest struct {
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
+}
+
+/*
+listPayees lists the payees of the authenticated user
+
+Path: GET /users/me/payees
+*/
+func (server *Server) listPayees(ctx *gin.Context) {
+	var req listPayeesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	payees, err := server.store.ListPayees(ctx, db.ListPayeesParams{
+		UserID: authPayload(ctx).UserID,
+		Limit:  req.PageSize,
+		Offset: (req.PageID - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, payees)
+}
+
+type updatePayeeRequest struct {
+	Nickname string `json:"nickname" binding:"required,max=50"`
+}
+
+/*
+updatePayee renames a payee of the authenticated user, the target of a payee can't change
+
+Path: PATCH /users/me/payees/:id
+
+Body updatePayeeRequest
+*/
+func (server *Server) updatePayee(ctx *gin.Context) {
+	var uri payeeURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req updatePayeeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.ownedPayee(ctx, uri.ID); !ok {
+		return
+	}
+
+	payee, err := server.store.UpdatePayeeNickname(ctx, db.UpdatePayeeNicknameParams{
+		ID:       uri.ID,
+		Nickname: req.Nickname,
+	})
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation" {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, payee)
+}
+
+/*
+deletePayee removes a payee of the authenticated user
+
+Path: DELETE /users/me/payees/:id
+*/
+func (server *Server) deletePayee(ctx *gin.Context) {
+	var uri payeeURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.ownedPayee(ctx, uri.ID); !ok {
+		return
+	}
+
+	err := server.store.DeletePayee(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+// ownedPayee loads a payee of the authenticated user, the payees of other users are reported
+// as not found so their ids don't leak
+func (server *Server) ownedPayee(ctx *gin.Context, payeeID int64) (db.Payee, bool) {
+	payee, err := server.store.GetPayee(ctx, payeeID)
+	if err != nil && err.Error() != sql.ErrNoRows.Error() {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return payee, false
+	}
+
+	if err != nil || payee.UserID != authPayload(ctx).UserID {
+		err := fmt.Errorf("payee %d not found", payeeID)
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return payee, false
+	}
+
+	return payee, true
+}
@@ -0,0 +1,360 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreatePayee(t *testing.T) {
+
+	type apiTest[A any, R any] struct {
+		Argument A
+		Response R
+		Err      error
+		Times    int
+	}
+
+	account := db.Account{ID: 2, UserID: 5, Currency: "USD", Kind: "customer"}
+	holder := db.User{ID: 5, Username: "jane", FullName: "Jane Doe"}
+	payee := db.Payee{ID: 9, UserID: 1, Nickname: "Jane", AccountID: sql.NullInt64{Int64: 2, Valid: true}, Verified: true}
+
+	testCases := []struct {
+		name               string
+		request            createPayeeRequest
+		GetAccount         apiTest[int64, db.Account]
+		GetUserByID        apiTest[int64, db.User]
+		GetBeneficiary     apiTest[int64, db.Beneficiary]
+		CreatePayee        apiTest[db.CreatePayeeParams, db.Payee]
+		expectedStatusCode int
+	}{
+		{
+			name:               "missing target",
+			request:            createPayeeRequest{Nickname: "Jane"},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "both targets",
+			request:            createPayeeRequest{Nickname: "Jane", AccountID: 2, BeneficiaryID: 3},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "account not found",
+			request:            createPayeeRequest{Nickname: "Jane", AccountID: 2},
+			GetAccount:         apiTest[int64, db.Account]{Argument: 2, Err: sql.ErrNoRows, Times: 1},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "internal account",
+			request:            createPayeeRequest{Nickname: "Jane", AccountID: 2},
+			GetAccount:         apiTest[int64, db.Account]{Argument: 2, Response: db.Account{ID: 2, Kind: "suspense"}, Times: 1},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "verified account payee",
+			request:     createPayeeRequest{Nickname: "Jane", AccountID: 2, HolderName: " jane doe "},
+			GetAccount:  apiTest[int64, db.Account]{Argument: 2, Response: account, Times: 1},
+			GetUserByID: apiTest[int64, db.User]{Argument: 5, Response: holder, Times: 1},
+			CreatePayee: apiTest[db.CreatePayeeParams, db.Payee]{
+				Argument: db.CreatePayeeParams{UserID: 1, Nickname: "Jane", AccountID: sql.NullInt64{Int64: 2, Valid: true}, Verified: true},
+				Response: payee,
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:        "unverified account payee",
+			request:     createPayeeRequest{Nickname: "Jane", AccountID: 2, HolderName: "John Doe"},
+			GetAccount:  apiTest[int64, db.Account]{Argument: 2, Response: account, Times: 1},
+			GetUserByID: apiTest[int64, db.User]{Argument: 5, Response: holder, Times: 1},
+			CreatePayee: apiTest[db.CreatePayeeParams, db.Payee]{
+				Argument: db.CreatePayeeParams{UserID: 1, Nickname: "Jane", AccountID: sql.NullInt64{Int64: 2, Valid: true}},
+				Response: db.Payee{ID: 9, UserID: 1, Nickname: "Jane", AccountID: sql.NullInt64{Int64: 2, Valid: true}},
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "beneficiary of another user",
+			request:            createPayeeRequest{Nickname: "Landlord", BeneficiaryID: 3},
+			GetBeneficiary:     apiTest[int64, db.Beneficiary]{Argument: 3, Response: db.Beneficiary{ID: 3, UserID: 2}, Times: 1},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "duplicate nickname",
+			request:        createPayeeRequest{Nickname: "Landlord", BeneficiaryID: 3},
+			GetBeneficiary: apiTest[int64, db.Beneficiary]{Argument: 3, Response: db.Beneficiary{ID: 3, UserID: 1}, Times: 1},
+			CreatePayee: apiTest[db.CreatePayeeParams, db.Payee]{
+				Argument: db.CreatePayeeParams{UserID: 1, Nickname: "Landlord", BeneficiaryID: sql.NullInt64{Int64: 3, Valid: true}},
+				Err:      &pq.Error{Code: "23505"},
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				GetAccount(gomock.Any(), tc.GetAccount.Argument).
+				Return(tc.GetAccount.Response, tc.GetAccount.Err).
+				Times(tc.GetAccount.Times)
+			mockStore.EXPECT().
+				GetUserByID(gomock.Any(), tc.GetUserByID.Argument).
+				Return(tc.GetUserByID.Response, tc.GetUserByID.Err).
+				Times(tc.GetUserByID.Times)
+			mockStore.EXPECT().
+				GetBeneficiary(gomock.Any(), tc.GetBeneficiary.Argument).
+				Return(tc.GetBeneficiary.Response, tc.GetBeneficiary.Err).
+				Times(tc.GetBeneficiary.Times)
+			mockStore.EXPECT().
+				CreatePayee(gomock.Any(), tc.CreatePayee.Argument).
+				Return(tc.CreatePayee.Response, tc.CreatePayee.Err).
+				Times(tc.CreatePayee.Times)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			bodyJson, err := json.Marshal(tc.request)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/users/me/payees", bytes.NewReader(bodyJson))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+
+			if tc.expectedStatusCode == http.StatusOK {
+				var response db.Payee
+				err = json.Unmarshal(recorder.Body.Bytes(), &response)
+				require.NoError(t, err)
+				require.Equal(t, tc.CreatePayee.Response, response)
+			}
+		})
+	}
+}
+
+func TestPayeeOwnership(t *testing.T) {
+	payee := db.Payee{ID: 9, UserID: 2, Nickname: "Jane", AccountID: sql.NullInt64{Int64: 2, Valid: true}}
+
+	testCases := []struct {
+		name               string
+		method             string
+		body               string
+		DeletePayeeTimes   int
+		userID             int64
+		expectedStatusCode int
+	}{
+		{name: "get payee of another user", method: http.MethodGet, userID: 1, expectedStatusCode: http.StatusNotFound},
+		{name: "rename payee of another user", method: http.MethodPatch, body: `{"nickname":"Mine"}`, userID: 1, expectedStatusCode: http.StatusNotFound},
+		{name: "delete payee of another user", method: http.MethodDelete, userID: 1, expectedStatusCode: http.StatusNotFound},
+		{name: "get own payee", method: http.MethodGet, userID: 2, expectedStatusCode: http.StatusOK},
+		{name: "delete own payee", method: http.MethodDelete, userID: 2, DeletePayeeTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				GetPayee(gomock.Any(), payee.ID).
+				Return(payee, nil).
+				Times(1)
+			mockStore.EXPECT().
+				UpdatePayeeNickname(gomock.Any(), gomock.Any()).
+				Times(0)
+			mockStore.EXPECT().
+				DeletePayee(gomock.Any(), payee.ID).
+				Return(nil).
+				Times(tc.DeletePayeeTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/users/me/payees/%d", payee.ID)
+			request, err := http.NewRequest(tc.method, url, bytes.NewReader([]byte(tc.body)))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.userID, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestCreatePayeeTransfer(t *testing.T) {
+	from := db.Account{ID: 1, UserID: 1, Balance: 500000, Currency: "USD", Kind: "customer"}
+	to := db.Account{ID: 2, UserID: 5, Currency: "USD", Kind: "customer"}
+	oldPayee := db.Payee{ID: 9, UserID: 1, AccountID: sql.NullInt64{Int64: 2, Valid: true}, CreatedAt: time.Now().Add(-48 * time.Hour)}
+	newPayee := oldPayee
+	newPayee.CreatedAt = time.Now().Add(-time.Hour)
+	beneficiaryPayee := db.Payee{ID: 10, UserID: 1, BeneficiaryID: sql.NullInt64{Int64: 3, Valid: true}, CreatedAt: oldPayee.CreatedAt}
+
+	testCases := []struct {
+		name                 string
+		payee                db.Payee
+		amount               int64
+		TransferTxTimes      int
+		OutboundPaymentTimes int
+		expectedStatusCode   int
+	}{
+		{name: "small transfer to a new payee", payee: newPayee, amount: 100000, TransferTxTimes: 1, expectedStatusCode: http.StatusOK},
+		{name: "large transfer to a new payee", payee: newPayee, amount: 100001, expectedStatusCode: http.StatusForbidden},
+		{name: "large transfer to an old payee", payee: oldPayee, amount: 100001, TransferTxTimes: 1, expectedStatusCode: http.StatusOK},
+		{name: "transfer to a beneficiary payee", payee: beneficiaryPayee, amount: 2500, OutboundPaymentTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				GetPayee(gomock.Any(), tc.payee.ID).
+				Return(tc.payee, nil).
+				Times(1)
+			mockStore.EXPECT().
+				GetAccount(gomock.Any(), from.ID).
+				Return(from, nil).
+				Times(1)
+			expectHolders(mockStore, map[int64]string{from.ID: util.AccountRoleCoOwner})
+			mockStore.EXPECT().
+				GetNewestPayeeOfDestination(gomock.Any(), gomock.Any()).
+				Return(tc.payee, nil).
+				AnyTimes()
+			mockStore.EXPECT().
+				GetAccount(gomock.Any(), to.ID).
+				Return(to, nil).
+				Times(tc.TransferTxTimes)
+			mockStore.EXPECT().
+				TransferTx(gomock.Any(), db.TransferTxParams{FromAccountID: from.ID, ToAccountID: to.ID, Amount: tc.amount}).
+				Return(db.TransferTxResult{}, nil).
+				Times(tc.TransferTxTimes)
+			mockStore.EXPECT().
+				CreateOutboundPaymentTx(gomock.Any(), db.CreateOutboundPaymentTxParams{AccountID: from.ID, UserID: 1, BeneficiaryID: 3, Amount: tc.amount}).
+				Return(db.OutboundPaymentTxResult{}, nil).
+				Times(tc.OutboundPaymentTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			bodyJson, err := json.Marshal(transferRequest{
+				FromAccountID: from.ID,
+				PayeeID:       tc.payee.ID,
+				Amount:        tc.amount,
+				Currency:      "USD",
+			})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/transfers", bytes.NewReader(bodyJson))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestCoolingOffWithoutPayee(t *testing.T) {
+	from := db.Account{ID: 1, UserID: 1, Balance: 500000, Currency: "USD", Kind: "customer"}
+	to := db.Account{ID: 2, UserID: 5, Currency: "USD", Kind: "customer"}
+	newPayee := db.Payee{ID: 9, UserID: 1, AccountID: sql.NullInt64{Int64: 2, Valid: true}, CreatedAt: time.Now().Add(-time.Hour)}
+	newBeneficiaryPayee := db.Payee{ID: 10, UserID: 1, BeneficiaryID: sql.NullInt64{Int64: 3, Valid: true}, CreatedAt: newPayee.CreatedAt}
+
+	testCases := []struct {
+		name               string
+		path               string
+		body               any
+		destination        db.GetNewestPayeeOfDestinationParams
+		payee              db.Payee
+		payeeErr           error
+		TransferTxTimes    int
+		expectedStatusCode int
+	}{
+		{
+			name:               "large transfer to the account of a new payee",
+			path:               "/transfers",
+			body:               transferRequest{FromAccountID: from.ID, ToAccountID: to.ID, Amount: 100001, Currency: "USD"},
+			destination:        db.GetNewestPayeeOfDestinationParams{UserID: 1, AccountID: sql.NullInt64{Int64: to.ID, Valid: true}},
+			payee:              newPayee,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "large transfer to an account that isn't a payee",
+			path:               "/transfers",
+			body:               transferRequest{FromAccountID: from.ID, ToAccountID: to.ID, Amount: 100001, Currency: "USD"},
+			destination:        db.GetNewestPayeeOfDestinationParams{UserID: 1, AccountID: sql.NullInt64{Int64: to.ID, Valid: true}},
+			payeeErr:           sql.ErrNoRows,
+			TransferTxTimes:    1,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "large ACH payment to the beneficiary of a new payee",
+			path:               "/ach-payments",
+			body:               createOutboundPaymentRequest{AccountID: from.ID, BeneficiaryID: 3, Amount: 100001},
+			destination:        db.GetNewestPayeeOfDestinationParams{UserID: 1, BeneficiaryID: sql.NullInt64{Int64: 3, Valid: true}},
+			payee:              newBeneficiaryPayee,
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				GetAccount(gomock.Any(), from.ID).
+				Return(from, nil).
+				Times(1)
+			expectHolders(mockStore, map[int64]string{from.ID: util.AccountRoleOwner})
+			mockStore.EXPECT().
+				GetAccount(gomock.Any(), to.ID).
+				Return(to, nil).
+				AnyTimes()
+			mockStore.EXPECT().
+				GetNewestPayeeOfDestination(gomock.Any(), tc.destination).
+				Return(tc.payee, tc.payeeErr).
+				Times(1)
+			mockStore.EXPECT().
+				TransferTx(gomock.Any(), gomock.Any()).
+				Return(db.TransferTxResult{}, nil).
+				Times(tc.TransferTxTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			bodyJson, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, tc.path, bytes.NewReader(bodyJson))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/Sinothic/simplebank/pain"
+	"github.com/gin-gonic/gin"
+)
+
+// maxPaymentBatchSize limits the size of uploaded pain.001 files
+const maxPaymentBatchSize = 5 << 20
+
+/*
+createPaymentBatch executes the credit transfers of a pain.001.001.09 file on behalf of the
+authenticated user and answers with a pain.002.001.10 status report
+
+Path: POST /payment-batches
+
+Body pain.001.001.09 XML document
+*/
+func (server *Server) createPaymentBatch(ctx *gin.Context) {
+	payload := authPayload(ctx)
+
+	body, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxPaymentBatchSize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			err = fmt.Errorf("payment batch is larger than %d bytes", maxPaymentBatchSize)
+			ctx.JSON(http.StatusRequestEntityTooLarge, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	doc, err := pain.Parse(bytes.NewReader(body))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	report, err := pain.NewImporter(server.store, server.config).Import(ctx, payload.UserID, doc)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	var out bytes.Buffer
+	if err := pain.WritePain002(&out, report); err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.Data(http.StatusOK, "application/xml; charset=utf-8", out.Bytes())
+}
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/pain"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreatePaymentBatch(t *testing.T) {
+	batch, err := os.ReadFile("../pain/testdata/pain001.xml")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name               string
+		body               []byte
+		authorize          bool
+		setupStore         func(store *mocks.MockStore)
+		expectedStatusCode int
+		expectedStatus     string
+	}{
+		{
+			name:               "unauthorized",
+			body:               batch,
+			setupStore:         func(store *mocks.MockStore) {},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:               "not a pain.001 message",
+			body:               []byte(`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08"/>`),
+			authorize:          true,
+			setupStore:         func(store *mocks.MockStore) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:      "duplicate message",
+			body:      batch,
+			authorize: true,
+			setupStore: func(store *mocks.MockStore) {
+				store.EXPECT().
+					GetPaymentBatch(gomock.Any(), db.GetPaymentBatchParams{UserID: 1, MessageID: "BATCH-2024-0042"}).
+					Return(db.PaymentBatch{ID: 5}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedStatus:     pain.StatusRejected,
+		},
+		{
+			name:      "internal error",
+			body:      batch,
+			authorize: true,
+			setupStore: func(store *mocks.MockStore) {
+				store.EXPECT().
+					GetPaymentBatch(gomock.Any(), gomock.Any()).
+					Return(db.PaymentBatch{}, sql.ErrConnDone)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			tc.setupStore(mockStore)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/payment-batches", bytes.NewReader(tc.body))
+			require.NoError(t, err)
+			if tc.authorize {
+				addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "acme", time.Minute)
+			}
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+
+			if tc.expectedStatusCode == http.StatusOK {
+				require.Contains(t, recorder.Header().Get("Content-Type"), "application/xml")
+
+				var report struct {
+					GroupStatus string `xml:"CstmrPmtStsRpt>OrgnlGrpInfAndSts>GrpSts"`
+				}
+				err = xml.Unmarshal(recorder.Body.Bytes(), &report)
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedStatus, report.GroupStatus)
+			}
+		})
+	}
+}
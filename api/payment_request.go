@@ -0,0 +1,304 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Sinothic/simplebank/access"
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/gin-gonic/gin"
+)
+
+// createPaymentRequestRequest asks PayerUsername to pay Amount into AccountID,
+// ExpiresAt defaults to and can't be later than the configured payment request TTL
+type createPaymentRequestRequest struct {
+	AccountID     int64      `json:"account_id" binding:"required,min=1"`
+	PayerUsername string     `json:"payer_username" binding:"required,alphanum"`
+	Amount        int64      `json:"amount" binding:"required,gt=0"`
+	Currency      string     `json:"currency" binding:"required,currency"`
+	Memo          string     `json:"memo" binding:"max=140"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+}
+
+/*
+createPaymentRequest asks another user for money, paid into an account of the authenticated user
+
+Path: POST /payment-requests
+
+Body createPaymentRequestRequest
+*/
+func (server *Server) createPaymentRequest(ctx *gin.Context) {
+	var req createPaymentRequestRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(server.config.PaymentRequestTTL)
+	if req.ExpiresAt != nil {
+		if !req.ExpiresAt.After(now) || req.ExpiresAt.After(expiresAt) {
+			err := fmt.Errorf("expires_at must be within %s from now", server.config.PaymentRequestTTL)
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+		expiresAt = *req.ExpiresAt
+	}
+
+	account, ok := server.heldAccount(ctx, req.AccountID, util.AccountPermissionTransact)
+	if !ok {
+		return
+	}
+
+	if !checkAccount(ctx, account, req.Currency) {
+		return
+	}
+
+	payer, err := server.store.GetUser(ctx, req.PayerUsername)
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			err := fmt.Errorf("user %s not found", req.PayerUsername)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	payload := authPayload(ctx)
+	if payer.ID == payload.UserID {
+		err := errors.New("can't request money from yourself")
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	paymentRequest, err := server.store.CreatePaymentRequest(ctx, db.CreatePaymentRequestParams{
+		RequesterID:        payload.UserID,
+		RequesterAccountID: account.ID,
+		PayerID:            payer.ID,
+		Amount:             req.Amount,
+		Currency:           req.Currency,
+		Memo:               req.Memo,
+		ExpiresAt:          expiresAt,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, paymentRequest)
+}
+
+// listPaymentRequestsRequest lists the requests the user has to pay (incoming) or has sent (outgoing)
+type listPaymentRequestsRequest struct {
+	Direction string `form:"direction" binding:"required,oneof=incoming outgoing"`
+	PageID    int32  `form:"page_id" binding:"required,min=1"`
+	PageSize  int32  `form:"page_size" binding:"required,min=5,max=10"`
+}
+
+/*
+listPaymentRequests lists the payment requests of the authenticated user, newest first
+
+Path: GET /payment-requests
+*/
+func (server *Server) listPaymentRequests(ctx *gin.Context) {
+	var req listPaymentRequestsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	userID := authPayload(ctx).UserID
+	limit := req.PageSize
+	offset := (req.PageID - 1) * req.PageSize
+
+	var paymentRequests []db.PaymentRequest
+	var err error
+	if req.Direction == "incoming" {
+		paymentRequests, err = server.store.ListIncomingPaymentRequests(ctx, db.ListIncomingPaymentRequestsParams{
+			PayerID: userID,
+			Limit:   limit,
+			Offset:  offset,
+		})
+	} else {
+		paymentRequests, err = server.store.ListOutgoingPaymentRequests(ctx, db.ListOutgoingPaymentRequestsParams{
+			RequesterID: userID,
+			Limit:       limit,
+			Offset:      offset,
+		})
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, paymentRequests)
+}
+
+type paymentRequestURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type acceptPaymentRequestRequest struct {
+	AccountID int64 `json:"account_id" binding:"required,min=1"`
+}
+
+/*
+acceptPaymentRequest pays a payment request addressed to the authenticated user from one of their accounts
+
+Path: POST /payment-requests/:id/accept
+
+Body acceptPaymentRequestRequest
+*/
+func (server *Server) acceptPaymentRequest(ctx *gin.Context) {
+	var uri paymentRequestURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req acceptPaymentRequestRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	request, ok := server.payerPaymentRequest(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	account, ok := server.debitAccount(ctx, req.AccountID, access.Destination{AccountID: request.RequesterAccountID}, request.Amount)
+	if !ok {
+		return
+	}
+
+	if account.Kind != util.AccountKindCustomer {
+		err := fmt.Errorf("account %d is not a customer account", account.ID)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	result, err := server.store.AcceptPaymentRequestTx(ctx, db.AcceptPaymentRequestTxParams{
+		ID:        uri.ID,
+		AccountID: account.ID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrPaymentRequestNotPending),
+			errors.Is(err, db.ErrPaymentRequestExpired),
+			errors.Is(err, db.ErrPaymentRequestCurrencyMismatch),
+			errors.Is(err, db.ErrInsufficientFunds):
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+/*
+declinePaymentRequest refuses a pending payment request addressed to the authenticated user
+
+Path: POST /payment-requests/:id/decline
+*/
+func (server *Server) declinePaymentRequest(ctx *gin.Context) {
+	var uri paymentRequestURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.payerPaymentRequest(ctx, uri.ID); !ok {
+		return
+	}
+
+	server.closePaymentRequest(ctx, uri.ID, util.PaymentRequestStatusDeclined)
+}
+
+/*
+cancelPaymentRequest withdraws a pending payment request sent by the authenticated user
+
+Path: POST /payment-requests/:id/cancel
+*/
+func (server *Server) cancelPaymentRequest(ctx *gin.Context) {
+	var uri paymentRequestURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	paymentRequest, ok := server.visiblePaymentRequest(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	if paymentRequest.RequesterID != authPayload(ctx).UserID {
+		err := errors.New("only the requester can cancel a payment request")
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
+	}
+
+	server.closePaymentRequest(ctx, uri.ID, util.PaymentRequestStatusCancelled)
+}
+
+// closePaymentRequest moves a pending payment request to a final status
+func (server *Server) closePaymentRequest(ctx *gin.Context, id int64, status string) {
+	paymentRequest, err := server.store.UpdatePendingPaymentRequestStatus(ctx, db.UpdatePendingPaymentRequestStatusParams{
+		ID:     id,
+		Status: status,
+	})
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			ctx.JSON(http.StatusBadRequest, errorResponse(db.ErrPaymentRequestNotPending))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, paymentRequest)
+}
+
+// payerPaymentRequest loads a payment request the authenticated user has to pay
+func (server *Server) payerPaymentRequest(ctx *gin.Context, id int64) (db.PaymentRequest, bool) {
+	paymentRequest, ok := server.visiblePaymentRequest(ctx, id)
+	if !ok {
+		return paymentRequest, false
+	}
+
+	if paymentRequest.PayerID != authPayload(ctx).UserID {
+		err := errors.New("only the payer can answer a payment request")
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return paymentRequest, false
+	}
+
+	return paymentRequest, true
+}
+
+// visiblePaymentRequest loads a payment request sent or received by the authenticated user,
+// other requests are reported as not found
+func (server *Server) visiblePaymentRequest(ctx *gin.Context, id int64) (db.PaymentRequest, bool) {
+	paymentRequest, err := server.store.GetPaymentRequest(ctx, id)
+	if err != nil && err.Error() != sql.ErrNoRows.Error() {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return paymentRequest, false
+	}
+
+	userID := authPayload(ctx).UserID
+	if err != nil || (paymentRequest.RequesterID != userID && paymentRequest.PayerID != userID) {
+		err := fmt.Errorf("payment request %d not found", id)
+		ctx.JSON(http.StatusNotFound, errorResponse(err))
+		return paymentRequest, false
+	}
+
+	return paymentRequest, true
+}
@@ -0,0 +1,200 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreatePaymentRequest(t *testing.T) {
+
+	type apiTest[A any, R any] struct {
+		Argument A
+		Response R
+		Err      error
+		Times    int
+	}
+
+	account := db.Account{ID: 1, UserID: 1, Currency: util.USD, Kind: util.AccountKindCustomer}
+	payer := db.User{ID: 2, Username: "jane"}
+	valid := createPaymentRequestRequest{AccountID: 1, PayerUsername: "jane", Amount: 2500, Currency: util.USD, Memo: "dinner"}
+	tooLate := valid
+	expiresAt := time.Now().Add(30 * 24 * time.Hour)
+	tooLate.ExpiresAt = &expiresAt
+
+	testCases := []struct {
+		name                 string
+		request              createPaymentRequestRequest
+		GetAccount           apiTest[int64, db.Account]
+		GetUser              apiTest[string, db.User]
+		CreatePaymentRequest apiTest[db.CreatePaymentRequestParams, db.PaymentRequest]
+		expectedStatusCode   int
+	}{
+		{
+			name:               "expiry beyond the ttl",
+			request:            tooLate,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "account of another user",
+			request:            valid,
+			GetAccount:         apiTest[int64, db.Account]{Argument: 1, Response: db.Account{ID: 1, UserID: 3, Currency: util.USD, Kind: util.AccountKindCustomer}, Times: 1},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "unknown payer",
+			request:            valid,
+			GetAccount:         apiTest[int64, db.Account]{Argument: 1, Response: account, Times: 1},
+			GetUser:            apiTest[string, db.User]{Argument: "jane", Err: sql.ErrNoRows, Times: 1},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "requesting from yourself",
+			request:            valid,
+			GetAccount:         apiTest[int64, db.Account]{Argument: 1, Response: account, Times: 1},
+			GetUser:            apiTest[string, db.User]{Argument: "jane", Response: db.User{ID: 1, Username: "jane"}, Times: 1},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "successful request",
+			request:    valid,
+			GetAccount: apiTest[int64, db.Account]{Argument: 1, Response: account, Times: 1},
+			GetUser:    apiTest[string, db.User]{Argument: "jane", Response: payer, Times: 1},
+			CreatePaymentRequest: apiTest[db.CreatePaymentRequestParams, db.PaymentRequest]{
+				Response: db.PaymentRequest{ID: 7, RequesterID: 1, RequesterAccountID: 1, PayerID: 2, Amount: 2500, Currency: util.USD, Memo: "dinner", Status: util.PaymentRequestStatusPending},
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				GetAccount(gomock.Any(), tc.GetAccount.Argument).
+				Return(tc.GetAccount.Response, tc.GetAccount.Err).
+				Times(tc.GetAccount.Times)
+			if tc.GetAccount.Response.UserID == 1 {
+				expectHolders(mockStore, map[int64]string{1: util.AccountRoleOwner})
+			} else {
+				expectHolders(mockStore, nil)
+			}
+			mockStore.EXPECT().
+				GetUser(gomock.Any(), tc.GetUser.Argument).
+				Return(tc.GetUser.Response, tc.GetUser.Err).
+				Times(tc.GetUser.Times)
+			mockStore.EXPECT().
+				CreatePaymentRequest(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, arg db.CreatePaymentRequestParams) (db.PaymentRequest, error) {
+					require.Equal(t, int64(1), arg.RequesterID)
+					require.Equal(t, payer.ID, arg.PayerID)
+					require.Equal(t, tc.request.Amount, arg.Amount)
+					require.WithinDuration(t, time.Now().Add(7*24*time.Hour), arg.ExpiresAt, time.Minute)
+					return tc.CreatePaymentRequest.Response, tc.CreatePaymentRequest.Err
+				}).
+				Times(tc.CreatePaymentRequest.Times)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			bodyJson, err := json.Marshal(tc.request)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/payment-requests", bytes.NewReader(bodyJson))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+
+			if tc.expectedStatusCode == http.StatusOK {
+				var response db.PaymentRequest
+				err = json.Unmarshal(recorder.Body.Bytes(), &response)
+				require.NoError(t, err)
+				require.Equal(t, tc.CreatePaymentRequest.Response, response)
+			}
+		})
+	}
+}
+
+func TestAnswerPaymentRequest(t *testing.T) {
+	paymentRequest := db.PaymentRequest{ID: 7, RequesterID: 1, RequesterAccountID: 1, PayerID: 2, Amount: 2500, Currency: util.USD, Status: util.PaymentRequestStatusPending}
+	payerAccount := db.Account{ID: 4, UserID: 2, Balance: 5000, Currency: util.USD, Kind: util.AccountKindCustomer}
+
+	testCases := []struct {
+		name               string
+		action             string
+		body               string
+		userID             int64
+		GetAccountTimes    int
+		AcceptTimes        int
+		AcceptErr          error
+		UpdateTimes        int
+		UpdateErr          error
+		expectedStatus     string
+		expectedStatusCode int
+	}{
+		{name: "stranger", action: "accept", body: `{"account_id":4}`, userID: 3, expectedStatusCode: http.StatusNotFound},
+		{name: "requester accepting", action: "accept", body: `{"account_id":4}`, userID: 1, expectedStatusCode: http.StatusForbidden},
+		{name: "accept", action: "accept", body: `{"account_id":4}`, userID: 2, GetAccountTimes: 1, AcceptTimes: 1, expectedStatusCode: http.StatusOK},
+		{name: "accept expired", action: "accept", body: `{"account_id":4}`, userID: 2, GetAccountTimes: 1, AcceptTimes: 1, AcceptErr: db.ErrPaymentRequestExpired, expectedStatusCode: http.StatusBadRequest},
+		{name: "accept without funds", action: "accept", body: `{"account_id":4}`, userID: 2, GetAccountTimes: 1, AcceptTimes: 1, AcceptErr: db.ErrInsufficientFunds, expectedStatusCode: http.StatusBadRequest},
+		{name: "decline", action: "decline", userID: 2, UpdateTimes: 1, expectedStatus: util.PaymentRequestStatusDeclined, expectedStatusCode: http.StatusOK},
+		{name: "decline answered request", action: "decline", userID: 2, UpdateTimes: 1, UpdateErr: sql.ErrNoRows, expectedStatus: util.PaymentRequestStatusDeclined, expectedStatusCode: http.StatusBadRequest},
+		{name: "requester declining", action: "decline", userID: 1, expectedStatusCode: http.StatusForbidden},
+		{name: "cancel", action: "cancel", userID: 1, UpdateTimes: 1, expectedStatus: util.PaymentRequestStatusCancelled, expectedStatusCode: http.StatusOK},
+		{name: "payer cancelling", action: "cancel", userID: 2, expectedStatusCode: http.StatusForbidden},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				GetPaymentRequest(gomock.Any(), paymentRequest.ID).
+				Return(paymentRequest, nil).
+				Times(1)
+			mockStore.EXPECT().
+				GetAccount(gomock.Any(), payerAccount.ID).
+				Return(payerAccount, nil).
+				Times(tc.GetAccountTimes)
+			expectHolders(mockStore, map[int64]string{payerAccount.ID: util.AccountRoleOwner})
+			mockStore.EXPECT().
+				AcceptPaymentRequestTx(gomock.Any(), db.AcceptPaymentRequestTxParams{ID: paymentRequest.ID, AccountID: payerAccount.ID}).
+				Return(db.AcceptPaymentRequestTxResult{}, tc.AcceptErr).
+				Times(tc.AcceptTimes)
+			mockStore.EXPECT().
+				UpdatePendingPaymentRequestStatus(gomock.Any(), db.UpdatePendingPaymentRequestStatusParams{ID: paymentRequest.ID, Status: tc.expectedStatus}).
+				Return(db.PaymentRequest{}, tc.UpdateErr).
+				Times(tc.UpdateTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/payment-requests/%d/%s", paymentRequest.ID, tc.action)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader([]byte(tc.body)))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.userID, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
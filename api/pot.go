@@ -0,0 +1,276 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
+)
+
+const potDateLayout = "2006-01-02"
+
+// potRequest names a pot and optionally sets the amount the customer saves up for and when
+type potRequest struct {
+	Name       string `json:"name" binding:"required,max=40,description"`
+	GoalAmount int64  `json:"goal_amount" binding:"omitempty,min=1"`
+	TargetDate string `json:"target_date" binding:"omitempty,datetime=2006-01-02"`
+}
+
+// potResponse shows how far a pot is from its goal, Progress is a percentage of GoalAmount
+type potResponse struct {
+	ID         int64     `json:"id"`
+	ParentID   int64     `json:"parent_id"`
+	Name       string    `json:"name"`
+	Balance    int64     `json:"balance"`
+	Currency   string    `json:"currency"`
+	GoalAmount int64     `json:"goal_amount,omitempty"`
+	TargetDate string    `json:"target_date,omitempty"`
+	Progress   int64     `json:"progress"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func newPotResponse(pot db.Account) potResponse {
+	rsp := potResponse{
+		ID:         pot.ID,
+		ParentID:   pot.ParentID.Int64,
+		Name:       pot.Name,
+		Balance:    pot.Balance,
+		Currency:   pot.Currency,
+		GoalAmount: pot.GoalAmount.Int64,
+		Progress:   util.PotProgress(pot.Balance, pot.GoalAmount.Int64),
+		CreatedAt:  pot.CreatedAt,
+	}
+	if pot.TargetDate.Valid {
+		rsp.TargetDate = pot.TargetDate.Time.Format(potDateLayout)
+	}
+	return rsp
+}
+
+// goal checks the target date is not in the past and converts the goal to its database form
+func (req potRequest) goal(ctx *gin.Context) (sql.NullInt64, sql.NullTime, bool) {
+	goalAmount := sql.NullInt64{Int64: req.GoalAmount, Valid: req.GoalAmount != 0}
+	if req.TargetDate == "" {
+		return goalAmount, sql.NullTime{}, true
+	}
+
+	targetDate, err := time.Parse(potDateLayout, req.TargetDate)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return goalAmount, sql.NullTime{}, false
+	}
+
+	if targetDate.Before(time.Now().UTC().Truncate(24 * time.Hour)) {
+		err := fmt.Errorf("target date %s is in the past", req.TargetDate)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return goalAmount, sql.NullTime{}, false
+	}
+
+	return goalAmount, sql.NullTime{Time: targetDate, Valid: true}, true
+}
+
+/*
+createPot adds a savings pot in the currency of an account held by the authenticated user
+
+Path: POST /accounts/:id/pots
+
+Body potRequest
+*/
+func (server *Server) createPot(ctx *gin.Context) {
+	var uri accountURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req potRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	goalAmount, targetDate, ok := req.goal(ctx)
+	if !ok {
+		return
+	}
+
+	parent, ok := server.heldAccount(ctx, uri.ID, util.AccountPermissionTransact)
+	if !ok {
+		return
+	}
+
+	if parent.Kind != util.AccountKindCustomer {
+		err := fmt.Errorf("account %d is not a customer account", parent.ID)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	pot, err := server.store.CreatePot(ctx, db.CreatePotParams{
+		UserID:         parent.UserID,
+		Currency:       parent.Currency,
+		ParentID:       parent.ID,
+		OrganisationID: parent.OrganisationID,
+		Name:           req.Name,
+		GoalAmount:     goalAmount,
+		TargetDate:     targetDate,
+	})
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation" {
+			err := fmt.Errorf("account %d already has a pot named %s", parent.ID, req.Name)
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newPotResponse(pot))
+}
+
+/*
+listPots lists the pots of an account held by the authenticated user with their progress
+
+Path: GET /accounts/:id/pots
+*/
+func (server *Server) listPots(ctx *gin.Context) {
+	var uri accountURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.heldAccount(ctx, uri.ID, util.AccountPermissionView); !ok {
+		return
+	}
+
+	pots, err := server.store.ListPots(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rsp := make([]potResponse, 0, len(pots))
+	for _, pot := range pots {
+		rsp = append(rsp, newPotResponse(pot))
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+/*
+updatePot renames a pot and replaces its goal
+
+Path: PUT /pots/:id
+
+Body potRequest
+*/
+func (server *Server) updatePot(ctx *gin.Context) {
+	var uri accountURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req potRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	goalAmount, targetDate, ok := req.goal(ctx)
+	if !ok {
+		return
+	}
+
+	if _, ok := server.heldPot(ctx, uri.ID); !ok {
+		return
+	}
+
+	pot, err := server.store.UpdatePot(ctx, db.UpdatePotParams{
+		ID:         uri.ID,
+		Name:       req.Name,
+		GoalAmount: goalAmount,
+		TargetDate: targetDate,
+	})
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation" {
+			err := fmt.Errorf("there is already a pot named %s", req.Name)
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newPotResponse(pot))
+}
+
+// movePotRequest moves a positive amount from the parent account into the pot, or a negative amount back
+type movePotRequest struct {
+	Amount int64 `json:"amount" binding:"required"`
+}
+
+/*
+movePot instantly moves money between a pot and its parent account, free of charge
+
+Path: POST /pots/:id/moves
+
+Body movePotRequest
+*/
+func (server *Server) movePot(ctx *gin.Context) {
+	var uri accountURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req movePotRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.heldPot(ctx, uri.ID); !ok {
+		return
+	}
+
+	result, err := server.store.MovePotTx(ctx, db.MovePotTxParams{
+		PotID:  uri.ID,
+		Amount: req.Amount,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrNotAPot) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// heldPot loads a pot the authenticated user can transact on through its parent account
+func (server *Server) heldPot(ctx *gin.Context, id int64) (db.Account, bool) {
+	pot, ok := server.heldAccount(ctx, id, util.AccountPermissionTransact)
+	if !ok {
+		return pot, false
+	}
+
+	if pot.Kind != util.AccountKindPot {
+		ctx.JSON(http.StatusBadRequest, errorResponse(db.ErrNotAPot))
+		return pot, false
+	}
+
+	return pot, true
+}
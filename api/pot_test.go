@@ -0,0 +1,172 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreatePot(t *testing.T) {
+	parent := db.Account{ID: 1, UserID: 1, Balance: 1000, Currency: util.EUR, Kind: util.AccountKindCustomer}
+	targetDate := time.Now().UTC().AddDate(1, 0, 0).Format(potDateLayout)
+
+	testCases := []struct {
+		name               string
+		body               string
+		role               string
+		CreatePotErr       error
+		CreatePotTimes     int
+		expectedStatusCode int
+	}{
+		{name: "missing name", body: `{"goal_amount":5000}`, role: util.AccountRoleOwner, expectedStatusCode: http.StatusBadRequest},
+		{name: "target date in the past", body: `{"name":"Holidays","target_date":"2001-01-01"}`, role: util.AccountRoleOwner, expectedStatusCode: http.StatusBadRequest},
+		{name: "viewer", body: `{"name":"Holidays"}`, role: util.AccountRoleViewer, expectedStatusCode: http.StatusForbidden},
+		{name: "duplicated name", body: `{"name":"Holidays"}`, role: util.AccountRoleOwner, CreatePotErr: &pq.Error{Code: "23505"}, CreatePotTimes: 1, expectedStatusCode: http.StatusForbidden},
+		{name: "co-owner with a goal", body: `{"name":"Holidays","goal_amount":5000,"target_date":"` + targetDate + `"}`, role: util.AccountRoleCoOwner, CreatePotTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				GetAccount(gomock.Any(), parent.ID).
+				Return(parent, nil).
+				AnyTimes()
+			expectHolders(mockStore, map[int64]string{parent.ID: tc.role})
+			mockStore.EXPECT().
+				CreatePot(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.CreatePotParams) (db.Account, error) {
+					require.Equal(t, parent.ID, arg.ParentID)
+					require.Equal(t, parent.Currency, arg.Currency)
+					return db.Account{
+						ID:         2,
+						UserID:     arg.UserID,
+						Currency:   arg.Currency,
+						Kind:       util.AccountKindPot,
+						ParentID:   sql.NullInt64{Int64: arg.ParentID, Valid: true},
+						Name:       arg.Name,
+						GoalAmount: arg.GoalAmount,
+						TargetDate: arg.TargetDate,
+					}, tc.CreatePotErr
+				}).
+				Times(tc.CreatePotTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/accounts/1/pots", strings.NewReader(tc.body))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+
+			if tc.expectedStatusCode == http.StatusOK {
+				var response potResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, parent.ID, response.ParentID)
+				require.Equal(t, int64(5000), response.GoalAmount)
+				require.Equal(t, targetDate, response.TargetDate)
+				require.Zero(t, response.Progress)
+			}
+		})
+	}
+}
+
+func TestListPots(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	parent := db.Account{ID: 1, UserID: 1, Balance: 1000, Currency: util.EUR, Kind: util.AccountKindCustomer}
+	pots := []db.Account{
+		{ID: 2, Balance: 2500, Currency: util.EUR, Kind: util.AccountKindPot, ParentID: sql.NullInt64{Int64: 1, Valid: true}, Name: "Holidays", GoalAmount: sql.NullInt64{Int64: 10000, Valid: true}},
+		{ID: 3, Balance: 700, Currency: util.EUR, Kind: util.AccountKindPot, ParentID: sql.NullInt64{Int64: 1, Valid: true}, Name: "Rainy day"},
+	}
+
+	mockStore := mocks.NewMockStore(ctrl)
+	mockStore.EXPECT().GetAccount(gomock.Any(), parent.ID).Return(parent, nil)
+	expectHolders(mockStore, map[int64]string{parent.ID: util.AccountRoleViewer})
+	mockStore.EXPECT().ListPots(gomock.Any(), parent.ID).Return(pots, nil)
+
+	server := newTestServer(t, mockStore)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/accounts/1/pots", nil)
+	require.NoError(t, err)
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var response []potResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	require.Len(t, response, 2)
+	require.Equal(t, int64(25), response[0].Progress)
+	require.Zero(t, response[1].Progress)
+	require.Zero(t, response[1].GoalAmount)
+}
+
+func TestMovePot(t *testing.T) {
+	pot := db.Account{ID: 2, UserID: 1, Balance: 200, Currency: util.EUR, Kind: util.AccountKindPot, ParentID: sql.NullInt64{Int64: 1, Valid: true}, Name: "Holidays"}
+	parent := db.Account{ID: 1, UserID: 1, Balance: 1000, Currency: util.EUR, Kind: util.AccountKindCustomer}
+
+	testCases := []struct {
+		name               string
+		accountID          int64
+		body               string
+		roles              map[int64]string
+		MoveErr            error
+		MoveTimes          int
+		expectedStatusCode int
+	}{
+		{name: "zero amount", accountID: pot.ID, body: `{"amount":0}`, roles: map[int64]string{1: util.AccountRoleOwner}, expectedStatusCode: http.StatusBadRequest},
+		{name: "holder of another account", accountID: pot.ID, body: `{"amount":100}`, roles: map[int64]string{2: util.AccountRoleOwner}, expectedStatusCode: http.StatusForbidden},
+		{name: "viewer of the parent", accountID: pot.ID, body: `{"amount":100}`, roles: map[int64]string{1: util.AccountRoleViewer}, expectedStatusCode: http.StatusForbidden},
+		{name: "not a pot", accountID: parent.ID, body: `{"amount":100}`, roles: map[int64]string{1: util.AccountRoleOwner}, expectedStatusCode: http.StatusBadRequest},
+		{name: "insufficient funds", accountID: pot.ID, body: `{"amount":-300}`, roles: map[int64]string{1: util.AccountRoleOwner}, MoveErr: db.ErrInsufficientFunds, MoveTimes: 1, expectedStatusCode: http.StatusBadRequest},
+		{name: "move into the pot", accountID: pot.ID, body: `{"amount":100}`, roles: map[int64]string{1: util.AccountRoleCoOwner}, MoveTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().GetAccount(gomock.Any(), pot.ID).Return(pot, nil).AnyTimes()
+			mockStore.EXPECT().GetAccount(gomock.Any(), parent.ID).Return(parent, nil).AnyTimes()
+			expectHolders(mockStore, tc.roles)
+			mockStore.EXPECT().
+				MovePotTx(gomock.Any(), gomock.Any()).
+				Return(db.TransferTxResult{}, tc.MoveErr).
+				Times(tc.MoveTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/pots/%d/moves", tc.accountID)
+			request, err := http.NewRequest(http.MethodPost, url, strings.NewReader(tc.body))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
@@ -1,7 +1,19 @@
 package api
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
 	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/graph"
+	"github.com/Sinothic/simplebank/health"
+	"github.com/Sinothic/simplebank/payment"
+	"github.com/Sinothic/simplebank/stream"
+	"github.com/Sinothic/simplebank/token"
+	"github.com/Sinothic/simplebank/util"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
@@ -9,14 +21,52 @@ import (
 
 // Server servers HTTP requests
 type Server struct {
-	store  db.Store
-	router *gin.Engine
+	config     util.Config
+	store      db.Store
+	rail       payment.PaymentRail
+	streams    *stream.Hub
+	tokenMaker token.Maker
+	graph      *graph.Schema
+	probes     *health.Probes
+	router     *gin.Engine
+	httpServer *http.Server
+	// shutdown is closed when the server shuts down, to end the streams which would never finish
+	shutdown chan struct{}
 }
 
 // NewServer creates a new HTTP server and setup routing
-func NewServer(store db.Store) *Server {
-	server := &Server{store: store}
-	router := gin.Default()
+func NewServer(
+	config util.Config,
+	store db.Store,
+	rail payment.PaymentRail,
+	streams *stream.Hub,
+	probes *health.Probes,
+) (*Server, error) {
+	tokenMaker, err := token.NewJWTMaker(config.TokenSymmetricKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create token maker: %w", err)
+	}
+
+	schema, err := graph.NewSchema(store, config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create graphql schema: %w", err)
+	}
+
+	server := &Server{
+		config:     config,
+		store:      store,
+		rail:       rail,
+		streams:    streams,
+		tokenMaker: tokenMaker,
+		graph:      schema,
+		probes:     probes,
+	}
+	router := gin.New()
+	// the orchestrator probes every few seconds, logging the probes would drown the requests
+	router.Use(gin.LoggerWithConfig(gin.LoggerConfig{SkipPaths: []string{"/healthz", "/readyz"}}), gin.Recovery())
+	// the store reads the audit context of the request through the gin context handed to it
+	router.ContextWithFallback = true
+	router.Use(requestAuditMiddleware())
 
 	// register validators
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
@@ -24,24 +74,168 @@ func NewServer(store db.Store) *Server {
 		if err != nil {
 			panic("cannot register currency validator")
 		}
+
+		err = v.RegisterValidation("reason_code", validReasonCode)
+		if err != nil {
+			panic("cannot register reason code validator")
+		}
+
+		err = v.RegisterValidation("routing_number", validRoutingNumber)
+		if err != nil {
+			panic("cannot register routing number validator")
+		}
+
+		err = v.RegisterValidation("bank_account_type", validBankAccountType)
+		if err != nil {
+			panic("cannot register bank account type validator")
+		}
+
+		err = v.RegisterValidation("iban", validIBAN)
+		if err != nil {
+			panic("cannot register iban validator")
+		}
+
+		err = v.RegisterValidation("sepa_iban", validSEPAIBAN)
+		if err != nil {
+			panic("cannot register sepa iban validator")
+		}
+
+		err = v.RegisterValidation("bic", validBIC)
+		if err != nil {
+			panic("cannot register bic validator")
+		}
+
+		err = v.RegisterValidation("description", validDescription)
+		if err != nil {
+			panic("cannot register description validator")
+		}
+
+		err = v.RegisterValidation("reference", validReference)
+		if err != nil {
+			panic("cannot register reference validator")
+		}
+
+		err = v.RegisterValidation("metadata_key", validMetadataKey)
+		if err != nil {
+			panic("cannot register metadata key validator")
+		}
 	}
 
-	router.POST("/accounts", server.createAccount)
-	router.DELETE("/accounts/:id", server.deleteAccount)
-	router.GET("/accounts/:id", server.getAccount)
-	router.GET("/accounts", server.listAccount)
+	router.POST("/users", server.createUser)
+	router.POST("/users/login", server.loginUser)
+	router.GET("/openapi.json", server.getOpenAPISpec)
+	router.GET("/docs", server.getAPIDocs)
+	router.GET("/healthz", server.getLiveness)
+	router.GET("/readyz", server.getReadiness)
 
-	router.POST("/transfers", server.createTransfer)
+	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker))
+	authRoutes.POST("/accounts", server.createAccount)
+	authRoutes.DELETE("/accounts/:id", server.deleteAccount)
+	authRoutes.GET("/accounts/:id", server.getAccount)
+	authRoutes.GET("/accounts", server.listAccount)
+	authRoutes.POST("/accounts/:id/deposits", server.createDeposit)
+	authRoutes.POST("/accounts/:id/withdrawals", server.createWithdrawal)
+	authRoutes.GET("/accounts/:id/external-transfers", server.listExternalTransfers)
+	authRoutes.GET("/accounts/:id/statement", server.getStatement)
+	authRoutes.GET("/accounts/:id/transfers", server.listAccountTransfers)
+	authRoutes.GET("/accounts/:id/entries", server.listAccountEntries)
+	authRoutes.GET("/accounts/:id/stream", server.streamAccount)
+	authRoutes.GET("/accounts/:id/holders", server.listAccountHolders)
+	authRoutes.DELETE("/accounts/:id/holders/:user_id", server.deleteAccountHolder)
+	authRoutes.POST("/accounts/:id/invitations", server.createAccountInvitation)
+	authRoutes.POST("/accounts/:id/pots", server.createPot)
+	authRoutes.GET("/accounts/:id/pots", server.listPots)
+	authRoutes.PUT("/pots/:id", server.updatePot)
+	authRoutes.POST("/pots/:id/moves", server.movePot)
+	authRoutes.GET("/users/me/invitations", server.listAccountInvitations)
+	authRoutes.POST("/invitations/:id/accept", server.acceptAccountInvitation)
+	authRoutes.POST("/invitations/:id/decline", server.declineAccountInvitation)
+	authRoutes.POST("/invitations/:id/revoke", server.revokeAccountInvitation)
+	authRoutes.POST("/transfers", server.createTransfer)
+	authRoutes.POST("/payment-batches", server.createPaymentBatch)
+	authRoutes.POST("/beneficiaries", server.createBeneficiary)
+	authRoutes.GET("/beneficiaries", server.listBeneficiaries)
+	authRoutes.POST("/ach-payments", server.createACHPayment)
+	authRoutes.GET("/accounts/:id/ach-payments", server.listOutboundPayments)
+	authRoutes.POST("/sepa-payments", server.createSEPAPayment)
+	authRoutes.GET("/accounts/:id/sepa-payments", server.listOutboundPayments)
+	authRoutes.POST("/users/me/payees", server.createPayee)
+	authRoutes.GET("/users/me/payees", server.listPayees)
+	authRoutes.GET("/users/me/payees/:id", server.getPayee)
+	authRoutes.PATCH("/users/me/payees/:id", server.updatePayee)
+	authRoutes.DELETE("/users/me/payees/:id", server.deletePayee)
+	authRoutes.POST("/payment-requests", server.createPaymentRequest)
+	authRoutes.GET("/payment-requests", server.listPaymentRequests)
+	authRoutes.POST("/payment-requests/:id/accept", server.acceptPaymentRequest)
+	authRoutes.POST("/payment-requests/:id/decline", server.declinePaymentRequest)
+	authRoutes.POST("/payment-requests/:id/cancel", server.cancelPaymentRequest)
+	authRoutes.POST("/organisations", server.createOrganisation)
+	authRoutes.GET("/organisations", server.listOrganisations)
+	authRoutes.GET("/organisations/:id/members", server.listOrganisationMembers)
+	authRoutes.POST("/organisations/:id/members", server.addOrganisationMember)
+	authRoutes.PUT("/organisations/:id/members/:user_id", server.updateOrganisationMember)
+	authRoutes.DELETE("/organisations/:id/members/:user_id", server.deleteOrganisationMember)
+	authRoutes.POST("/organisations/:id/accounts", server.createOrganisationAccount)
+	authRoutes.GET("/organisations/:id/accounts", server.listOrganisationAccounts)
+	authRoutes.GET("/organisations/:id/approvals", server.listTransferApprovals)
+	authRoutes.POST("/transfer-approvals/:id/approve", server.approveTransfer)
+	authRoutes.POST("/transfer-approvals/:id/reject", server.rejectTransfer)
+	authRoutes.POST("/webhooks", server.createWebhook)
+	authRoutes.GET("/webhooks", server.listWebhooks)
+	authRoutes.PUT("/webhooks/:id", server.updateWebhook)
+	authRoutes.DELETE("/webhooks/:id", server.deleteWebhook)
+	authRoutes.GET("/webhooks/:id/deliveries", server.listWebhookDeliveries)
+	authRoutes.POST("/webhooks/:id/deliveries/:delivery_id/replay", server.replayWebhookDelivery)
+	authRoutes.POST("/graphql", server.executeGraphQL)
+
+	adminRoutes := router.Group("/admin").Use(
+		authMiddleware(server.tokenMaker),
+		auditMiddleware(server.store),
+		roleMiddleware(util.UserRoleSupport, util.UserRoleAdmin),
+	)
+	adminOnly := roleMiddleware(util.UserRoleAdmin)
+	adminRoutes.GET("/users", server.searchUsers)
+	adminRoutes.PUT("/users/:id/role", adminOnly, server.updateUserRole)
+	adminRoutes.GET("/accounts", server.searchAccounts)
+	adminRoutes.GET("/accounts/:id/entries", server.adminListAccountEntries)
+	adminRoutes.POST("/accounts/:id/freeze", server.freezeAccount)
+	adminRoutes.POST("/accounts/:id/unfreeze", adminOnly, server.unfreezeAccount)
+	adminRoutes.POST("/accounts/:id/adjustments", adminOnly, server.createAdjustment)
+	adminRoutes.POST("/external-transfers/:id/complete", adminOnly, server.completeExternalTransfer)
+	adminRoutes.POST("/external-transfers/:id/fail", adminOnly, server.failExternalTransfer)
+	adminRoutes.POST("/ach/returns", adminOnly, server.importACHReturns)
+	adminRoutes.GET("/audit-logs", adminOnly, server.listAdminAuditLogs)
+	adminRoutes.GET("/audit-events", adminOnly, server.listAuditEvents)
 
 	server.router = router
-	return server
+	server.shutdown = make(chan struct{})
+	server.httpServer = util.NewHTTPServer(config, router)
+	server.httpServer.RegisterOnShutdown(func() {
+		close(server.shutdown)
+	})
+	return server, nil
 }
 
 func errorResponse(err error) gin.H {
 	return gin.H{"error": err.Error()}
 }
 
-// Start runs the HTTP server on a specific address
+// Start runs the HTTP server on a specific address until it is shut down
 func (server *Server) Start(address string) error {
-	return server.router.Run(address)
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	err = server.httpServer.Serve(listener)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// Shutdown stops accepting connections, ends the streams and waits for the other requests in flight
+// to finish until ctx is done
+func (server *Server) Shutdown(ctx context.Context) error {
+	return server.httpServer.Shutdown(ctx)
 }
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/statement"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	statementFormatCamt053 = "camt053"
+	statementFormatMT940   = "mt940"
+)
+
+type getStatementURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+type getStatementRequest struct {
+	From   time.Time `form:"from" binding:"required" time_format:"2006-01-02" time_utc:"1"`
+	To     time.Time `form:"to" binding:"required" time_format:"2006-01-02" time_utc:"1"`
+	Format string    `form:"format" binding:"required,oneof=camt053 mt940"`
+}
+
+/*
+getStatement exports the entries of an account between two dates, both included,
+as an ISO 20022 camt.053 or a SWIFT MT940 statement
+
+Path: GET /accounts/:id/statement?from=2024-01-01&to=2024-01-31&format=camt053
+*/
+func (server *Server) getStatement(ctx *gin.Context) {
+	var uri getStatementURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req getStatementRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if req.To.Before(req.From) {
+		err := errors.New("statement period ends before it starts")
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.heldAccount(ctx, uri.ID, util.AccountPermissionView); !ok {
+		return
+	}
+
+	data, err := server.store.AccountStatementTx(ctx, db.AccountStatementTxParams{
+		AccountID: uri.ID,
+		From:      req.From,
+		To:        req.To.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	var buf bytes.Buffer
+	contentType := "application/xml; charset=utf-8"
+	extension := "xml"
+	if req.Format == statementFormatMT940 {
+		contentType = "text/plain; charset=utf-8"
+		extension = "sta"
+		err = statement.WriteMT940(&buf, data)
+	} else {
+		err = statement.WriteCamt053(&buf, data, time.Now())
+	}
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	filename := fmt.Sprintf("statement-%d-%s.%s", uri.ID, req.From.Format("20060102"), extension)
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	ctx.Data(http.StatusOK, contentType, buf.Bytes())
+}
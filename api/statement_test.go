@@ -0,0 +1,122 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetStatement(t *testing.T) {
+
+	type apiTest[A any, R any] struct {
+		Argument A
+		Response R
+		Err      error
+		Times    int
+	}
+
+	from := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+	statement := db.AccountStatement{
+		Account:        db.Account{ID: 1, Currency: "USD", Balance: 100},
+		From:           from,
+		To:             to,
+		OpeningBalance: 100,
+		ClosingBalance: 100,
+		Entries:        []db.Entry{},
+	}
+
+	testCases := []struct {
+		name                string
+		query               string
+		AccountStatementTx  apiTest[db.AccountStatementTxParams, db.AccountStatement]
+		expectedStatusCode  int
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			name:               "bad request error (unknown format)",
+			query:              "from=2024-03-01&to=2024-03-31&format=pdf",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "bad request error (period ends before it starts)",
+			query:              "from=2024-03-31&to=2024-03-01&format=mt940",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:  "account not found",
+			query: "from=2024-03-01&to=2024-03-31&format=mt940",
+			AccountStatementTx: apiTest[db.AccountStatementTxParams, db.AccountStatement]{
+				Argument: db.AccountStatementTxParams{AccountID: 1, From: from, To: to},
+				Err:      sql.ErrNoRows,
+				Times:    1,
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:  "mt940 statement",
+			query: "from=2024-03-01&to=2024-03-31&format=mt940",
+			AccountStatementTx: apiTest[db.AccountStatementTxParams, db.AccountStatement]{
+				Argument: db.AccountStatementTxParams{AccountID: 1, From: from, To: to},
+				Response: statement,
+				Times:    1,
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedContentType: "text/plain; charset=utf-8",
+			expectedBody:        ":60F:C240301USD1,00",
+		},
+		{
+			name:  "camt053 statement",
+			query: "from=2024-03-01&to=2024-03-31&format=camt053",
+			AccountStatementTx: apiTest[db.AccountStatementTxParams, db.AccountStatement]{
+				Argument: db.AccountStatementTxParams{AccountID: 1, From: from, To: to},
+				Response: statement,
+				Times:    1,
+			},
+			expectedStatusCode:  http.StatusOK,
+			expectedContentType: "application/xml; charset=utf-8",
+			expectedBody:        "<Cd>CLBD</Cd>",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				GetAccount(gomock.Any(), int64(1)).
+				Return(statement.Account, nil).
+				AnyTimes()
+			expectHolders(mockStore, map[int64]string{1: util.AccountRoleViewer})
+			mockStore.EXPECT().
+				AccountStatementTx(gomock.Any(), tc.AccountStatementTx.Argument).
+				Return(tc.AccountStatementTx.Response, tc.AccountStatementTx.Err).
+				Times(tc.AccountStatementTx.Times)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/accounts/1/statement?"+tc.query, nil)
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			if tc.expectedStatusCode == http.StatusOK {
+				require.Equal(t, tc.expectedContentType, recorder.Header().Get("Content-Type"))
+				require.Contains(t, recorder.Body.String(), tc.expectedBody)
+			}
+		})
+	}
+}
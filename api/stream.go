@@ -0,0 +1,261 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Sinothic/simplebank/access"
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// streamPageSize is the number of entries read at once by a stream catching up
+	streamPageSize = 100
+	// defaultStreamHeartbeat is used when the heartbeat interval isn't configured
+	defaultStreamHeartbeat = 15 * time.Second
+)
+
+const (
+	streamEventBalance   = "balance"
+	streamEventEntry     = "entry"
+	streamEventError     = "error"
+	streamEventHeartbeat = "heartbeat"
+)
+
+// streamEvent is sent as an SSE event, or as a JSON message over a WebSocket. Its id is the id of the last entry
+// the client has seen, a client resumes the stream after it with the Last-Event-ID header or the last_event_id parameter
+type streamEvent struct {
+	ID   int64  `json:"id,omitempty"`
+	Type string `json:"type"`
+	Data any    `json:"data,omitempty"`
+}
+
+// balanceEvent starts a stream that doesn't resume another one, the entries sent next carry the balance after them
+type balanceEvent struct {
+	AccountID int64  `json:"account_id"`
+	Balance   int64  `json:"balance"`
+	Currency  string `json:"currency"`
+}
+
+type streamAccountRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+/*
+streamAccount pushes the balance of an account held by the authenticated user, then every new entry with the balance
+after it. The stream is sent as Server-Sent Events, or over a WebSocket when the request is a WebSocket upgrade
+
+Path: GET /accounts/:id/stream?last_event_id=42
+*/
+func (server *Server) streamAccount(ctx *gin.Context) {
+	var req streamAccountRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	lastEventID, err := parseLastEventID(ctx.Request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, ok := server.heldAccount(ctx, req.ID, util.AccountPermissionView)
+	if !ok {
+		return
+	}
+
+	userID := authPayload(ctx).UserID
+	if isWebSocketUpgrade(ctx.Request) {
+		server.streamWebSocket(ctx, userID, account, lastEventID)
+		return
+	}
+
+	// a stream outlives the write timeout of the server
+	_ = http.NewResponseController(ctx.Writer).SetWriteDeadline(time.Time{})
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+	ctx.Writer.Flush()
+
+	err = server.streamEntries(ctx.Request.Context(), userID, account, lastEventID, func(event streamEvent) error {
+		return writeServerSentEvent(ctx.Writer, event)
+	})
+	if err != nil && ctx.Request.Context().Err() == nil {
+		_ = writeServerSentEvent(ctx.Writer, streamEvent{Type: streamEventError, Data: errorResponse(err)})
+	}
+}
+
+// streamWebSocket accepts the upgrade from any origin, the stream is authorized by the bearer token and not by cookies
+func (server *Server) streamWebSocket(ctx *gin.Context, userID int64, account db.Account, lastEventID int64) {
+	websocket.Server{
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler: func(conn *websocket.Conn) {
+			// the hijacked connection keeps the read and write deadlines of the server
+			_ = conn.SetDeadline(time.Time{})
+
+			streamCtx, cancel := context.WithCancel(ctx.Request.Context())
+			defer cancel()
+
+			// the client sends nothing, reading only sees it closing the connection
+			go func() {
+				_, _ = io.Copy(io.Discard, conn)
+				cancel()
+			}()
+
+			err := server.streamEntries(streamCtx, userID, account, lastEventID, func(event streamEvent) error {
+				if event.Type == streamEventHeartbeat {
+					return pingWebSocket(conn)
+				}
+				return websocket.JSON.Send(conn, event)
+			})
+			if err != nil && streamCtx.Err() == nil {
+				_ = websocket.JSON.Send(conn, streamEvent{Type: streamEventError, Data: errorResponse(err)})
+			}
+		},
+	}.ServeHTTP(ctx.Writer, ctx.Request)
+}
+
+// streamEntries sends the new entries of the account until the context is done, the server shuts down or sending fails. A stream is woken
+// by the notifications of the hub and looks for new entries on every heartbeat as well, in case one was lost.
+// The access of the user is checked again on every heartbeat, the stream ends once the user no longer holds the account
+func (server *Server) streamEntries(ctx context.Context, userID int64, account db.Account, lastEventID int64, send func(streamEvent) error) error {
+	notifications, unsubscribe := server.streams.Subscribe(account.ID)
+	defer unsubscribe()
+
+	if lastEventID == 0 {
+		head, err := server.store.GetAccountStreamHead(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		lastEventID = head.LastEntryID
+		err = send(streamEvent{
+			ID:   lastEventID,
+			Type: streamEventBalance,
+			Data: balanceEvent{AccountID: account.ID, Balance: head.Balance, Currency: account.Currency},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	heartbeat := server.config.StreamHeartbeat
+	if heartbeat <= 0 {
+		heartbeat = defaultStreamHeartbeat
+	}
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+
+	for {
+		for {
+			entries, err := server.store.ListAccountEntriesAfter(ctx, db.ListAccountEntriesAfterParams{
+				AccountID: account.ID,
+				AfterID:   lastEventID,
+				PageLimit: streamPageSize,
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, entry := range entries {
+				if err := send(streamEvent{ID: entry.ID, Type: streamEventEntry, Data: entry}); err != nil {
+					return err
+				}
+				lastEventID = entry.ID
+			}
+
+			if len(entries) < streamPageSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-server.shutdown:
+			return nil
+		case <-notifications:
+		case <-ticker.C:
+			_, _, err := access.Resolve(ctx, server.store, userID, account.ID, util.AccountPermissionView)
+			if err != nil {
+				return err
+			}
+			if err := send(streamEvent{Type: streamEventHeartbeat}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// writeServerSentEvent writes an event and flushes it, a heartbeat is a comment ignored by the clients
+func writeServerSentEvent(w gin.ResponseWriter, event streamEvent) error {
+	if event.Type == streamEventHeartbeat {
+		if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+			return err
+		}
+		w.Flush()
+		return nil
+	}
+
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	var frame strings.Builder
+	if event.ID != 0 {
+		fmt.Fprintf(&frame, "id: %d\n", event.ID)
+	}
+	fmt.Fprintf(&frame, "event: %s\ndata: %s\n\n", event.Type, data)
+	if _, err := io.WriteString(w, frame.String()); err != nil {
+		return err
+	}
+	w.Flush()
+	return nil
+}
+
+// pingWebSocket sends a ping frame, the client answers it without the application seeing it
+func pingWebSocket(conn *websocket.Conn) error {
+	conn.PayloadType = websocket.PingFrame
+	defer func() { conn.PayloadType = websocket.TextFrame }()
+
+	_, err := conn.Write(nil)
+	return err
+}
+
+// parseLastEventID reads the Last-Event-ID header an EventSource sends when it reconnects, or the last_event_id
+// parameter of clients that can't set headers, 0 starts a new stream
+func parseLastEventID(req *http.Request) (int64, error) {
+	value := req.Header.Get("Last-Event-ID")
+	if value == "" {
+		value = req.URL.Query().Get("last_event_id")
+	}
+	if value == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id < 0 {
+		return 0, errors.New("the last event id must be a positive integer")
+	}
+	return id, nil
+}
+
+func isWebSocketUpgrade(req *http.Request) bool {
+	return strings.EqualFold(req.Header.Get("Upgrade"), "websocket") &&
+		strings.Contains(strings.ToLower(req.Header.Get("Connection")), "upgrade")
+}
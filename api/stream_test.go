@@ -0,0 +1,225 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/net/websocket"
+)
+
+func TestStreamAccount(t *testing.T) {
+	account := createRandomAccount()
+	entry := db.ListAccountEntriesAfterRow{ID: 8, AccountID: account.ID, Amount: -100, BalanceAfter: 444}
+	removedViewer := map[int64]string{account.ID: util.AccountRoleViewer}
+
+	testCases := []struct {
+		name          string
+		lastEventID   string
+		roles         map[int64]string
+		buildStubs    func(store *mocks.MockStore, server *Server, cancel context.CancelFunc)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "new stream",
+			roles: map[int64]string{account.ID: util.AccountRoleViewer},
+			buildStubs: func(store *mocks.MockStore, server *Server, cancel context.CancelFunc) {
+				store.EXPECT().
+					GetAccountStreamHead(gomock.Any(), account.ID).
+					Return(db.GetAccountStreamHeadRow{Balance: 544, LastEntryID: 7}, nil)
+				gomock.InOrder(
+					store.EXPECT().
+						ListAccountEntriesAfter(gomock.Any(), db.ListAccountEntriesAfterParams{AccountID: account.ID, AfterID: 7, PageLimit: streamPageSize}).
+						DoAndReturn(func(context.Context, db.ListAccountEntriesAfterParams) ([]db.ListAccountEntriesAfterRow, error) {
+							// an entry is committed after the stream caught up
+							server.streams.Notify(account.ID)
+							return []db.ListAccountEntriesAfterRow{}, nil
+						}),
+					store.EXPECT().
+						ListAccountEntriesAfter(gomock.Any(), db.ListAccountEntriesAfterParams{AccountID: account.ID, AfterID: 7, PageLimit: streamPageSize}).
+						DoAndReturn(func(context.Context, db.ListAccountEntriesAfterParams) ([]db.ListAccountEntriesAfterRow, error) {
+							cancel()
+							return []db.ListAccountEntriesAfterRow{entry}, nil
+						}),
+				)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/event-stream", recorder.Header().Get("Content-Type"))
+
+				body := recorder.Body.String()
+				require.True(t, strings.HasPrefix(body, "id: 7\nevent: balance\ndata: {\"account_id\":1,\"balance\":544,\"currency\":\"USD\"}\n\n"))
+				require.Contains(t, body, "id: 8\nevent: entry\ndata: {\"id\":8,")
+				require.Contains(t, body, "\"balance_after\":444}\n\n")
+			},
+		},
+		{
+			name:        "resumed stream",
+			lastEventID: "7",
+			roles:       map[int64]string{account.ID: util.AccountRoleOwner},
+			buildStubs: func(store *mocks.MockStore, server *Server, cancel context.CancelFunc) {
+				store.EXPECT().
+					GetAccountStreamHead(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					ListAccountEntriesAfter(gomock.Any(), db.ListAccountEntriesAfterParams{AccountID: account.ID, AfterID: 7, PageLimit: streamPageSize}).
+					DoAndReturn(func(context.Context, db.ListAccountEntriesAfterParams) ([]db.ListAccountEntriesAfterRow, error) {
+						cancel()
+						return []db.ListAccountEntriesAfterRow{entry}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.True(t, strings.HasPrefix(recorder.Body.String(), "id: 8\nevent: entry\n"))
+			},
+		},
+		{
+			name:        "server shutting down",
+			lastEventID: "7",
+			roles:       map[int64]string{account.ID: util.AccountRoleOwner},
+			buildStubs: func(store *mocks.MockStore, server *Server, cancel context.CancelFunc) {
+				store.EXPECT().
+					ListAccountEntriesAfter(gomock.Any(), db.ListAccountEntriesAfterParams{AccountID: account.ID, AfterID: 7, PageLimit: streamPageSize}).
+					DoAndReturn(func(context.Context, db.ListAccountEntriesAfterParams) ([]db.ListAccountEntriesAfterRow, error) {
+						require.NoError(t, server.Shutdown(context.Background()))
+						return []db.ListAccountEntriesAfterRow{entry}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.True(t, strings.HasPrefix(recorder.Body.String(), "id: 8\nevent: entry\n"))
+			},
+		},
+		{
+			name:        "holder removed",
+			lastEventID: "7",
+			roles:       removedViewer,
+			buildStubs: func(store *mocks.MockStore, server *Server, cancel context.CancelFunc) {
+				server.config.StreamHeartbeat = 10 * time.Millisecond
+				store.EXPECT().
+					ListAccountEntriesAfter(gomock.Any(), db.ListAccountEntriesAfterParams{AccountID: account.ID, AfterID: 7, PageLimit: streamPageSize}).
+					DoAndReturn(func(context.Context, db.ListAccountEntriesAfterParams) ([]db.ListAccountEntriesAfterRow, error) {
+						// the owner removes the viewer while the stream is open
+						delete(removedViewer, account.ID)
+						return []db.ListAccountEntriesAfterRow{entry}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				body := recorder.Body.String()
+				require.True(t, strings.HasPrefix(body, "id: 8\nevent: entry\n"))
+				require.Contains(t, body, "event: error\ndata: {\"error\":")
+				require.NotContains(t, body, ": heartbeat")
+			},
+		},
+		{
+			name:        "invalid last event id",
+			lastEventID: "abc",
+			roles:       map[int64]string{account.ID: util.AccountRoleOwner},
+			buildStubs: func(store *mocks.MockStore, server *Server, cancel context.CancelFunc) {
+				store.EXPECT().
+					ListAccountEntriesAfter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "account held by another user",
+			buildStubs: func(store *mocks.MockStore, server *Server, cancel context.CancelFunc) {
+				store.EXPECT().
+					ListAccountEntriesAfter(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				GetAccount(gomock.Any(), account.ID).
+				Return(account, nil).
+				AnyTimes()
+			expectHolders(mockStore, tc.roles)
+
+			server := newTestServer(t, mockStore)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			tc.buildStubs(mockStore, server, cancel)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequestWithContext(ctx, http.MethodGet, "/accounts/1/stream", nil)
+			require.NoError(t, err)
+			if tc.lastEventID != "" {
+				request.Header.Set("Last-Event-ID", tc.lastEventID)
+			}
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+			require.Zero(t, server.streams.Subscribers(account.ID))
+		})
+	}
+}
+
+func TestStreamAccountWebSocket(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	account := createRandomAccount()
+	mockStore := mocks.NewMockStore(ctrl)
+	mockStore.EXPECT().
+		GetAccount(gomock.Any(), account.ID).
+		Return(account, nil)
+	expectHolders(mockStore, map[int64]string{account.ID: util.AccountRoleOwner})
+	mockStore.EXPECT().
+		ListAccountEntriesAfter(gomock.Any(), db.ListAccountEntriesAfterParams{AccountID: account.ID, AfterID: 7, PageLimit: streamPageSize}).
+		Return([]db.ListAccountEntriesAfterRow{{ID: 8, AccountID: account.ID, Amount: 100, BalanceAfter: 644}}, nil)
+
+	server := newTestServer(t, mockStore)
+	httpServer := httptest.NewServer(server.router)
+	defer httpServer.Close()
+
+	config, err := websocket.NewConfig("ws"+strings.TrimPrefix(httpServer.URL, "http")+"/accounts/1/stream?last_event_id=7", httpServer.URL)
+	require.NoError(t, err)
+	request, err := http.NewRequest(http.MethodGet, httpServer.URL, nil)
+	require.NoError(t, err)
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+	config.Header = request.Header
+
+	conn, err := websocket.DialConfig(config)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var event struct {
+		ID   int64                         `json:"id"`
+		Type string                        `json:"type"`
+		Data db.ListAccountEntriesAfterRow `json:"data"`
+	}
+	require.NoError(t, websocket.JSON.Receive(conn, &event))
+	require.Equal(t, int64(8), event.ID)
+	require.Equal(t, streamEventEntry, event.Type)
+	require.Equal(t, int64(644), event.Data.BalanceAfter)
+
+	// the stream ends once the client goes away
+	require.NoError(t, conn.Close())
+	require.Eventually(t, func() bool {
+		return server.streams.Subscribers(account.ID) == 0
+	}, time.Second, 10*time.Millisecond)
+}
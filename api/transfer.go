@@ -2,22 +2,55 @@ package api
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
+	"github.com/Sinothic/simplebank/access"
 	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
 	"github.com/gin-gonic/gin"
 )
 
+// transferRequest targets either an account or a payee of the authenticated user.
+// Description tells the customers what the transfer was for, Reference and Metadata let integrators
+// correlate it, all three are copied to both entries
 type transferRequest struct {
-	FromAccountID int64  `json:"from_account_id" binding:"required,min=1"`
-	ToAccountID   int64  `json:"to_account_id" binding:"required,min=1"`
-	Amount        int64  `json:"amount" binding:"required,gt=0"`
-	Currency      string `json:"currency" binding:"required,currency"`
+	FromAccountID int64             `json:"from_account_id" binding:"required,min=1"`
+	ToAccountID   int64             `json:"to_account_id" binding:"required_without=PayeeID,excluded_with=PayeeID,omitempty,min=1"`
+	PayeeID       int64             `json:"payee_id" binding:"omitempty,min=1"`
+	Amount        int64             `json:"amount" binding:"required,gt=0"`
+	Currency      string            `json:"currency" binding:"required,currency"`
+	Description   string            `json:"description,omitempty" binding:"max=140,description"`
+	Reference     string            `json:"reference,omitempty" binding:"max=35,reference"`
+	Metadata      map[string]string `json:"metadata,omitempty" binding:"max=20,dive,keys,max=40,metadata_key,endkeys,max=500"`
+}
+
+// transferTxParams builds the parameters of the transfer transaction between two accounts
+func (req transferRequest) transferTxParams(fromAccountID, toAccountID int64) (db.TransferTxParams, error) {
+	arg := db.TransferTxParams{
+		FromAccountID: fromAccountID,
+		ToAccountID:   toAccountID,
+		Amount:        req.Amount,
+		Description:   req.Description,
+		Reference:     req.Reference,
+	}
+
+	if len(req.Metadata) > 0 {
+		metadata, err := json.Marshal(req.Metadata)
+		if err != nil {
+			return arg, err
+		}
+		arg.Metadata = metadata
+	}
+
+	return arg, nil
 }
 
 /*
-createTransfer handles HTTP request to create a new transfer
+createTransfer moves money from an account the authenticated user can transact on.
+A transfer from an organisation account above the transfer limit of the member is held
+until another member approves it and answered with 202 Accepted
 
 Path: POST /transfers
 
@@ -30,7 +63,17 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 		return
 	}
 
-	if !server.validAccount(ctx, req.FromAccountID, req.Currency) {
+	if req.PayeeID != 0 {
+		server.createPayeeTransfer(ctx, req)
+		return
+	}
+
+	from, fromAccess, ok := server.accessAccount(ctx, req.FromAccountID, util.AccountPermissionTransact)
+	if !ok {
+		return
+	}
+
+	if !checkAccount(ctx, from, req.Currency) {
 		return
 	}
 
@@ -38,10 +81,87 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 		return
 	}
 
-	arg := db.TransferTxParams{
-		FromAccountID: req.FromAccountID,
-		ToAccountID:   req.ToAccountID,
-		Amount:        req.Amount,
+	if !server.checkCoolingOff(ctx, access.Destination{AccountID: req.ToAccountID}, req.Amount) {
+		return
+	}
+
+	arg, err := req.transferTxParams(req.FromAccountID, req.ToAccountID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if fromAccess.Exceeds(req.Amount) {
+		server.holdTransfer(ctx, from, arg)
+		return
+	}
+
+	result, err := server.store.TransferTx(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// createPayeeTransfer sends money from an account of the authenticated user to one of their payees,
+// an external beneficiary is paid through the rail of its currency without the transfer details.
+// Like any other transfer between accounts, a transfer to an account above the transfer limit of an
+// organisation member is held, a payment to a beneficiary above it is refused
+func (server *Server) createPayeeTransfer(ctx *gin.Context, req transferRequest) {
+	payee, ok := server.ownedPayee(ctx, req.PayeeID)
+	if !ok {
+		return
+	}
+
+	from, fromAccess, ok := server.accessAccount(ctx, req.FromAccountID, util.AccountPermissionTransact)
+	if !ok {
+		return
+	}
+
+	destination := access.Destination{AccountID: payee.AccountID.Int64, BeneficiaryID: payee.BeneficiaryID.Int64}
+	if !server.checkCoolingOff(ctx, destination, req.Amount) {
+		return
+	}
+
+	if !checkAccount(ctx, from, req.Currency) {
+		return
+	}
+
+	if payee.BeneficiaryID.Valid {
+		if !checkTransferLimit(ctx, fromAccess, req.Amount) {
+			return
+		}
+
+		result, err := server.store.CreateOutboundPaymentTx(ctx, db.CreateOutboundPaymentTxParams{
+			UserID:        payee.UserID,
+			AccountID:     from.ID,
+			BeneficiaryID: payee.BeneficiaryID.Int64,
+			Amount:        req.Amount,
+		})
+		if err != nil {
+			outboundPaymentErrorResponse(ctx, err, payee.BeneficiaryID.Int64)
+			return
+		}
+
+		ctx.JSON(http.StatusOK, result)
+		return
+	}
+
+	if !server.validAccount(ctx, payee.AccountID.Int64, req.Currency) {
+		return
+	}
+
+	arg, err := req.transferTxParams(from.ID, payee.AccountID.Int64)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if fromAccess.Exceeds(req.Amount) {
+		server.holdTransfer(ctx, from, arg)
+		return
 	}
 
 	result, err := server.store.TransferTx(ctx, arg)
@@ -65,11 +185,134 @@ func (server *Server) validAccount(ctx *gin.Context, accountID int64, currency s
 		return false
 	}
 
+	return checkAccount(ctx, account, currency)
+}
+
+// checkAccount makes sure money can be moved in the currency from or to the account
+func checkAccount(ctx *gin.Context, account db.Account, currency string) bool {
+	if account.Kind != util.AccountKindCustomer {
+		err := fmt.Errorf("account %d is not a customer account", account.ID)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return false
+	}
+
 	if account.Currency != currency {
-		err := fmt.Errorf("account %d currency mismatch: %s vs %s", accountID, account.Currency, currency)
+		err := fmt.Errorf("account %d currency mismatch: %s vs %s", account.ID, account.Currency, currency)
 		ctx.JSON(http.StatusBadRequest, errorResponse(err))
 		return false
 	}
 
 	return true
 }
+
+type accountURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// searchRequest filters the transfers or entries of an account: Reference must match exactly,
+// Query must be found in the description ignoring case and metadata[key]=value pairs must all match
+type searchRequest struct {
+	PageID    int32  `form:"page_id" binding:"required,min=1"`
+	PageSize  int32  `form:"page_size" binding:"required,min=5,max=10"`
+	Reference string `form:"reference" binding:"max=35,reference"`
+	Query     string `form:"q" binding:"max=140"`
+}
+
+// bindSearch binds the search filters of a list endpoint, the metadata filter is returned as a JSON object
+func bindSearch(ctx *gin.Context) (accountURI, searchRequest, json.RawMessage, bool) {
+	var uri accountURI
+	var req searchRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return uri, req, nil, false
+	}
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return uri, req, nil, false
+	}
+
+	filter := ctx.QueryMap("metadata")
+	for key := range filter {
+		if !util.IsValidMetadataKey(key) {
+			err := fmt.Errorf("invalid metadata key %q", key)
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return uri, req, nil, false
+		}
+	}
+
+	metadata, err := json.Marshal(filter)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return uri, req, nil, false
+	}
+
+	return uri, req, metadata, true
+}
+
+/*
+listAccountTransfers lists the transfers from or to an account matching the search filters
+
+Path: GET /accounts/:id/transfers?page_id=1&page_size=10&reference=INV-42&q=rent&metadata[order_id]=42
+*/
+func (server *Server) listAccountTransfers(ctx *gin.Context) {
+	uri, req, metadata, ok := bindSearch(ctx)
+	if !ok {
+		return
+	}
+
+	if _, ok := server.heldAccount(ctx, uri.ID, util.AccountPermissionView); !ok {
+		return
+	}
+
+	transfers, err := server.store.SearchAccountTransfers(ctx, db.SearchAccountTransfersParams{
+		AccountID:   uri.ID,
+		Reference:   req.Reference,
+		Description: req.Query,
+		Metadata:    metadata,
+		PageLimit:   req.PageSize,
+		PageOffset:  (req.PageID - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, transfers)
+}
+
+/*
+listAccountEntries lists the entries of an account matching the search filters
+
+Path: GET /accounts/:id/entries?page_id=1&page_size=10&reference=INV-42&q=rent&metadata[order_id]=42
+*/
+func (server *Server) listAccountEntries(ctx *gin.Context) {
+	uri, req, metadata, ok := bindSearch(ctx)
+	if !ok {
+		return
+	}
+
+	if _, ok := server.heldAccount(ctx, uri.ID, util.AccountPermissionView); !ok {
+		return
+	}
+
+	server.searchAccountEntries(ctx, uri.ID, req, metadata)
+}
+
+// searchAccountEntries responds with the entries of an account matching the search filters
+func (server *Server) searchAccountEntries(ctx *gin.Context, accountID int64, req searchRequest, metadata json.RawMessage) {
+	entries, err := server.store.SearchAccountEntries(ctx, db.SearchAccountEntriesParams{
+		AccountID:   accountID,
+		Reference:   req.Reference,
+		Description: req.Query,
+		Metadata:    metadata,
+		PageLimit:   req.PageSize,
+		PageOffset:  (req.PageID - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entries)
+}
@@ -0,0 +1,179 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Sinothic/simplebank/access"
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/gin-gonic/gin"
+)
+
+// holdTransfer records a transfer from an organisation account above the transfer limit of the
+// authenticated member, nothing is moved until another member approves it
+func (server *Server) holdTransfer(ctx *gin.Context, from db.Account, arg db.TransferTxParams) {
+	approval, err := access.HoldTransfer(ctx, server.store, authPayload(ctx).UserID, from, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, approval)
+}
+
+/*
+listTransferApprovals lists the transfers of an organisation waiting for an approval
+
+Path: GET /organisations/:id/approvals
+*/
+func (server *Server) listTransferApprovals(ctx *gin.Context) {
+	var uri organisationURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.organisationMember(ctx, uri.ID, util.AccountPermissionView); !ok {
+		return
+	}
+
+	approvals, err := server.store.ListPendingTransferApprovals(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, approvals)
+}
+
+type transferApprovalURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+/*
+approveTransfer makes a held transfer, it has to be approved by an approver or an admin
+of the organisation other than its initiator whose transfer limit covers the amount
+
+Path: POST /transfer-approvals/:id/approve
+*/
+func (server *Server) approveTransfer(ctx *gin.Context) {
+	var uri transferApprovalURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	approval, ok := server.transferApproval(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	member, ok := server.organisationMember(ctx, approval.OrganisationID, util.OrganisationPermissionApprove)
+	if !ok {
+		return
+	}
+
+	if approval.InitiatorID == member.UserID {
+		err := errors.New("a transfer can't be approved by its initiator")
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
+	}
+
+	// approving moves the money, the amount has to be within the transfer limit of the approver as well
+	_, approverAccess, err := access.Resolve(ctx, server.store, member.UserID, approval.FromAccountID, util.OrganisationPermissionApprove)
+	if err != nil {
+		accessErrorResponse(ctx, err)
+		return
+	}
+	if approverAccess.Exceeds(approval.Amount) {
+		err := fmt.Errorf("amount %d exceeds your transfer limit of %d, a member with a higher limit has to approve it",
+			approval.Amount, approverAccess.TransferLimit.Int64)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return
+	}
+
+	result, err := server.store.ApproveTransferTx(ctx, db.ApproveTransferTxParams{
+		ID:         approval.ID,
+		ApproverID: member.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrTransferApprovalNotPending) || errors.Is(err, db.ErrInsufficientFunds) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		if errors.Is(err, db.ErrAccountFrozen) {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+/*
+rejectTransfer drops a held transfer, approvers and admins reject it and its initiator can withdraw it
+
+Path: POST /transfer-approvals/:id/reject
+*/
+func (server *Server) rejectTransfer(ctx *gin.Context) {
+	var uri transferApprovalURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	approval, ok := server.transferApproval(ctx, uri.ID)
+	if !ok {
+		return
+	}
+
+	permission := util.OrganisationPermissionApprove
+	if approval.InitiatorID == authPayload(ctx).UserID {
+		permission = util.AccountPermissionView
+	}
+
+	member, ok := server.organisationMember(ctx, approval.OrganisationID, permission)
+	if !ok {
+		return
+	}
+
+	approval, err := server.store.RejectPendingTransferApproval(ctx, db.RejectPendingTransferApprovalParams{
+		ID:         approval.ID,
+		ApproverID: member.UserID,
+	})
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			ctx.JSON(http.StatusBadRequest, errorResponse(db.ErrTransferApprovalNotPending))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, approval)
+}
+
+// transferApproval loads a held transfer, the caller still has to check the membership of the authenticated user
+func (server *Server) transferApproval(ctx *gin.Context, id int64) (db.TransferApproval, bool) {
+	approval, err := server.store.GetTransferApproval(ctx, id)
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			err := fmt.Errorf("transfer approval %d not found", id)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return approval, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return approval, false
+	}
+
+	return approval, true
+}
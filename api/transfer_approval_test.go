@@ -0,0 +1,276 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOrganisationTransfer(t *testing.T) {
+	from := db.Account{ID: 1, UserID: 1, Balance: 100000, Currency: util.EUR, Kind: util.AccountKindCustomer, OrganisationID: sql.NullInt64{Int64: 1, Valid: true}}
+	to := db.Account{ID: 2, UserID: 9, Currency: util.EUR, Kind: util.AccountKindCustomer}
+	members := map[int64]db.OrganisationMember{
+		1: {Role: util.OrganisationRoleAdmin},
+		2: {Role: util.OrganisationRoleInitiator, TransferLimit: sql.NullInt64{Int64: 1000, Valid: true}},
+		3: {Role: util.OrganisationRoleViewer},
+	}
+
+	testCases := []struct {
+		name               string
+		userID             int64
+		amount             int64
+		TransferTimes      int
+		HoldTimes          int
+		expectedStatusCode int
+	}{
+		{name: "not a member", userID: 4, amount: 500, expectedStatusCode: http.StatusForbidden},
+		{name: "viewer", userID: 3, amount: 500, expectedStatusCode: http.StatusForbidden},
+		{name: "initiator within the limit", userID: 2, amount: 1000, TransferTimes: 1, expectedStatusCode: http.StatusOK},
+		{name: "initiator above the limit", userID: 2, amount: 1001, HoldTimes: 1, expectedStatusCode: http.StatusAccepted},
+		{name: "admin without a limit", userID: 1, amount: 50000, TransferTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().GetAccount(gomock.Any(), from.ID).Return(from, nil).AnyTimes()
+			mockStore.EXPECT().GetAccount(gomock.Any(), to.ID).Return(to, nil).AnyTimes()
+			expectOrganisationMembers(mockStore, members)
+			mockStore.EXPECT().
+				TransferTx(gomock.Any(), gomock.Any()).
+				Return(db.TransferTxResult{}, nil).
+				Times(tc.TransferTimes)
+			mockStore.EXPECT().
+				CreateTransferApproval(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.CreateTransferApprovalParams) (db.TransferApproval, error) {
+					require.Equal(t, from.OrganisationID.Int64, arg.OrganisationID)
+					require.Equal(t, tc.userID, arg.InitiatorID)
+					require.Equal(t, tc.amount, arg.Amount)
+					require.JSONEq(t, `{}`, string(arg.Metadata))
+					return db.TransferApproval{
+						ID:             1,
+						OrganisationID: arg.OrganisationID,
+						FromAccountID:  arg.FromAccountID,
+						ToAccountID:    arg.ToAccountID,
+						Amount:         arg.Amount,
+						Metadata:       arg.Metadata,
+						InitiatorID:    arg.InitiatorID,
+						Status:         util.TransferApprovalStatusPending,
+					}, nil
+				}).
+				Times(tc.HoldTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			body, err := json.Marshal(transferRequest{FromAccountID: from.ID, ToAccountID: to.ID, Amount: tc.amount, Currency: util.EUR})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/transfers", strings.NewReader(string(body)))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.userID, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestOrganisationDebitAboveLimit(t *testing.T) {
+	account := db.Account{ID: 1, UserID: 1, Balance: 100000, Currency: util.EUR, Kind: util.AccountKindCustomer, OrganisationID: sql.NullInt64{Int64: 1, Valid: true}}
+	to := db.Account{ID: 2, UserID: 9, Currency: util.EUR, Kind: util.AccountKindCustomer}
+	accountPayee := db.Payee{ID: 4, UserID: 2, AccountID: sql.NullInt64{Int64: to.ID, Valid: true}, CreatedAt: time.Now().Add(-48 * time.Hour)}
+	beneficiaryPayee := db.Payee{ID: 5, UserID: 2, BeneficiaryID: sql.NullInt64{Int64: 3, Valid: true}, CreatedAt: accountPayee.CreatedAt}
+
+	testCases := []struct {
+		name               string
+		path               string
+		body               string
+		payee              db.Payee
+		HoldTimes          int
+		expectedStatusCode int
+	}{
+		{
+			name:               "withdrawal",
+			path:               "/accounts/1/withdrawals",
+			body:               `{"amount":1001,"currency":"EUR"}`,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "SEPA payment",
+			path:               "/sepa-payments",
+			body:               `{"account_id":1,"beneficiary_id":3,"amount":1001}`,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "transfer to a beneficiary payee",
+			path:               "/transfers",
+			body:               `{"from_account_id":1,"payee_id":5,"amount":1001,"currency":"EUR"}`,
+			payee:              beneficiaryPayee,
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "transfer to an account payee",
+			path:               "/transfers",
+			body:               `{"from_account_id":1,"payee_id":4,"amount":1001,"currency":"EUR"}`,
+			payee:              accountPayee,
+			HoldTimes:          1,
+			expectedStatusCode: http.StatusAccepted,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().GetAccount(gomock.Any(), account.ID).Return(account, nil)
+			mockStore.EXPECT().GetAccount(gomock.Any(), to.ID).Return(to, nil).AnyTimes()
+			mockStore.EXPECT().GetPayee(gomock.Any(), tc.payee.ID).Return(tc.payee, nil).AnyTimes()
+			expectOrganisationMembers(mockStore, map[int64]db.OrganisationMember{
+				2: {Role: util.OrganisationRoleInitiator, TransferLimit: sql.NullInt64{Int64: 1000, Valid: true}},
+			})
+			mockStore.EXPECT().StartExternalTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			mockStore.EXPECT().CreateOutboundPaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			mockStore.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			mockStore.EXPECT().
+				CreateTransferApproval(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.CreateTransferApprovalParams) (db.TransferApproval, error) {
+					require.Equal(t, to.ID, arg.ToAccountID)
+					return db.TransferApproval{ID: 1, OrganisationID: arg.OrganisationID, Status: util.TransferApprovalStatusPending}, nil
+				}).
+				Times(tc.HoldTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, tc.path, strings.NewReader(tc.body))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 2, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			if tc.expectedStatusCode == http.StatusForbidden {
+				require.Contains(t, recorder.Body.String(), "only transfers can wait for an approval")
+			}
+		})
+	}
+}
+
+func TestApproveTransfer(t *testing.T) {
+	approval := db.TransferApproval{ID: 1, OrganisationID: 1, FromAccountID: 1, ToAccountID: 2, Amount: 5000, InitiatorID: 2, Status: util.TransferApprovalStatusPending}
+	members := map[int64]db.OrganisationMember{
+		1: {Role: util.OrganisationRoleApprover},
+		2: {Role: util.OrganisationRoleAdmin},
+		3: {Role: util.OrganisationRoleInitiator},
+		5: {Role: util.OrganisationRoleApprover, TransferLimit: sql.NullInt64{Int64: 1000, Valid: true}},
+		6: {Role: util.OrganisationRoleApprover, TransferLimit: sql.NullInt64{Int64: 5000, Valid: true}},
+	}
+	account := db.Account{ID: approval.FromAccountID, Currency: util.USD, OrganisationID: sql.NullInt64{Int64: 1, Valid: true}}
+
+	testCases := []struct {
+		name               string
+		userID             int64
+		ApproveErr         error
+		ApproveTimes       int
+		expectedStatusCode int
+	}{
+		{name: "not a member", userID: 4, expectedStatusCode: http.StatusNotFound},
+		{name: "initiator role", userID: 3, expectedStatusCode: http.StatusForbidden},
+		{name: "initiator of the transfer", userID: 2, expectedStatusCode: http.StatusForbidden},
+		{name: "not pending", userID: 1, ApproveErr: db.ErrTransferApprovalNotPending, ApproveTimes: 1, expectedStatusCode: http.StatusBadRequest},
+		{name: "insufficient funds", userID: 1, ApproveErr: db.ErrInsufficientFunds, ApproveTimes: 1, expectedStatusCode: http.StatusBadRequest},
+		{name: "frozen account", userID: 1, ApproveErr: db.ErrAccountFrozen, ApproveTimes: 1, expectedStatusCode: http.StatusForbidden},
+		{name: "approver", userID: 1, ApproveTimes: 1, expectedStatusCode: http.StatusOK},
+		{name: "above the limit of the approver", userID: 5, expectedStatusCode: http.StatusForbidden},
+		{name: "within the limit of the approver", userID: 6, ApproveTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().GetTransferApproval(gomock.Any(), approval.ID).Return(approval, nil)
+			mockStore.EXPECT().GetAccount(gomock.Any(), approval.FromAccountID).Return(account, nil).AnyTimes()
+			expectOrganisationMembers(mockStore, members)
+			mockStore.EXPECT().
+				ApproveTransferTx(gomock.Any(), db.ApproveTransferTxParams{ID: approval.ID, ApproverID: tc.userID}).
+				Return(db.ApproveTransferTxResult{}, tc.ApproveErr).
+				Times(tc.ApproveTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/transfer-approvals/1/approve", nil)
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.userID, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestRejectTransfer(t *testing.T) {
+	approval := db.TransferApproval{ID: 1, OrganisationID: 1, FromAccountID: 1, ToAccountID: 2, Amount: 5000, InitiatorID: 3, Status: util.TransferApprovalStatusPending}
+	members := map[int64]db.OrganisationMember{
+		1: {Role: util.OrganisationRoleApprover},
+		2: {Role: util.OrganisationRoleViewer},
+		3: {Role: util.OrganisationRoleInitiator},
+	}
+
+	testCases := []struct {
+		name               string
+		userID             int64
+		RejectErr          error
+		RejectTimes        int
+		expectedStatusCode int
+	}{
+		{name: "viewer", userID: 2, expectedStatusCode: http.StatusForbidden},
+		{name: "not pending", userID: 1, RejectErr: sql.ErrNoRows, RejectTimes: 1, expectedStatusCode: http.StatusBadRequest},
+		{name: "approver", userID: 1, RejectTimes: 1, expectedStatusCode: http.StatusOK},
+		{name: "initiator withdraws the transfer", userID: 3, RejectTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().GetTransferApproval(gomock.Any(), approval.ID).Return(approval, nil)
+			expectOrganisationMembers(mockStore, members)
+			mockStore.EXPECT().
+				RejectPendingTransferApproval(gomock.Any(), db.RejectPendingTransferApprovalParams{ID: approval.ID, ApproverID: tc.userID}).
+				Return(db.TransferApproval{ID: approval.ID, Status: util.TransferApprovalStatusRejected}, tc.RejectErr).
+				Times(tc.RejectTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/transfer-approvals/1/reject", nil)
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.userID, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
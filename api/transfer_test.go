@@ -9,10 +9,13 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	db "github.com/Sinothic/simplebank/db/sqlc"
 	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -36,6 +39,7 @@ func TestCreateTransfer(t *testing.T) {
 		TransferTx         apiTest[db.TransferTxParams, db.TransferTxResult]
 		GetAccountFrom     apiTest[int64, db.Account]
 		GetAccountTo       apiTest[int64, db.Account]
+		fromRole           string
 		expectedStatusCode int
 		expectedError      *responseError
 	}{
@@ -46,6 +50,61 @@ func TestCreateTransfer(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusBadRequest,
 		},
+		{
+			name: "bad request error (both to account and payee)",
+			transferRequest: transferRequest{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				PayeeID:       3,
+				Amount:        100,
+				Currency:      "USD",
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "bad request error (multi-line description)",
+			transferRequest: transferRequest{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        100,
+				Currency:      "USD",
+				Description:   "rent\nmarch",
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "bad request error (reference outside the SWIFT character set)",
+			transferRequest: transferRequest{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        100,
+				Currency:      "USD",
+				Reference:     "INV_42",
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "bad request error (invalid metadata key)",
+			transferRequest: transferRequest{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        100,
+				Currency:      "USD",
+				Metadata:      map[string]string{"order id": "42"},
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "bad request error (metadata value too long)",
+			transferRequest: transferRequest{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        100,
+				Currency:      "USD",
+				Metadata:      map[string]string{"order_id": strings.Repeat("x", 501)},
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
 		{
 			name: "invalid account from (random error)",
 			transferRequest: transferRequest{
@@ -76,6 +135,22 @@ func TestCreateTransfer(t *testing.T) {
 				Times:    1,
 			},
 		},
+		{
+			name: "viewer of the account from",
+			transferRequest: transferRequest{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        100,
+				Currency:      "USD",
+			},
+			expectedStatusCode: http.StatusForbidden,
+			GetAccountFrom: apiTest[int64, db.Account]{
+				Argument: 1,
+				Response: db.Account{Currency: "USD", Kind: "customer"},
+				Times:    1,
+			},
+			fromRole: util.AccountRoleViewer,
+		},
 		{
 			name: "invalid account from (invalid currency)",
 			transferRequest: transferRequest{
@@ -87,7 +162,7 @@ func TestCreateTransfer(t *testing.T) {
 			expectedStatusCode: http.StatusBadRequest,
 			GetAccountFrom: apiTest[int64, db.Account]{
 				Argument: 1,
-				Response: db.Account{Currency: "USD"},
+				Response: db.Account{Currency: "USD", Kind: "customer"},
 				Times:    1,
 			},
 		},
@@ -102,7 +177,7 @@ func TestCreateTransfer(t *testing.T) {
 			expectedStatusCode: http.StatusInternalServerError,
 			GetAccountFrom: apiTest[int64, db.Account]{
 				Argument: 1,
-				Response: db.Account{Currency: "USD"},
+				Response: db.Account{Currency: "USD", Kind: "customer"},
 				Times:    1,
 			},
 			GetAccountTo: apiTest[int64, db.Account]{
@@ -124,11 +199,11 @@ func TestCreateTransfer(t *testing.T) {
 			},
 			GetAccountFrom: apiTest[int64, db.Account]{
 				Argument: 1,
-				Response: db.Account{Currency: "USD"},
+				Response: db.Account{Currency: "USD", Kind: "customer"},
 				Times:    1,
 			},
 			GetAccountTo: apiTest[int64, db.Account]{
-				Response: db.Account{Currency: "USD"},
+				Response: db.Account{Currency: "USD", Kind: "customer"},
 				Times:    1,
 			},
 			TransferTx: apiTest[db.TransferTxParams, db.TransferTxResult]{
@@ -157,6 +232,7 @@ func TestCreateTransfer(t *testing.T) {
 					UserID:   1,
 					Balance:  100,
 					Currency: "USD",
+					Kind:     "customer",
 				},
 				Times: 1,
 			},
@@ -166,6 +242,7 @@ func TestCreateTransfer(t *testing.T) {
 					UserID:   2,
 					Balance:  100,
 					Currency: "USD",
+					Kind:     "customer",
 				},
 				Times: 1,
 			},
@@ -181,6 +258,7 @@ func TestCreateTransfer(t *testing.T) {
 						FromAccountID: 1,
 						ToAccountID:   2,
 						Amount:        100,
+						Metadata:      json.RawMessage(`{}`),
 					},
 					FromAccount: db.Account{
 						ID:       1,
@@ -198,16 +276,66 @@ func TestCreateTransfer(t *testing.T) {
 						ID:        1,
 						AccountID: 1,
 						Amount:    -100,
+						Metadata:  json.RawMessage(`{}`),
 					},
 					ToEntry: db.Entry{
 						ID:        2,
 						AccountID: 2,
 						Amount:    100,
+						Metadata:  json.RawMessage(`{}`),
 					},
 				},
 				Times: 1,
 			},
 		},
+		{
+			name: "successfully make a transfer with details",
+			transferRequest: transferRequest{
+				FromAccountID: 1,
+				ToAccountID:   2,
+				Amount:        100,
+				Currency:      "USD",
+				Description:   "Rent March",
+				Reference:     "INV-2024/0042",
+				Metadata:      map[string]string{"order_id": "42"},
+			},
+			expectedStatusCode: http.StatusOK,
+			GetAccountFrom: apiTest[int64, db.Account]{
+				Argument: 1,
+				Response: db.Account{ID: 1, Currency: "USD", Kind: "customer"},
+				Times:    1,
+			},
+			GetAccountTo: apiTest[int64, db.Account]{
+				Response: db.Account{ID: 2, Currency: "USD", Kind: "customer"},
+				Times:    1,
+			},
+			TransferTx: apiTest[db.TransferTxParams, db.TransferTxResult]{
+				Argument: db.TransferTxParams{
+					FromAccountID: 1,
+					ToAccountID:   2,
+					Amount:        100,
+					Description:   "Rent March",
+					Reference:     "INV-2024/0042",
+					Metadata:      json.RawMessage(`{"order_id":"42"}`),
+				},
+				Response: db.TransferTxResult{
+					Transfer: db.Transfer{
+						ID:            1,
+						FromAccountID: 1,
+						ToAccountID:   2,
+						Amount:        100,
+						Description:   "Rent March",
+						Reference:     "INV-2024/0042",
+						Metadata:      json.RawMessage(`{"order_id":"42"}`),
+					},
+					FromEntry:   db.Entry{ID: 1, AccountID: 1, Amount: -100, Metadata: json.RawMessage(`{}`)},
+					ToEntry:     db.Entry{ID: 2, AccountID: 2, Amount: 100, Metadata: json.RawMessage(`{}`)},
+					FromAccount: db.Account{ID: 1},
+					ToAccount:   db.Account{ID: 2},
+				},
+				Times: 1,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -225,13 +353,18 @@ func TestCreateTransfer(t *testing.T) {
 				GetAccount(gomock.Any(), tc.transferRequest.FromAccountID).
 				Return(tc.GetAccountFrom.Response, tc.GetAccountFrom.Err).
 				Times(tc.GetAccountFrom.Times)
+			fromRole := tc.fromRole
+			if fromRole == "" {
+				fromRole = util.AccountRoleOwner
+			}
+			expectHolders(mockStore, map[int64]string{tc.transferRequest.FromAccountID: fromRole})
 
 			mockStore.EXPECT().
 				GetAccount(gomock.Any(), tc.transferRequest.ToAccountID).
 				Return(tc.GetAccountTo.Response, tc.GetAccountTo.Err).
 				Times(tc.GetAccountTo.Times)
 
-			server := NewServer(mockStore)
+			server := newTestServer(t, mockStore)
 			recorder := httptest.NewRecorder()
 
 			url := fmt.Sprintf("/transfers")
@@ -243,6 +376,7 @@ func TestCreateTransfer(t *testing.T) {
 
 			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(bodyJson))
 			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
 
 			server.router.ServeHTTP(recorder, request)
 			responseBody, err := io.ReadAll(recorder.Body)
@@ -268,3 +402,77 @@ func TestCreateTransfer(t *testing.T) {
 	}
 
 }
+
+func TestSearchAccountTransfers(t *testing.T) {
+	testCases := []struct {
+		name               string
+		query              string
+		arg                db.SearchAccountTransfersParams
+		times              int
+		expectedStatusCode int
+	}{
+		{
+			name:               "invalid reference",
+			query:              "page_id=1&page_size=5&reference=INV_42",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "invalid metadata key",
+			query:              "page_id=1&page_size=5&metadata[order%20id]=42",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:  "no filter",
+			query: "page_id=2&page_size=5",
+			arg: db.SearchAccountTransfersParams{
+				AccountID:  1,
+				Metadata:   json.RawMessage(`{}`),
+				PageLimit:  5,
+				PageOffset: 5,
+			},
+			times:              1,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:  "all filters",
+			query: "page_id=1&page_size=5&reference=INV-42&q=rent&metadata[order_id]=42&metadata[shop]=eu",
+			arg: db.SearchAccountTransfersParams{
+				AccountID:   1,
+				Reference:   "INV-42",
+				Description: "rent",
+				Metadata:    json.RawMessage(`{"order_id":"42","shop":"eu"}`),
+				PageLimit:   5,
+			},
+			times:              1,
+			expectedStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				SearchAccountTransfers(gomock.Any(), tc.arg).
+				Return([]db.Transfer{}, nil).
+				Times(tc.times)
+			mockStore.EXPECT().
+				GetAccount(gomock.Any(), int64(1)).
+				Return(db.Account{ID: 1, UserID: 1, Currency: util.USD}, nil).
+				Times(tc.times)
+			expectHolders(mockStore, map[int64]string{1: util.AccountRoleViewer})
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/accounts/1/transfers?"+tc.query, nil)
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/go-faker/faker/v4"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// eqCreateUserParamsMatcher matches CreateUserParams whose hashed password belongs to password
+type eqCreateUserParamsMatcher struct {
+	arg      db.CreateUserParams
+	password string
+}
+
+func (e eqCreateUserParamsMatcher) Matches(x interface{}) bool {
+	arg, ok := x.(db.CreateUserParams)
+	if !ok {
+		return false
+	}
+
+	if err := util.CheckPassword(e.password, arg.HashedPassword); err != nil {
+		return false
+	}
+
+	e.arg.HashedPassword = arg.HashedPassword
+	return e.arg == arg
+}
+
+func (e eqCreateUserParamsMatcher) String() string {
+	return fmt.Sprintf("matches arg %v and password %v", e.arg, e.password)
+}
+
+func eqCreateUserParams(arg db.CreateUserParams, password string) gomock.Matcher {
+	return eqCreateUserParamsMatcher{arg, password}
+}
+
+func createRandomUser(t *testing.T) (db.User, string) {
+	password := faker.Password()
+	hashedPassword, err := util.HashPassword(password)
+	require.NoError(t, err)
+
+	user := db.User{
+		ID:             1,
+		Username:       "user" + faker.UUIDDigit()[:8],
+		HashedPassword: hashedPassword,
+		FullName:       faker.Name(),
+		Email:          faker.Email(),
+	}
+	return user, password
+}
+
+func TestCreateUserAPI(t *testing.T) {
+	user, password := createRandomUser(t)
+
+	testCases := []struct {
+		name               string
+		body               createUserRequest
+		times              int
+		err                error
+		expectedStatusCode int
+	}{
+		{
+			name:               "ok",
+			body:               createUserRequest{Username: user.Username, Password: password, FullName: user.FullName, Email: user.Email},
+			times:              1,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "invalid email",
+			body:               createUserRequest{Username: user.Username, Password: password, FullName: user.FullName, Email: "invalid"},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "short password",
+			body:               createUserRequest{Username: user.Username, Password: "123", FullName: user.FullName, Email: user.Email},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "duplicate username",
+			body:               createUserRequest{Username: user.Username, Password: password, FullName: user.FullName, Email: user.Email},
+			times:              1,
+			err:                &pq.Error{Code: "23505"},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "internal error",
+			body:               createUserRequest{Username: user.Username, Password: password, FullName: user.FullName, Email: user.Email},
+			times:              1,
+			err:                sql.ErrConnDone,
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			arg := db.CreateUserParams{
+				Username: user.Username,
+				FullName: user.FullName,
+				Email:    user.Email,
+			}
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				CreateUser(gomock.Any(), eqCreateUserParams(arg, password)).
+				Return(user, tc.err).
+				Times(tc.times)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			bodyJson, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/users", bytes.NewReader(bodyJson))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+
+			if tc.expectedStatusCode == http.StatusOK {
+				require.NotContains(t, recorder.Body.String(), "hashed_password")
+
+				var response userResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &response)
+				require.NoError(t, err)
+				require.Equal(t, user.Username, response.Username)
+				require.Equal(t, user.Email, response.Email)
+			}
+		})
+	}
+}
+
+func TestLoginUserAPI(t *testing.T) {
+	user, password := createRandomUser(t)
+
+	testCases := []struct {
+		name               string
+		body               loginUserRequest
+		times              int
+		err                error
+		expectedStatusCode int
+	}{
+		{
+			name:               "ok",
+			body:               loginUserRequest{Username: user.Username, Password: password},
+			times:              1,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "user not found",
+			body:               loginUserRequest{Username: user.Username, Password: password},
+			times:              1,
+			err:                sql.ErrNoRows,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "incorrect password",
+			body:               loginUserRequest{Username: user.Username, Password: "incorrect-password"},
+			times:              1,
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:               "invalid username",
+			body:               loginUserRequest{Username: "invalid-user#1", Password: password},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				GetUser(gomock.Any(), user.Username).
+				Return(user, tc.err).
+				Times(tc.times)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			bodyJson, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/users/login", bytes.NewReader(bodyJson))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+
+			if tc.expectedStatusCode == http.StatusOK {
+				var response loginUserResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &response)
+				require.NoError(t, err)
+
+				payload, err := server.tokenMaker.VerifyToken(response.AccessToken)
+				require.NoError(t, err)
+				require.Equal(t, user.ID, payload.UserID)
+				require.Equal(t, user.Username, payload.Username)
+			}
+		})
+	}
+}
@@ -9,3 +9,48 @@ var validCurrency validator.Func = func(fl validator.FieldLevel) bool {
 	currency := fl.Field().String()
 	return util.IsSupportedCurrency(currency)
 }
+
+var validReasonCode validator.Func = func(fl validator.FieldLevel) bool {
+	reason := fl.Field().String()
+	return util.IsSupportedAdjustmentReason(reason)
+}
+
+var validRoutingNumber validator.Func = func(fl validator.FieldLevel) bool {
+	routingNumber := fl.Field().String()
+	return util.IsValidRoutingNumber(routingNumber)
+}
+
+var validBankAccountType validator.Func = func(fl validator.FieldLevel) bool {
+	accountType := fl.Field().String()
+	return util.IsSupportedBankAccountType(accountType)
+}
+
+var validIBAN validator.Func = func(fl validator.FieldLevel) bool {
+	iban := fl.Field().String()
+	return util.IsValidIBAN(iban)
+}
+
+var validSEPAIBAN validator.Func = func(fl validator.FieldLevel) bool {
+	iban := fl.Field().String()
+	return util.IsSEPAIBAN(iban)
+}
+
+var validBIC validator.Func = func(fl validator.FieldLevel) bool {
+	bic := fl.Field().String()
+	return util.IsValidBIC(bic)
+}
+
+var validDescription validator.Func = func(fl validator.FieldLevel) bool {
+	description := fl.Field().String()
+	return util.IsValidDescription(description)
+}
+
+var validReference validator.Func = func(fl validator.FieldLevel) bool {
+	reference := fl.Field().String()
+	return util.IsValidReference(reference)
+}
+
+var validMetadataKey validator.Func = func(fl validator.FieldLevel) bool {
+	key := fl.Field().String()
+	return util.IsValidMetadataKey(key)
+}
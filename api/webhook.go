@@ -0,0 +1,329 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/Sinothic/simplebank/webhook"
+	"github.com/gin-gonic/gin"
+)
+
+// webhookRequest subscribes a URL to some event types about the accounts the authenticated user holds,
+// the deliveries are only sent over https
+type webhookRequest struct {
+	URL        string   `json:"url" binding:"required,url,startswith=https://,max=2048"`
+	EventTypes []string `json:"event_types" binding:"required,min=1,unique,dive,oneof=AccountCreated TransferPosted BalanceAdjusted ExternalTransferPosted OutboundPaymentCreated OutboundPaymentSubmitted OutboundPaymentReturned"`
+}
+
+// webhookResponse only shows the secret of a subscription when it is created
+type webhookResponse struct {
+	ID                  int64      `json:"id"`
+	URL                 string     `json:"url"`
+	EventTypes          []string   `json:"event_types"`
+	Secret              string     `json:"secret,omitempty"`
+	Status              string     `json:"status"`
+	ConsecutiveFailures int32      `json:"consecutive_failures"`
+	DisabledAt          *time.Time `json:"disabled_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+}
+
+func newWebhookResponse(subscription db.WebhookSubscription) (webhookResponse, error) {
+	rsp := webhookResponse{
+		ID:                  subscription.ID,
+		URL:                 subscription.Url,
+		Status:              subscription.Status,
+		ConsecutiveFailures: subscription.ConsecutiveFailures,
+		CreatedAt:           subscription.CreatedAt,
+	}
+	if subscription.DisabledAt.Valid {
+		rsp.DisabledAt = &subscription.DisabledAt.Time
+	}
+	err := json.Unmarshal(subscription.EventTypes, &rsp.EventTypes)
+	return rsp, err
+}
+
+/*
+createWebhook subscribes a URL to events about the accounts of the authenticated user, the deliveries
+are signed with the secret in the response, which is not shown again
+
+Path: POST /webhooks
+
+Body webhookRequest
+*/
+func (server *Server) createWebhook(ctx *gin.Context) {
+	var req webhookRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	eventTypes, err := json.Marshal(req.EventTypes)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	subscription, err := server.store.CreateWebhookSubscription(ctx, db.CreateWebhookSubscriptionParams{
+		UserID:     authPayload(ctx).UserID,
+		Url:        req.URL,
+		EventTypes: eventTypes,
+		Secret:     secret,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rsp, err := newWebhookResponse(subscription)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rsp.Secret = subscription.Secret
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+/*
+listWebhooks lists the webhook subscriptions of the authenticated user
+
+Path: GET /webhooks
+*/
+func (server *Server) listWebhooks(ctx *gin.Context) {
+	subscriptions, err := server.store.ListWebhookSubscriptions(ctx, authPayload(ctx).UserID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rsp := make([]webhookResponse, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		item, err := newWebhookResponse(subscription)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		rsp = append(rsp, item)
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+type webhookURI struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// updateWebhookRequest replaces a subscription, enabling a disabled subscription resets its failures
+type updateWebhookRequest struct {
+	webhookRequest
+	Enabled *bool `json:"enabled" binding:"required"`
+}
+
+/*
+updateWebhook replaces the URL and the event types of a webhook subscription and enables or disables it
+
+Path: PUT /webhooks/:id
+
+Body updateWebhookRequest
+*/
+func (server *Server) updateWebhook(ctx *gin.Context) {
+	var uri webhookURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req updateWebhookRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	eventTypes, err := json.Marshal(req.EventTypes)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	status := util.WebhookStatusActive
+	if !*req.Enabled {
+		status = util.WebhookStatusDisabled
+	}
+
+	subscription, err := server.store.UpdateWebhookSubscription(ctx, db.UpdateWebhookSubscriptionParams{
+		ID:         uri.ID,
+		UserID:     authPayload(ctx).UserID,
+		Url:        req.URL,
+		EventTypes: eventTypes,
+		Status:     status,
+	})
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			err := fmt.Errorf("webhook %d not found", uri.ID)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	rsp, err := newWebhookResponse(subscription)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+/*
+deleteWebhook deletes a webhook subscription with its deliveries
+
+Path: DELETE /webhooks/:id
+*/
+func (server *Server) deleteWebhook(ctx *gin.Context) {
+	var uri webhookURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.ownedWebhook(ctx, uri.ID); !ok {
+		return
+	}
+
+	err := server.store.DeleteWebhookSubscription(ctx, db.DeleteWebhookSubscriptionParams{
+		ID:     uri.ID,
+		UserID: authPayload(ctx).UserID,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+type listWebhookDeliveriesRequest struct {
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
+}
+
+/*
+listWebhookDeliveries lists the deliveries of a webhook subscription, the latest first, with the outcome of their last attempt
+
+Path: GET /webhooks/:id/deliveries?page_id=1&page_size=10
+*/
+func (server *Server) listWebhookDeliveries(ctx *gin.Context) {
+	var uri webhookURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req listWebhookDeliveriesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.ownedWebhook(ctx, uri.ID); !ok {
+		return
+	}
+
+	deliveries, err := server.store.ListWebhookDeliveries(ctx, db.ListWebhookDeliveriesParams{
+		SubscriptionID: uri.ID,
+		Limit:          req.PageSize,
+		Offset:         (req.PageID - 1) * req.PageSize,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, deliveries)
+}
+
+type webhookDeliveryURI struct {
+	ID         int64 `uri:"id" binding:"required,min=1"`
+	DeliveryID int64 `uri:"delivery_id" binding:"required,min=1"`
+}
+
+/*
+replayWebhookDelivery sends a succeeded or failed delivery again as soon as possible with a fresh set of attempts
+
+Path: POST /webhooks/:id/deliveries/:delivery_id/replay
+*/
+func (server *Server) replayWebhookDelivery(ctx *gin.Context) {
+	var uri webhookDeliveryURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, ok := server.ownedWebhook(ctx, uri.ID); !ok {
+		return
+	}
+
+	_, err := server.store.GetWebhookDelivery(ctx, db.GetWebhookDeliveryParams{
+		ID:             uri.DeliveryID,
+		SubscriptionID: uri.ID,
+	})
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			err := fmt.Errorf("delivery %d of webhook %d not found", uri.DeliveryID, uri.ID)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	delivery, err := server.store.ReplayWebhookDelivery(ctx, uri.DeliveryID)
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			err := errors.New("the delivery is still pending")
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, delivery)
+}
+
+// ownedWebhook loads a webhook subscription of the authenticated user, the subscriptions of other users are not found
+func (server *Server) ownedWebhook(ctx *gin.Context, id int64) (db.WebhookSubscription, bool) {
+	subscription, err := server.store.GetWebhookSubscription(ctx, db.GetWebhookSubscriptionParams{
+		ID:     id,
+		UserID: authPayload(ctx).UserID,
+	})
+	if err != nil {
+		if err.Error() == sql.ErrNoRows.Error() {
+			err := fmt.Errorf("webhook %d not found", id)
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return subscription, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return subscription, false
+	}
+
+	return subscription, true
+}
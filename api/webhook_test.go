@@ -0,0 +1,196 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// expectWebhooks makes subscription 1 a webhook of user 1
+func expectWebhooks(store *mocks.MockStore) {
+	store.EXPECT().
+		GetWebhookSubscription(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.GetWebhookSubscriptionParams) (db.WebhookSubscription, error) {
+			if arg.ID != 1 || arg.UserID != 1 {
+				return db.WebhookSubscription{}, sql.ErrNoRows
+			}
+			return db.WebhookSubscription{ID: 1, UserID: 1, Url: "https://example.com/hooks", EventTypes: []byte(`["TransferPosted"]`)}, nil
+		}).
+		AnyTimes()
+}
+
+func TestCreateWebhook(t *testing.T) {
+	testCases := []struct {
+		name               string
+		body               string
+		CreateTimes        int
+		expectedStatusCode int
+	}{
+		{name: "invalid url", body: `{"url":"not a url","event_types":["TransferPosted"]}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "plain http url", body: `{"url":"http://example.com/hooks","event_types":["TransferPosted"]}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "no event type", body: `{"url":"https://example.com/hooks","event_types":[]}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "unknown event type", body: `{"url":"https://example.com/hooks","event_types":["AccountDeleted"]}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "duplicated event type", body: `{"url":"https://example.com/hooks","event_types":["TransferPosted","TransferPosted"]}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "OK", body: `{"url":"https://example.com/hooks","event_types":["TransferPosted","AccountCreated"]}`, CreateTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				CreateWebhookSubscription(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.CreateWebhookSubscriptionParams) (db.WebhookSubscription, error) {
+					require.Equal(t, int64(1), arg.UserID)
+					require.Equal(t, "https://example.com/hooks", arg.Url)
+					require.JSONEq(t, `["TransferPosted","AccountCreated"]`, string(arg.EventTypes))
+					require.True(t, strings.HasPrefix(arg.Secret, "whsec_"))
+					return db.WebhookSubscription{ID: 1, UserID: arg.UserID, Url: arg.Url, EventTypes: arg.EventTypes, Secret: arg.Secret, Status: util.WebhookStatusActive}, nil
+				}).
+				Times(tc.CreateTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(tc.body))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+
+			if tc.expectedStatusCode == http.StatusOK {
+				var rsp webhookResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+				require.Equal(t, []string{"TransferPosted", "AccountCreated"}, rsp.EventTypes)
+				require.NotEmpty(t, rsp.Secret)
+			}
+		})
+	}
+}
+
+func TestListWebhooksHidesSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockStore(ctrl)
+	mockStore.EXPECT().
+		ListWebhookSubscriptions(gomock.Any(), int64(1)).
+		Return([]db.WebhookSubscription{{ID: 1, UserID: 1, Url: "https://example.com/hooks", EventTypes: []byte(`["TransferPosted"]`), Secret: "whsec_secret"}}, nil).
+		Times(1)
+
+	server := newTestServer(t, mockStore)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/webhooks", nil)
+	require.NoError(t, err)
+	addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.NotContains(t, recorder.Body.String(), "whsec_secret")
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	testCases := []struct {
+		name               string
+		body               string
+		UpdateErr          error
+		UpdateTimes        int
+		expectedStatus     string
+		expectedStatusCode int
+	}{
+		{name: "missing enabled", body: `{"url":"https://example.com/hooks","event_types":["TransferPosted"]}`, expectedStatusCode: http.StatusBadRequest},
+		{name: "not found", body: `{"url":"https://example.com/hooks","event_types":["TransferPosted"],"enabled":true}`, UpdateErr: sql.ErrNoRows, UpdateTimes: 1, expectedStatus: util.WebhookStatusActive, expectedStatusCode: http.StatusNotFound},
+		{name: "disable", body: `{"url":"https://example.com/hooks","event_types":["TransferPosted"],"enabled":false}`, UpdateTimes: 1, expectedStatus: util.WebhookStatusDisabled, expectedStatusCode: http.StatusOK},
+		{name: "enable", body: `{"url":"https://example.com/hooks","event_types":["TransferPosted"],"enabled":true}`, UpdateTimes: 1, expectedStatus: util.WebhookStatusActive, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockStore(ctrl)
+			mockStore.EXPECT().
+				UpdateWebhookSubscription(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.UpdateWebhookSubscriptionParams) (db.WebhookSubscription, error) {
+					require.Equal(t, int64(1), arg.UserID)
+					require.Equal(t, tc.expectedStatus, arg.Status)
+					return db.WebhookSubscription{ID: arg.ID, UserID: arg.UserID, Url: arg.Url, EventTypes: arg.EventTypes, Status: arg.Status}, tc.UpdateErr
+				}).
+				Times(tc.UpdateTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPut, "/webhooks/1", strings.NewReader(tc.body))
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, 1, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
+
+func TestReplayWebhookDelivery(t *testing.T) {
+	testCases := []struct {
+		name               string
+		userID             int64
+		GetDeliveryErr     error
+		GetDeliveryTimes   int
+		ReplayErr          error
+		ReplayTimes        int
+		expectedStatusCode int
+	}{
+		{name: "webhook of another user", userID: 2, expectedStatusCode: http.StatusNotFound},
+		{name: "delivery of another webhook", userID: 1, GetDeliveryErr: sql.ErrNoRows, GetDeliveryTimes: 1, expectedStatusCode: http.StatusNotFound},
+		{name: "still pending", userID: 1, GetDeliveryTimes: 1, ReplayErr: sql.ErrNoRows, ReplayTimes: 1, expectedStatusCode: http.StatusBadRequest},
+		{name: "OK", userID: 1, GetDeliveryTimes: 1, ReplayTimes: 1, expectedStatusCode: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			delivery := db.WebhookDelivery{ID: 7, SubscriptionID: 1, Status: util.WebhookDeliveryStatusFailed}
+
+			mockStore := mocks.NewMockStore(ctrl)
+			expectWebhooks(mockStore)
+			mockStore.EXPECT().
+				GetWebhookDelivery(gomock.Any(), db.GetWebhookDeliveryParams{ID: 7, SubscriptionID: 1}).
+				Return(delivery, tc.GetDeliveryErr).
+				Times(tc.GetDeliveryTimes)
+			delivery.Status = util.WebhookDeliveryStatusPending
+			mockStore.EXPECT().
+				ReplayWebhookDelivery(gomock.Any(), int64(7)).
+				Return(delivery, tc.ReplayErr).
+				Times(tc.ReplayTimes)
+
+			server := newTestServer(t, mockStore)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodPost, "/webhooks/1/deliveries/7/replay", nil)
+			require.NoError(t, err)
+			addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, tc.userID, "user", time.Minute)
+
+			server.router.ServeHTTP(recorder, request)
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+		})
+	}
+}
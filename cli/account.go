@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/spf13/cobra"
+)
+
+var accountCmd = &cobra.Command{
+	Use:   "account",
+	Short: "Manage the accounts",
+}
+
+var freezeReason string
+
+var accountFreezeCmd = &cobra.Command{
+	Use:   "freeze ID",
+	Short: "Stop any money from moving out of a customer account and its pots",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil || id < 1 {
+			return fmt.Errorf("invalid account id %q", args[0])
+		}
+
+		store, dbConnection, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer dbConnection.Close()
+
+		accounts, err := freezeAccount(auditContext(cmd), store, id, freezeReason)
+		if err != nil {
+			return err
+		}
+
+		for _, account := range accounts {
+			cmd.Printf("froze %s account %d\n", account.Kind, account.ID)
+		}
+		return nil
+	},
+}
+
+func init() {
+	accountFreezeCmd.Flags().StringVar(&freezeReason, "reason", "", "why the account is frozen")
+	accountFreezeCmd.MarkFlagRequired("reason")
+
+	accountCmd.AddCommand(accountFreezeCmd)
+}
+
+// freezeAccount freezes a customer account and its pots like POST /admin/accounts/:id/freeze does
+func freezeAccount(ctx context.Context, store db.Store, id int64, reason string) ([]db.Account, error) {
+	if reason == "" || len(reason) > 200 {
+		return nil, fmt.Errorf("reason must have between 1 and 200 characters")
+	}
+
+	account, err := store.GetAccount(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get account %d: %w", id, err)
+	}
+
+	if account.Kind != util.AccountKindCustomer {
+		return nil, fmt.Errorf("account %d is not a customer account", account.ID)
+	}
+
+	accounts, err := store.FreezeAccount(ctx, db.FreezeAccountParams{ID: account.ID, Reason: reason})
+	if err != nil {
+		return nil, fmt.Errorf("cannot freeze account %d: %w", account.ID, err)
+	}
+	return accounts, nil
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFreezeAccount(t *testing.T) {
+	testCases := []struct {
+		name        string
+		id          int64
+		reason      string
+		FreezeTimes int
+		errText     string
+	}{
+		{name: "OK", id: 1, reason: "court order", FreezeTimes: 1},
+		{name: "no reason", id: 1, errText: "reason must have"},
+		{name: "reason too long", id: 1, reason: strings.Repeat("a", 201), errText: "reason must have"},
+		{name: "not found", id: 9, reason: "court order", errText: "cannot get account 9"},
+		{name: "internal account", id: 5, reason: "court order", errText: "not a customer account"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mocks.NewMockStore(ctrl)
+			expectAccounts(store)
+			store.EXPECT().
+				FreezeAccount(gomock.Any(), db.FreezeAccountParams{ID: tc.id, Reason: tc.reason}).
+				Times(tc.FreezeTimes).
+				Return([]db.Account{{ID: tc.id}}, nil)
+
+			accounts, err := freezeAccount(context.Background(), store, tc.id, tc.reason)
+			if tc.errText == "" {
+				require.NoError(t, err)
+				require.Len(t, accounts, 1)
+				return
+			}
+			require.ErrorContains(t, err, tc.errText)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/Sinothic/simplebank/nacha"
+	"github.com/spf13/cobra"
+)
+
+var achCmd = &cobra.Command{
+	Use:   "ach",
+	Short: "Exchange NACHA files with the ODFI",
+}
+
+var achExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Write the pending outbound payments to a NACHA file right away",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, dbConnection, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer dbConnection.Close()
+
+		result, path, err := nacha.NewExporter(store, config).Export(auditContext(cmd))
+		if err != nil {
+			return err
+		}
+
+		if path == "" {
+			cmd.Println("no pending outbound payment to export")
+			return nil
+		}
+		cmd.Printf("exported %d outbound payments to %s\n", len(result.Entries), path)
+		return nil
+	},
+}
+
+var returnsFile string
+
+var achReturnsCmd = &cobra.Command{
+	Use:   "returns",
+	Short: "Import a NACHA return file to give the money of the returned payments back",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		in, err := os.Open(returnsFile)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		returns, err := nacha.ParseReturns(in)
+		if err != nil {
+			return err
+		}
+
+		store, dbConnection, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer dbConnection.Close()
+
+		result, err := nacha.NewExporter(store, config).ImportReturns(auditContext(cmd), returns)
+		if err != nil {
+			return err
+		}
+		cmd.Printf("returned %d payments, skipped %d entries\n", len(result.Returned), len(result.Skipped))
+		return nil
+	},
+}
+
+func init() {
+	achReturnsCmd.Flags().StringVar(&returnsFile, "file", "", "NACHA return file to import")
+	achReturnsCmd.MarkFlagRequired("file")
+
+	achCmd.AddCommand(achExportCmd, achReturnsCmd)
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/spf13/cobra"
+)
+
+var ledgerCmd = &cobra.Command{
+	Use:   "ledger",
+	Short: "Check the integrity of the ledger",
+}
+
+var verifyAccountID int64
+
+var ledgerVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify the hash chain of the entries of every account, or of one account",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, dbConnection, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer dbConnection.Close()
+
+		result, err := verifyLedger(cmd.Context(), store, verifyAccountID)
+		if err != nil {
+			return err
+		}
+		cmd.Printf("verified %d entries of %d accounts\n", result.Entries, result.Accounts)
+		return nil
+	},
+}
+
+func init() {
+	ledgerVerifyCmd.Flags().Int64Var(&verifyAccountID, "account", 0, "only verify the entries of this account")
+
+	ledgerCmd.AddCommand(ledgerVerifyCmd)
+}
+
+// verifyLedger fails on the first entry that was edited, removed or inserted after it was booked
+func verifyLedger(ctx context.Context, store db.Store, accountID int64) (db.EntryChainVerification, error) {
+	if accountID < 0 {
+		return db.EntryChainVerification{}, fmt.Errorf("invalid account id %d", accountID)
+	}
+
+	result, err := store.VerifyEntryChainTx(ctx, db.VerifyEntryChainTxParams{AccountID: accountID})
+	if err != nil {
+		return result, fmt.Errorf("cannot verify entries: %w", err)
+	}
+
+	if result.Broken != nil {
+		return result, fmt.Errorf("chain broken at entry %d of account %d after %d entries: %s",
+			result.Broken.EntryID, result.Broken.AccountID, result.Entries, result.Broken.Reason)
+	}
+	return result, nil
+}
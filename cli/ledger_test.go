@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestVerifyLedger(t *testing.T) {
+	testCases := []struct {
+		name        string
+		accountID   int64
+		result      db.EntryChainVerification
+		VerifyTimes int
+		errText     string
+	}{
+		{name: "OK", result: db.EntryChainVerification{Accounts: 2, Entries: 10}, VerifyTimes: 1},
+		{name: "one account", accountID: 4, result: db.EntryChainVerification{Accounts: 1, Entries: 3}, VerifyTimes: 1},
+		{name: "invalid account", accountID: -1, errText: "invalid account id -1"},
+		{
+			name:        "broken",
+			result:      db.EntryChainVerification{Accounts: 1, Entries: 3, Broken: &db.BrokenEntryLink{EntryID: 7, AccountID: 4, Reason: "hash mismatch"}},
+			VerifyTimes: 1,
+			errText:     "chain broken at entry 7 of account 4 after 3 entries: hash mismatch",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mocks.NewMockStore(ctrl)
+			store.EXPECT().
+				VerifyEntryChainTx(gomock.Any(), db.VerifyEntryChainTxParams{AccountID: tc.accountID}).
+				Times(tc.VerifyTimes).
+				Return(tc.result, nil)
+
+			result, err := verifyLedger(context.Background(), store, tc.accountID)
+			if tc.errText == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.result, result)
+				return
+			}
+			require.ErrorContains(t, err, tc.errText)
+		})
+	}
+}
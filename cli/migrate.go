@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/Sinothic/simplebank/db/migration"
+	"github.com/spf13/cobra"
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Apply or revert the migrations of the database embedded in the binary",
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up [N]",
+	Short: "Apply the next N migrations, or all of them",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		steps, err := migrationSteps(args)
+		if err != nil {
+			return err
+		}
+		return runMigrator(cmd, func(migrator *migration.Migrator) error {
+			return migrator.Up(steps)
+		})
+	},
+}
+
+var migrateDownAll bool
+
+var migrateDownCmd = &cobra.Command{
+	Use:   "down N | --all",
+	Short: "Revert the last N migrations, or all of them with --all",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		steps, err := migrationSteps(args)
+		if err != nil {
+			return err
+		}
+		if (steps == 0) != migrateDownAll {
+			return fmt.Errorf("give the number of migrations to revert or --all")
+		}
+		return runMigrator(cmd, func(migrator *migration.Migrator) error {
+			return migrator.Down(steps)
+		})
+	},
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "List the migrations and whether they have been applied",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMigrator(cmd, func(migrator *migration.Migrator) error {
+			statuses, err := migrator.Status()
+			if err != nil {
+				return err
+			}
+
+			for _, status := range statuses {
+				state := "pending"
+				if status.Applied {
+					state = "applied"
+				}
+				cmd.Printf("%06d %-40s %s\n", status.Version, status.Name, state)
+			}
+			return nil
+		})
+	},
+}
+
+func init() {
+	migrateDownCmd.Flags().BoolVar(&migrateDownAll, "all", false, "revert every migration, dropping all the data")
+	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateStatusCmd)
+}
+
+// migrationSteps reads the optional number of migrations to apply or revert, 0 means all of them
+func migrationSteps(args []string) (int, error) {
+	if len(args) == 0 {
+		return 0, nil
+	}
+
+	steps, err := strconv.Atoi(args[0])
+	if err != nil || steps < 1 {
+		return 0, fmt.Errorf("invalid number of migrations %q", args[0])
+	}
+	return steps, nil
+}
+
+// runMigrator runs fn with a migrator of the database of the config, then prints the version of the schema
+func runMigrator(cmd *cobra.Command, fn func(migrator *migration.Migrator) error) error {
+	migrator, err := migration.NewMigrator(config.DBSource)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	if err := fn(migrator); err != nil {
+		return err
+	}
+
+	version, dirty, err := migrator.Version()
+	if err != nil {
+		return err
+	}
+
+	if dirty {
+		cmd.Printf("schema version %d is dirty, its migration failed and has to be fixed by hand\n", version)
+		return nil
+	}
+	cmd.Printf("schema version %d\n", version)
+	return nil
+}
@@ -0,0 +1,20 @@
+package cli
+
+import (
+	"github.com/Sinothic/simplebank/api"
+	"github.com/spf13/cobra"
+)
+
+var openAPICmd = &cobra.Command{
+	Use:   "openapi",
+	Short: "Print the OpenAPI specification of the API, without a database or a running server",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		spec, err := api.OpenAPISpec()
+		if err != nil {
+			return err
+		}
+		_, err = cmd.OutOrStdout().Write(spec)
+		return err
+	},
+}
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/Sinothic/simplebank/pain"
+	"github.com/spf13/cobra"
+)
+
+var pain001Cmd = &cobra.Command{
+	Use:   "pain001",
+	Short: "Import ISO 20022 payment batches",
+}
+
+var pain001Args struct {
+	File     string
+	Username string
+	Out      string
+}
+
+var pain001ImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import a pain.001.001.09 payment batch on behalf of a user and write the pain.002.001.10 status report",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		in, err := os.Open(pain001Args.File)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		doc, err := pain.Parse(in)
+		if err != nil {
+			return err
+		}
+
+		store, dbConnection, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer dbConnection.Close()
+
+		ctx := auditContext(cmd)
+		user, err := store.GetUser(ctx, pain001Args.Username)
+		if err != nil {
+			return fmt.Errorf("cannot get user %s: %w", pain001Args.Username, err)
+		}
+
+		report, err := pain.NewImporter(store, config).Import(ctx, user.ID, doc)
+		if err != nil {
+			return fmt.Errorf("cannot import payment batch: %w", err)
+		}
+
+		var w io.Writer = cmd.OutOrStdout()
+		if pain001Args.Out != "" {
+			f, err := os.Create(pain001Args.Out)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			w = f
+		}
+
+		if err := pain.WritePain002(w, report); err != nil {
+			return fmt.Errorf("cannot write status report: %w", err)
+		}
+		cmd.PrintErrf("payment batch %s: %s\n", report.OriginalMessageID, report.GroupStatus)
+		return nil
+	},
+}
+
+func init() {
+	flags := pain001ImportCmd.Flags()
+	flags.StringVar(&pain001Args.File, "file", "", "pain.001 file to import")
+	flags.StringVar(&pain001Args.Username, "username", "", "user initiating the payments, must be allowed to transact on the debtor accounts")
+	flags.StringVar(&pain001Args.Out, "out", "", "where to write the pain.002 report (default stdout)")
+	for _, name := range []string{"file", "username"} {
+		pain001ImportCmd.MarkFlagRequired(name)
+	}
+
+	pain001Cmd.AddCommand(pain001ImportCmd)
+}
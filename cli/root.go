@@ -0,0 +1,66 @@
+// Package cli is the command line of the bank: it serves the APIs, migrates the database and runs
+// the admin operations on the ledger through the same db.Store as the server
+package cli
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	_ "github.com/lib/pq"
+)
+
+var (
+	configPath string
+	config     util.Config
+)
+
+var rootCmd = &cobra.Command{
+	Use:          "simplebank",
+	Short:        "Simple bank server and admin commands",
+	SilenceUsage: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		var err error
+		config, err = util.LoadConfig(configPath)
+		if err != nil {
+			return fmt.Errorf("cannot load config: %w", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&configPath, "config", ".", "directory containing app.env")
+	rootCmd.AddCommand(serveCmd, migrateCmd, seedCmd, userCmd, accountCmd, transferCmd, ledgerCmd, achCmd, pain001Cmd, openAPICmd)
+}
+
+// Execute runs the command given by the arguments of the process
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+// openStore connects to the database of the config, the connection is closed by the caller
+func openStore() (db.Store, *sql.DB, error) {
+	dbConnection, err := sql.Open(config.DBDriver, config.DBSource)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot connect to db: %w", err)
+	}
+	return db.NewStore(dbConnection), dbConnection, nil
+}
+
+// auditContext records the changes made by an admin command as made from the command line
+func auditContext(cmd *cobra.Command) context.Context {
+	return db.WithAuditContext(cmd.Context(), db.AuditContext{
+		Route:     cmd.CommandPath(),
+		RequestID: uuid.NewString(),
+		UserAgent: "simplebank-cli",
+	})
+}
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/go-faker/faker/v4"
+	"github.com/spf13/cobra"
+)
+
+var seedArgs seedParams
+
+var seedCmd = &cobra.Command{
+	Use:   "seed",
+	Short: "Fill the database with fake users holding funded accounts and transfers between them",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, dbConnection, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer dbConnection.Close()
+
+		result, err := seed(auditContext(cmd), store, seedArgs)
+		if err != nil {
+			return err
+		}
+		cmd.Printf("created %d users with the password %q, %d accounts and %d transfers\n",
+			result.Users, seedArgs.Password, result.Accounts, result.Transfers)
+		return nil
+	},
+}
+
+func init() {
+	flags := seedCmd.Flags()
+	flags.IntVar(&seedArgs.Users, "users", 10, "number of users to create")
+	flags.IntVar(&seedArgs.Transfers, "transfers", 50, "number of transfers between their accounts")
+	flags.StringVar(&seedArgs.Password, "password", "secret123", "password of every user")
+}
+
+type seedParams struct {
+	Users     int
+	Transfers int
+	Password  string
+}
+
+type seedResult struct {
+	Users     int
+	Accounts  int
+	Transfers int
+}
+
+// seed creates users with an account in one to three currencies, funds the accounts with a migration
+// adjustment and moves some of the money between accounts of the same currency
+func seed(ctx context.Context, store db.Store, arg seedParams) (seedResult, error) {
+	var result seedResult
+	if len(arg.Password) < 8 {
+		return result, fmt.Errorf("password must have at least 8 characters")
+	}
+
+	hashedPassword, err := util.HashPassword(arg.Password)
+	if err != nil {
+		return result, err
+	}
+
+	accounts := map[string][]db.Account{}
+	currencies := []string{util.USD, util.EUR, util.CAD}
+	for range arg.Users {
+		user, err := store.CreateUser(ctx, db.CreateUserParams{
+			Username:       faker.Username(),
+			HashedPassword: hashedPassword,
+			FullName:       faker.Name(),
+			Email:          faker.Email(),
+		})
+		if err != nil {
+			return result, fmt.Errorf("cannot create user: %w", err)
+		}
+		result.Users++
+
+		rand.Shuffle(len(currencies), func(i, j int) {
+			currencies[i], currencies[j] = currencies[j], currencies[i]
+		})
+		for _, currency := range currencies[:1+rand.IntN(len(currencies))] {
+			created, err := store.CreateAccountTx(ctx, db.CreateAccountTxParams{UserID: user.ID, Currency: currency})
+			if err != nil {
+				return result, fmt.Errorf("cannot create account: %w", err)
+			}
+
+			funded, err := store.AdjustBalanceTx(ctx, db.AdjustBalanceTxParams{
+				AccountID:     created.Account.ID,
+				Amount:        int64(10_000 + rand.IntN(1_000_000)),
+				ReasonCode:    util.ReasonMigration,
+				Justification: "opening balance of seed data",
+			})
+			if err != nil {
+				return result, fmt.Errorf("cannot fund account %d: %w", created.Account.ID, err)
+			}
+			accounts[currency] = append(accounts[currency], funded.Account)
+			result.Accounts++
+		}
+	}
+
+	for range arg.Transfers {
+		currency := currencies[rand.IntN(len(currencies))]
+		if len(accounts[currency]) < 2 {
+			continue
+		}
+
+		i, j := rand.IntN(len(accounts[currency])), rand.IntN(len(accounts[currency])-1)
+		if j >= i {
+			j++
+		}
+		from, to := accounts[currency][i], accounts[currency][j]
+		if from.Balance < 2 {
+			continue
+		}
+
+		transferred, err := store.TransferTx(ctx, db.TransferTxParams{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        1 + rand.Int64N(from.Balance/2),
+			Description:   faker.Word() + " " + faker.Word(),
+		})
+		if err != nil {
+			return result, fmt.Errorf("cannot transfer: %w", err)
+		}
+		accounts[currency][i], accounts[currency][j] = transferred.FromAccount, transferred.ToAccount
+		result.Transfers++
+	}
+	return result, nil
+}
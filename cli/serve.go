@@ -0,0 +1,180 @@
+package cli
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/Sinothic/simplebank/api"
+	"github.com/Sinothic/simplebank/db/migration"
+	"github.com/Sinothic/simplebank/event"
+	"github.com/Sinothic/simplebank/gapi"
+	"github.com/Sinothic/simplebank/health"
+	"github.com/Sinothic/simplebank/nacha"
+	"github.com/Sinothic/simplebank/pain"
+	"github.com/Sinothic/simplebank/payment"
+	"github.com/Sinothic/simplebank/stream"
+	"github.com/Sinothic/simplebank/webhook"
+	"github.com/spf13/cobra"
+)
+
+// defaultShutdownTimeout is how long the requests in flight and the workers have to finish
+// when the shutdown timeout isn't configured
+const defaultShutdownTimeout = 30 * time.Second
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve the HTTP API, and the gRPC API when its address is configured, with the background workers",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return serve(cmd.Context())
+	},
+}
+
+// serve runs the servers and the background workers until SIGINT or SIGTERM, or until a server fails.
+// The readiness probe fails first and the servers keep serving for the drain delay, so that the load balancer
+// stops sending them requests. They are drained next, so that the requests in flight can finish, then
+// the workers are stopped and the connections to the database closed
+func serve(ctx context.Context) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	store, dbConnection, err := openStore()
+	if err != nil {
+		return err
+	}
+	defer dbConnection.Close()
+
+	if err := prepareSchema(ctx, dbConnection); err != nil {
+		return err
+	}
+
+	rail, err := payment.NewPaymentRail(config.PaymentRail, config.Environment)
+	if err != nil {
+		return fmt.Errorf("cannot create payment rail: %w", err)
+	}
+
+	publisher, err := event.NewPublisher(config)
+	if err != nil {
+		return fmt.Errorf("cannot create event publisher: %w", err)
+	}
+	defer publisher.Close()
+
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	workers := health.NewWorkers()
+	streams := stream.NewHub()
+	workers.Go(workersCtx, "nacha_exporter", nacha.NewExporter(store, config).RunDaily)
+	workers.Go(workersCtx, "sepa_exporter", pain.NewSEPAExporter(store, config).Run)
+	workers.Go(workersCtx, "event_relay", event.NewRelay(store, event.NewMultiPublisher(publisher, webhook.NewFanOut(store)), config).Run)
+	workers.Go(workersCtx, "webhook_dispatcher", webhook.NewDispatcher(store, config).Run)
+	workers.Go(workersCtx, "stream_listener", stream.NewListener(streams, config).Run)
+
+	probes := health.NewProbes()
+	probes.AddCheck("database", dbConnection.PingContext)
+	probes.AddCheck("migrations", func(ctx context.Context) error {
+		return migration.CheckVersion(ctx, dbConnection)
+	})
+	probes.AddCheck("workers", workers.Check)
+
+	server, err := api.NewServer(config, store, rail, streams, probes)
+	if err != nil {
+		return fmt.Errorf("cannot create server: %w", err)
+	}
+
+	// serveErrs receives the error of a server stopping before the shutdown
+	serveErrs := make(chan error, 3)
+	go func() {
+		if err := server.Start(config.ServerAddress); err != nil {
+			serveErrs <- fmt.Errorf("cannot start server: %w", err)
+		}
+	}()
+
+	var grpcServer *gapi.Server
+	var gatewayServer *http.Server
+	gatewayCtx, closeGateway := context.WithCancel(context.Background())
+	defer closeGateway()
+
+	if config.GRPCServerAddress != "" {
+		grpcServer, err = gapi.NewServer(config, store)
+		if err != nil {
+			return fmt.Errorf("cannot create gRPC server: %w", err)
+		}
+
+		go func() {
+			if err := grpcServer.Start(config.GRPCServerAddress); err != nil {
+				serveErrs <- fmt.Errorf("cannot start gRPC server: %w", err)
+			}
+		}()
+
+		if config.GatewayServerAddress != "" {
+			gatewayServer, err = gapi.NewGatewayServer(gatewayCtx, config)
+			if err != nil {
+				return fmt.Errorf("cannot create gateway server: %w", err)
+			}
+
+			go func() {
+				if err := gatewayServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+					serveErrs <- fmt.Errorf("cannot start gateway server: %w", err)
+				}
+			}()
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		log.Printf("shutting down")
+	case err = <-serveErrs:
+		log.Printf("shutting down: %v", err)
+	}
+
+	// a second signal kills the server without waiting for the drain
+	stop()
+	probes.Drain()
+	if err == nil && config.ShutdownDrainDelay > 0 {
+		log.Printf("draining for %s", config.ShutdownDrainDelay)
+		time.Sleep(config.ShutdownDrainDelay)
+	}
+
+	timeout := config.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = defaultShutdownTimeout
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if gatewayServer != nil {
+		if err := gatewayServer.Shutdown(shutdownCtx); err != nil {
+			log.Printf("cannot drain gateway server: %v", err)
+		}
+	}
+	if grpcServer != nil {
+		grpcServer.Stop(shutdownCtx)
+	}
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("cannot drain server: %v", err)
+	}
+
+	stopWorkers()
+	if !workers.Wait(shutdownCtx) {
+		log.Printf("background workers didn't stop within %s", timeout)
+	}
+	return err
+}
+
+// prepareSchema migrates the schema when MIGRATE_ON_START is set, the server refuses to start
+// on a schema that is dirty or ahead of the migrations it embeds
+func prepareSchema(ctx context.Context, dbConnection *sql.DB) error {
+	if config.MigrateOnStart {
+		return migration.Apply(ctx, dbConnection, config.DBSource)
+	}
+	return migration.Check(config.DBSource)
+}
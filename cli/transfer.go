@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/spf13/cobra"
+)
+
+var transferArgs transferParams
+
+var transferCmd = &cobra.Command{
+	Use:   "transfer",
+	Short: "Move money between two customer accounts in the same currency",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, dbConnection, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer dbConnection.Close()
+
+		result, err := transfer(auditContext(cmd), store, transferArgs)
+		if err != nil {
+			return err
+		}
+		cmd.Printf("transfer %d moved %d %s from account %d (balance %d) to account %d (balance %d)\n",
+			result.Transfer.ID, result.Transfer.Amount, transferArgs.Currency,
+			result.FromAccount.ID, result.FromAccount.Balance, result.ToAccount.ID, result.ToAccount.Balance)
+		return nil
+	},
+}
+
+func init() {
+	flags := transferCmd.Flags()
+	flags.Int64Var(&transferArgs.FromAccountID, "from", 0, "id of the account to take the money from")
+	flags.Int64Var(&transferArgs.ToAccountID, "to", 0, "id of the account to give the money to")
+	flags.Int64Var(&transferArgs.Amount, "amount", 0, "amount in cents")
+	flags.StringVar(&transferArgs.Currency, "currency", "", "currency of both accounts")
+	flags.StringVar(&transferArgs.Description, "description", "", "description shown on the statements")
+	flags.StringVar(&transferArgs.Reference, "reference", "", "reference of the transfer")
+	for _, name := range []string{"from", "to", "amount", "currency"} {
+		transferCmd.MarkFlagRequired(name)
+	}
+}
+
+type transferParams struct {
+	FromAccountID int64
+	ToAccountID   int64
+	Amount        int64
+	Currency      string
+	Description   string
+	Reference     string
+}
+
+// transfer moves money with the checks of POST /transfers, without the ones about the user making it
+func transfer(ctx context.Context, store db.Store, arg transferParams) (db.TransferTxResult, error) {
+	if arg.Amount <= 0 {
+		return db.TransferTxResult{}, fmt.Errorf("amount must be positive")
+	}
+
+	if arg.FromAccountID == arg.ToAccountID {
+		return db.TransferTxResult{}, fmt.Errorf("cannot transfer from account %d to itself", arg.FromAccountID)
+	}
+
+	for _, id := range []int64{arg.FromAccountID, arg.ToAccountID} {
+		account, err := store.GetAccount(ctx, id)
+		if err != nil {
+			return db.TransferTxResult{}, fmt.Errorf("cannot get account %d: %w", id, err)
+		}
+
+		if account.Kind != util.AccountKindCustomer {
+			return db.TransferTxResult{}, fmt.Errorf("account %d is not a customer account", account.ID)
+		}
+
+		if account.Currency != arg.Currency {
+			return db.TransferTxResult{}, fmt.Errorf("account %d currency mismatch: %s vs %s", account.ID, account.Currency, arg.Currency)
+		}
+
+		if id == arg.FromAccountID && account.FrozenAt.Valid {
+			return db.TransferTxResult{}, fmt.Errorf("account %d is frozen", account.ID)
+		}
+	}
+
+	result, err := store.TransferTx(ctx, db.TransferTxParams{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+		Description:   arg.Description,
+		Reference:     arg.Reference,
+	})
+	if err != nil {
+		return db.TransferTxResult{}, fmt.Errorf("cannot transfer: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// expectAccounts makes accounts 1 and 2 USD customer accounts, 3 a EUR one, 4 a frozen USD one and 5 a suspense account
+func expectAccounts(store *mocks.MockStore) {
+	accounts := map[int64]db.Account{
+		1: {ID: 1, Kind: util.AccountKindCustomer, Currency: util.USD, Balance: 100},
+		2: {ID: 2, Kind: util.AccountKindCustomer, Currency: util.USD},
+		3: {ID: 3, Kind: util.AccountKindCustomer, Currency: util.EUR},
+		4: {ID: 4, Kind: util.AccountKindCustomer, Currency: util.USD, FrozenAt: sql.NullTime{Time: time.Now(), Valid: true}},
+		5: {ID: 5, Kind: util.AccountKindSuspense, Currency: util.USD},
+	}
+	store.EXPECT().
+		GetAccount(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, id int64) (db.Account, error) {
+			account, ok := accounts[id]
+			if !ok {
+				return db.Account{}, sql.ErrNoRows
+			}
+			return account, nil
+		}).
+		AnyTimes()
+}
+
+func TestTransfer(t *testing.T) {
+	testCases := []struct {
+		name          string
+		arg           transferParams
+		TransferTimes int
+		errText       string
+	}{
+		{name: "OK", arg: transferParams{FromAccountID: 1, ToAccountID: 2, Amount: 10, Currency: util.USD}, TransferTimes: 1},
+		{name: "negative amount", arg: transferParams{FromAccountID: 1, ToAccountID: 2, Amount: -10, Currency: util.USD}, errText: "amount must be positive"},
+		{name: "same account", arg: transferParams{FromAccountID: 1, ToAccountID: 1, Amount: 10, Currency: util.USD}, errText: "to itself"},
+		{name: "not found", arg: transferParams{FromAccountID: 1, ToAccountID: 9, Amount: 10, Currency: util.USD}, errText: "cannot get account 9"},
+		{name: "currency mismatch", arg: transferParams{FromAccountID: 1, ToAccountID: 3, Amount: 10, Currency: util.USD}, errText: "currency mismatch"},
+		{name: "frozen", arg: transferParams{FromAccountID: 4, ToAccountID: 1, Amount: 10, Currency: util.USD}, errText: "account 4 is frozen"},
+		{name: "to frozen", arg: transferParams{FromAccountID: 1, ToAccountID: 4, Amount: 10, Currency: util.USD}, TransferTimes: 1},
+		{name: "internal account", arg: transferParams{FromAccountID: 5, ToAccountID: 1, Amount: 10, Currency: util.USD}, errText: "not a customer account"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mocks.NewMockStore(ctrl)
+			expectAccounts(store)
+			store.EXPECT().
+				TransferTx(gomock.Any(), db.TransferTxParams{
+					FromAccountID: tc.arg.FromAccountID,
+					ToAccountID:   tc.arg.ToAccountID,
+					Amount:        tc.arg.Amount,
+				}).
+				Times(tc.TransferTimes).
+				Return(db.TransferTxResult{Transfer: db.Transfer{ID: 1, Amount: tc.arg.Amount}}, nil)
+
+			_, err := transfer(context.Background(), store, tc.arg)
+			if tc.errText == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errText)
+		})
+	}
+}
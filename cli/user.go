@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/spf13/cobra"
+)
+
+var userCmd = &cobra.Command{
+	Use:   "user",
+	Short: "Manage the users",
+}
+
+var createUserArgs createUserParams
+
+var userCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a user, with a staff role to give access to the back office",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, dbConnection, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer dbConnection.Close()
+
+		user, err := createUser(auditContext(cmd), store, createUserArgs)
+		if err != nil {
+			return err
+		}
+		cmd.Printf("created %s user %d %s\n", user.Role, user.ID, user.Username)
+		return nil
+	},
+}
+
+func init() {
+	flags := userCreateCmd.Flags()
+	flags.StringVar(&createUserArgs.Username, "username", "", "username to log in with")
+	flags.StringVar(&createUserArgs.FullName, "full-name", "", "full name of the user")
+	flags.StringVar(&createUserArgs.Email, "email", "", "email of the user")
+	flags.StringVar(&createUserArgs.Password, "password", "", "password of at least 8 characters")
+	flags.StringVar(&createUserArgs.Role, "role", util.UserRoleCustomer, "customer, support or admin")
+	for _, name := range []string{"username", "full-name", "email", "password"} {
+		userCreateCmd.MarkFlagRequired(name)
+	}
+
+	userCmd.AddCommand(userCreateCmd)
+}
+
+type createUserParams struct {
+	Username string
+	FullName string
+	Email    string
+	Password string
+	Role     string
+}
+
+// createUser creates a user like POST /users does, then gives it its role
+func createUser(ctx context.Context, store db.Store, arg createUserParams) (db.User, error) {
+	switch arg.Role {
+	case util.UserRoleCustomer, util.UserRoleSupport, util.UserRoleAdmin:
+	default:
+		return db.User{}, fmt.Errorf("unknown role %q", arg.Role)
+	}
+
+	if len(arg.Password) < 8 {
+		return db.User{}, fmt.Errorf("password must have at least 8 characters")
+	}
+
+	hashedPassword, err := util.HashPassword(arg.Password)
+	if err != nil {
+		return db.User{}, err
+	}
+
+	user, err := store.CreateUser(ctx, db.CreateUserParams{
+		Username:       arg.Username,
+		HashedPassword: hashedPassword,
+		FullName:       arg.FullName,
+		Email:          arg.Email,
+	})
+	if err != nil {
+		return db.User{}, fmt.Errorf("cannot create user: %w", err)
+	}
+
+	if arg.Role == user.Role {
+		return user, nil
+	}
+
+	user, err = store.UpdateUserRole(ctx, db.UpdateUserRoleParams{ID: user.ID, Role: arg.Role})
+	if err != nil {
+		return db.User{}, fmt.Errorf("cannot give user %d the %s role: %w", user.ID, arg.Role, err)
+	}
+	return user, nil
+}
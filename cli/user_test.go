@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateUser(t *testing.T) {
+	testCases := []struct {
+		name            string
+		arg             createUserParams
+		CreateTimes     int
+		UpdateRoleTimes int
+		errText         string
+	}{
+		{name: "customer", arg: createUserParams{Username: "alice", Password: "secret123", Role: util.UserRoleCustomer}, CreateTimes: 1},
+		{name: "admin", arg: createUserParams{Username: "alice", Password: "secret123", Role: util.UserRoleAdmin}, CreateTimes: 1, UpdateRoleTimes: 1},
+		{name: "unknown role", arg: createUserParams{Username: "alice", Password: "secret123", Role: "root"}, errText: "unknown role"},
+		{name: "short password", arg: createUserParams{Username: "alice", Password: "secret", Role: util.UserRoleCustomer}, errText: "password"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mocks.NewMockStore(ctrl)
+			store.EXPECT().
+				CreateUser(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.CreateUserParams) (db.User, error) {
+					require.Equal(t, tc.arg.Username, arg.Username)
+					require.NoError(t, util.CheckPassword(tc.arg.Password, arg.HashedPassword))
+					return db.User{ID: 1, Username: arg.Username, Role: util.UserRoleCustomer}, nil
+				}).
+				Times(tc.CreateTimes)
+			store.EXPECT().
+				UpdateUserRole(gomock.Any(), db.UpdateUserRoleParams{ID: 1, Role: tc.arg.Role}).
+				Times(tc.UpdateRoleTimes).
+				Return(db.User{ID: 1, Username: tc.arg.Username, Role: tc.arg.Role}, nil)
+
+			user, err := createUser(context.Background(), store, tc.arg)
+			if tc.errText == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.arg.Role, user.Role)
+				return
+			}
+			require.ErrorContains(t, err, tc.errText)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS "balance_adjustments";
+
+DELETE FROM "entries" WHERE "account_id" IN (SELECT "id" FROM "accounts" WHERE "kind" <> 'customer');
+
+DELETE FROM "accounts" WHERE "kind" <> 'customer';
+
+DELETE FROM "users" WHERE "email" = 'system@simplebank.internal';
+
+DROP INDEX IF EXISTS "internal_account_key";
+
+DROP INDEX IF EXISTS "user_currency_key";
+
+ALTER TABLE IF EXISTS "accounts" ADD CONSTRAINT "user_currency_key" UNIQUE ("user_id", "currency");
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "kind";
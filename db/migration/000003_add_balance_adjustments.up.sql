@@ -0,0 +1,41 @@
+ALTER TABLE "accounts" ADD COLUMN "kind" varchar NOT NULL DEFAULT 'customer';
+
+ALTER TABLE "accounts" DROP CONSTRAINT IF EXISTS "user_currency_key";
+
+CREATE UNIQUE INDEX "user_currency_key" ON "accounts" ("user_id", "currency") WHERE "kind" = 'customer';
+
+CREATE UNIQUE INDEX "internal_account_key" ON "accounts" ("kind", "currency") WHERE "kind" <> 'customer';
+
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email")
+VALUES ('system', '!', 'Simple Bank', 'system@simplebank.internal');
+
+INSERT INTO "accounts" ("user_id", "balance", "currency", "kind")
+SELECT "users"."id", 0, "currencies"."code", 'suspense'
+FROM "users", (VALUES ('USD'), ('EUR'), ('CAD')) AS "currencies" ("code")
+WHERE "users"."email" = 'system@simplebank.internal';
+
+CREATE TABLE "balance_adjustments" (
+                                       "id" bigserial PRIMARY KEY,
+                                       "account_id" bigint NOT NULL,
+                                       "suspense_account_id" bigint NOT NULL,
+                                       "account_entry_id" bigint NOT NULL,
+                                       "suspense_entry_id" bigint NOT NULL,
+                                       "amount" bigint NOT NULL,
+                                       "reason_code" varchar NOT NULL,
+                                       "justification" text NOT NULL,
+                                       "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "balance_adjustments" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "balance_adjustments" ADD FOREIGN KEY ("suspense_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "balance_adjustments" ADD FOREIGN KEY ("account_entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "balance_adjustments" ADD FOREIGN KEY ("suspense_entry_id") REFERENCES "entries" ("id");
+
+CREATE INDEX ON "balance_adjustments" ("account_id");
+
+COMMENT ON COLUMN "accounts"."kind" IS 'customer, or the purpose of an internal bank account (suspense, ...)';
+
+COMMENT ON COLUMN "balance_adjustments"."amount" IS 'credited to the account when positive, debited when negative';
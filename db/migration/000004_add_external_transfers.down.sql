@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "external_transfers";
+
+DELETE FROM "entries" WHERE "account_id" IN (SELECT "id" FROM "accounts" WHERE "kind" = 'clearing');
+
+DELETE FROM "accounts" WHERE "kind" = 'clearing';
@@ -0,0 +1,35 @@
+INSERT INTO "accounts" ("user_id", "balance", "currency", "kind")
+SELECT "users"."id", 0, "currencies"."code", 'clearing'
+FROM "users", (VALUES ('USD'), ('EUR'), ('CAD')) AS "currencies" ("code")
+WHERE "users"."email" = 'system@simplebank.internal';
+
+CREATE TABLE "external_transfers" (
+                                      "id" bigserial PRIMARY KEY,
+                                      "account_id" bigint NOT NULL,
+                                      "clearing_account_id" bigint NOT NULL,
+                                      "direction" varchar NOT NULL,
+                                      "amount" bigint NOT NULL,
+                                      "currency" varchar NOT NULL,
+                                      "rail" varchar NOT NULL,
+                                      "external_reference" varchar,
+                                      "status" varchar NOT NULL DEFAULT 'pending',
+                                      "failure_reason" varchar NOT NULL DEFAULT '',
+                                      "created_at" timestamptz NOT NULL DEFAULT (now()),
+                                      "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "external_transfers" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "external_transfers" ADD FOREIGN KEY ("clearing_account_id") REFERENCES "accounts" ("id");
+
+CREATE INDEX ON "external_transfers" ("account_id");
+
+CREATE UNIQUE INDEX ON "external_transfers" ("rail", "external_reference");
+
+COMMENT ON COLUMN "external_transfers"."direction" IS 'deposit or withdrawal';
+
+COMMENT ON COLUMN "external_transfers"."amount" IS 'must be positive';
+
+COMMENT ON COLUMN "external_transfers"."external_reference" IS 'id assigned by the payment rail';
+
+COMMENT ON COLUMN "external_transfers"."status" IS 'pending, completed or failed';
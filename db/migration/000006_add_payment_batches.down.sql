@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "payment_batches";
+
+ALTER TABLE IF EXISTS "users" DROP CONSTRAINT IF EXISTS "username_key";
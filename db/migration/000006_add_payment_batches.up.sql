@@ -0,0 +1,17 @@
+ALTER TABLE "users" ADD CONSTRAINT "username_key" UNIQUE ("username");
+
+CREATE TABLE "payment_batches" (
+                                   "id" bigserial PRIMARY KEY,
+                                   "user_id" bigint NOT NULL,
+                                   "message_id" varchar NOT NULL,
+                                   "number_of_transactions" bigint NOT NULL,
+                                   "accepted_transactions" bigint NOT NULL DEFAULT 0,
+                                   "rejected_transactions" bigint NOT NULL DEFAULT 0,
+                                   "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "payment_batches" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
+
+ALTER TABLE "payment_batches" ADD CONSTRAINT "user_message_key" UNIQUE ("user_id", "message_id");
+
+COMMENT ON COLUMN "payment_batches"."message_id" IS 'pain.001 GrpHdr/MsgId, unique per user to reject duplicated files';
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS "outbound_payments";
+
+DROP TABLE IF EXISTS "ach_files";
+
+DROP TABLE IF EXISTS "beneficiaries";
+
+DELETE FROM "entries" WHERE "account_id" IN (SELECT "id" FROM "accounts" WHERE "kind" = 'ach_clearing');
+
+DELETE FROM "accounts" WHERE "kind" = 'ach_clearing';
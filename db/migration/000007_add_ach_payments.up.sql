@@ -0,0 +1,67 @@
+INSERT INTO "accounts" ("user_id", "balance", "currency", "kind")
+SELECT "users"."id", 0, 'USD', 'ach_clearing'
+FROM "users"
+WHERE "users"."email" = 'system@simplebank.internal';
+
+CREATE TABLE "beneficiaries" (
+                                 "id" bigserial PRIMARY KEY,
+                                 "user_id" bigint NOT NULL,
+                                 "name" varchar NOT NULL,
+                                 "routing_number" varchar NOT NULL,
+                                 "account_number" varchar NOT NULL,
+                                 "account_type" varchar NOT NULL DEFAULT 'checking',
+                                 "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "ach_files" (
+                             "id" bigserial PRIMARY KEY,
+                             "file_name" varchar NOT NULL,
+                             "entry_count" bigint NOT NULL,
+                             "total_amount" bigint NOT NULL,
+                             "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "outbound_payments" (
+                                     "id" bigserial PRIMARY KEY,
+                                     "account_id" bigint NOT NULL,
+                                     "beneficiary_id" bigint NOT NULL,
+                                     "clearing_account_id" bigint NOT NULL,
+                                     "amount" bigint NOT NULL,
+                                     "currency" varchar NOT NULL,
+                                     "status" varchar NOT NULL DEFAULT 'pending',
+                                     "ach_file_id" bigint,
+                                     "trace_number" varchar,
+                                     "return_code" varchar NOT NULL DEFAULT '',
+                                     "created_at" timestamptz NOT NULL DEFAULT (now()),
+                                     "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "beneficiaries" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
+
+ALTER TABLE "outbound_payments" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "outbound_payments" ADD FOREIGN KEY ("beneficiary_id") REFERENCES "beneficiaries" ("id");
+
+ALTER TABLE "outbound_payments" ADD FOREIGN KEY ("clearing_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "outbound_payments" ADD FOREIGN KEY ("ach_file_id") REFERENCES "ach_files" ("id");
+
+CREATE INDEX ON "beneficiaries" ("user_id");
+
+CREATE INDEX ON "outbound_payments" ("account_id");
+
+CREATE INDEX ON "outbound_payments" ("status");
+
+CREATE UNIQUE INDEX ON "outbound_payments" ("trace_number");
+
+COMMENT ON COLUMN "beneficiaries"."routing_number" IS 'ABA routing transit number, 9 digits with check digit';
+
+COMMENT ON COLUMN "beneficiaries"."account_type" IS 'checking or savings';
+
+COMMENT ON COLUMN "outbound_payments"."amount" IS 'must be positive';
+
+COMMENT ON COLUMN "outbound_payments"."status" IS 'pending, submitted or returned';
+
+COMMENT ON COLUMN "outbound_payments"."trace_number" IS 'NACHA entry trace number, set when the payment is written to a file';
+
+COMMENT ON COLUMN "outbound_payments"."return_code" IS 'NACHA return reason code, R01 to R85';
@@ -0,0 +1,19 @@
+DELETE FROM "outbound_payments" WHERE "rail" = 'sepa';
+
+DROP INDEX IF EXISTS "outbound_payments_rail_status_idx";
+
+ALTER TABLE IF EXISTS "outbound_payments" DROP COLUMN IF EXISTS "sepa_batch_id";
+
+ALTER TABLE IF EXISTS "outbound_payments" DROP COLUMN IF EXISTS "rail";
+
+DROP TABLE IF EXISTS "sepa_batches";
+
+ALTER TABLE IF EXISTS "beneficiaries" DROP COLUMN IF EXISTS "bic";
+
+ALTER TABLE IF EXISTS "beneficiaries" DROP COLUMN IF EXISTS "iban";
+
+ALTER TABLE IF EXISTS "beneficiaries" DROP COLUMN IF EXISTS "currency";
+
+DELETE FROM "entries" WHERE "account_id" IN (SELECT "id" FROM "accounts" WHERE "kind" = 'sepa_clearing');
+
+DELETE FROM "accounts" WHERE "kind" = 'sepa_clearing';
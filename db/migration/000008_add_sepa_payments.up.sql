@@ -0,0 +1,37 @@
+INSERT INTO "accounts" ("user_id", "balance", "currency", "kind")
+SELECT "users"."id", 0, 'EUR', 'sepa_clearing'
+FROM "users"
+WHERE "users"."email" = 'system@simplebank.internal';
+
+ALTER TABLE "beneficiaries" ADD COLUMN "currency" varchar NOT NULL DEFAULT 'USD';
+
+ALTER TABLE "beneficiaries" ADD COLUMN "iban" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "beneficiaries" ADD COLUMN "bic" varchar NOT NULL DEFAULT '';
+
+CREATE TABLE "sepa_batches" (
+                                "id" bigserial PRIMARY KEY,
+                                "message_id" varchar UNIQUE NOT NULL,
+                                "file_name" varchar NOT NULL,
+                                "entry_count" bigint NOT NULL,
+                                "total_amount" bigint NOT NULL,
+                                "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "outbound_payments" ADD COLUMN "rail" varchar NOT NULL DEFAULT 'ach';
+
+ALTER TABLE "outbound_payments" ADD COLUMN "sepa_batch_id" bigint;
+
+ALTER TABLE "outbound_payments" ADD FOREIGN KEY ("sepa_batch_id") REFERENCES "sepa_batches" ("id");
+
+CREATE INDEX ON "outbound_payments" ("rail", "status");
+
+COMMENT ON COLUMN "beneficiaries"."currency" IS 'USD beneficiaries are paid by ACH, EUR beneficiaries by SEPA credit transfer';
+
+COMMENT ON COLUMN "beneficiaries"."iban" IS 'set for EUR beneficiaries';
+
+COMMENT ON COLUMN "beneficiaries"."bic" IS 'set for EUR beneficiaries';
+
+COMMENT ON COLUMN "sepa_batches"."message_id" IS 'pain.001 GrpHdr/MsgId of the batch';
+
+COMMENT ON COLUMN "outbound_payments"."rail" IS 'ach or sepa';
@@ -0,0 +1,25 @@
+CREATE TABLE "payees" (
+                          "id" bigserial PRIMARY KEY,
+                          "user_id" bigint NOT NULL,
+                          "nickname" varchar NOT NULL,
+                          "account_id" bigint,
+                          "beneficiary_id" bigint,
+                          "verified" boolean NOT NULL DEFAULT false,
+                          "created_at" timestamptz NOT NULL DEFAULT (now()),
+                          "updated_at" timestamptz NOT NULL DEFAULT (now()),
+                          CONSTRAINT "payee_target_check" CHECK (("account_id" IS NULL) <> ("beneficiary_id" IS NULL))
+);
+
+ALTER TABLE "payees" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
+
+ALTER TABLE "payees" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "payees" ADD FOREIGN KEY ("beneficiary_id") REFERENCES "beneficiaries" ("id");
+
+ALTER TABLE "payees" ADD CONSTRAINT "user_nickname_key" UNIQUE ("user_id", "nickname");
+
+COMMENT ON COLUMN "payees"."account_id" IS 'set when the payee is an account of the bank';
+
+COMMENT ON COLUMN "payees"."beneficiary_id" IS 'set when the payee is an external bank account';
+
+COMMENT ON COLUMN "payees"."verified" IS 'the target account was found and its holder matched';
@@ -0,0 +1,33 @@
+CREATE TABLE "payment_requests" (
+                                    "id" bigserial PRIMARY KEY,
+                                    "requester_id" bigint NOT NULL,
+                                    "requester_account_id" bigint NOT NULL,
+                                    "payer_id" bigint NOT NULL,
+                                    "amount" bigint NOT NULL,
+                                    "currency" varchar NOT NULL,
+                                    "memo" varchar NOT NULL DEFAULT '',
+                                    "status" varchar NOT NULL DEFAULT 'pending',
+                                    "transfer_id" bigint,
+                                    "expires_at" timestamptz NOT NULL,
+                                    "created_at" timestamptz NOT NULL DEFAULT (now()),
+                                    "updated_at" timestamptz NOT NULL DEFAULT (now()),
+                                    CONSTRAINT "payment_request_amount_check" CHECK ("amount" > 0)
+);
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("requester_id") REFERENCES "users" ("id");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("requester_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("payer_id") REFERENCES "users" ("id");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "payment_requests" ("requester_id");
+
+CREATE INDEX ON "payment_requests" ("payer_id");
+
+COMMENT ON COLUMN "payment_requests"."requester_account_id" IS 'the account credited when the request is accepted';
+
+COMMENT ON COLUMN "payment_requests"."status" IS 'pending, accepted, declined, cancelled or expired';
+
+COMMENT ON COLUMN "payment_requests"."transfer_id" IS 'set when the request is accepted';
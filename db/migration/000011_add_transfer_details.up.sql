@@ -0,0 +1,29 @@
+ALTER TABLE "transfers" ADD COLUMN "description" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "transfers" ADD COLUMN "reference" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "transfers" ADD COLUMN "metadata" jsonb NOT NULL DEFAULT '{}';
+
+ALTER TABLE "entries" ADD COLUMN "description" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "entries" ADD COLUMN "reference" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "entries" ADD COLUMN "metadata" jsonb NOT NULL DEFAULT '{}';
+
+CREATE INDEX ON "transfers" ("reference");
+
+CREATE INDEX ON "transfers" USING GIN ("metadata");
+
+CREATE INDEX ON "entries" ("account_id", "reference");
+
+COMMENT ON COLUMN "transfers"."description" IS 'what the transfer was for, shown to customers';
+
+COMMENT ON COLUMN "transfers"."reference" IS 'reference of the integrator, used to correlate the transfer';
+
+COMMENT ON COLUMN "transfers"."metadata" IS 'flat map of string values set by the integrator';
+
+COMMENT ON COLUMN "entries"."description" IS 'copied from the transfer that posted the entry';
+
+COMMENT ON COLUMN "entries"."reference" IS 'copied from the transfer that posted the entry';
+
+COMMENT ON COLUMN "entries"."metadata" IS 'copied from the transfer that posted the entry';
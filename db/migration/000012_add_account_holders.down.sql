@@ -0,0 +1,5 @@
+COMMENT ON INDEX "user_currency_key" IS NULL;
+
+DROP TABLE IF EXISTS "account_invitations";
+
+DROP TABLE IF EXISTS "account_holders";
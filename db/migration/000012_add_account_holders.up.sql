@@ -0,0 +1,47 @@
+CREATE TABLE "account_holders" (
+                                   "account_id" bigint NOT NULL,
+                                   "user_id" bigint NOT NULL,
+                                   "role" varchar NOT NULL,
+                                   "created_at" timestamptz NOT NULL DEFAULT (now()),
+                                   PRIMARY KEY ("account_id", "user_id")
+);
+
+CREATE TABLE "account_invitations" (
+                                       "id" bigserial PRIMARY KEY,
+                                       "account_id" bigint NOT NULL,
+                                       "inviter_id" bigint NOT NULL,
+                                       "invitee_id" bigint NOT NULL,
+                                       "role" varchar NOT NULL,
+                                       "status" varchar NOT NULL DEFAULT 'pending',
+                                       "created_at" timestamptz NOT NULL DEFAULT (now()),
+                                       "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "account_holders" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "account_holders" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
+
+ALTER TABLE "account_invitations" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "account_invitations" ADD FOREIGN KEY ("inviter_id") REFERENCES "users" ("id");
+
+ALTER TABLE "account_invitations" ADD FOREIGN KEY ("invitee_id") REFERENCES "users" ("id");
+
+CREATE INDEX ON "account_holders" ("user_id");
+
+CREATE UNIQUE INDEX "account_invitee_pending_key" ON "account_invitations" ("account_id", "invitee_id") WHERE "status" = 'pending';
+
+CREATE INDEX ON "account_invitations" ("invitee_id", "status");
+
+INSERT INTO "account_holders" ("account_id", "user_id", "role")
+SELECT "id", "user_id", 'owner'
+FROM "accounts"
+WHERE "kind" = 'customer';
+
+COMMENT ON COLUMN "account_holders"."role" IS 'owner, co_owner or viewer, the owner is accounts.user_id';
+
+COMMENT ON COLUMN "account_invitations"."role" IS 'co_owner or viewer';
+
+COMMENT ON COLUMN "account_invitations"."status" IS 'pending, accepted, declined or revoked';
+
+COMMENT ON INDEX "user_currency_key" IS 'a user owns at most one customer account per currency, co-owned and viewed accounts are not counted';
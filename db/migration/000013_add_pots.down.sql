@@ -0,0 +1,35 @@
+UPDATE "accounts" SET "balance" = "accounts"."balance" + "pots"."total"
+FROM (SELECT "parent_id", SUM("balance") AS "total" FROM "accounts" WHERE "kind" = 'pot' GROUP BY "parent_id") AS "pots"
+WHERE "accounts"."id" = "pots"."parent_id";
+
+DELETE FROM "entries" WHERE "transfer_id" IN (
+    SELECT "id" FROM "transfers"
+    WHERE "from_account_id" IN (SELECT "id" FROM "accounts" WHERE "kind" = 'pot')
+       OR "to_account_id" IN (SELECT "id" FROM "accounts" WHERE "kind" = 'pot')
+);
+
+DELETE FROM "transfers"
+WHERE "from_account_id" IN (SELECT "id" FROM "accounts" WHERE "kind" = 'pot')
+   OR "to_account_id" IN (SELECT "id" FROM "accounts" WHERE "kind" = 'pot');
+
+DELETE FROM "accounts" WHERE "kind" = 'pot';
+
+DROP INDEX IF EXISTS "pot_name_key";
+
+DROP INDEX IF EXISTS "internal_account_key";
+
+CREATE UNIQUE INDEX "internal_account_key" ON "accounts" ("kind", "currency") WHERE "kind" <> 'customer';
+
+COMMENT ON COLUMN "accounts"."kind" IS 'customer, or the purpose of an internal bank account (suspense, ...)';
+
+ALTER TABLE IF EXISTS "accounts" DROP CONSTRAINT IF EXISTS "goal_amount_check";
+
+ALTER TABLE IF EXISTS "accounts" DROP CONSTRAINT IF EXISTS "pot_parent_check";
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "target_date";
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "goal_amount";
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "name";
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "parent_id";
@@ -0,0 +1,27 @@
+ALTER TABLE "accounts" ADD COLUMN "parent_id" bigint;
+
+ALTER TABLE "accounts" ADD COLUMN "name" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "accounts" ADD COLUMN "goal_amount" bigint;
+
+ALTER TABLE "accounts" ADD COLUMN "target_date" date;
+
+ALTER TABLE "accounts" ADD FOREIGN KEY ("parent_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "accounts" ADD CONSTRAINT "pot_parent_check" CHECK (("kind" = 'pot') = ("parent_id" IS NOT NULL));
+
+ALTER TABLE "accounts" ADD CONSTRAINT "goal_amount_check" CHECK ("goal_amount" > 0);
+
+DROP INDEX IF EXISTS "internal_account_key";
+
+CREATE UNIQUE INDEX "internal_account_key" ON "accounts" ("kind", "currency") WHERE "kind" NOT IN ('customer', 'pot');
+
+CREATE UNIQUE INDEX "pot_name_key" ON "accounts" ("parent_id", "name") WHERE "kind" = 'pot';
+
+COMMENT ON COLUMN "accounts"."parent_id" IS 'the customer account a pot belongs to, pots are held by the holders of their parent';
+
+COMMENT ON COLUMN "accounts"."goal_amount" IS 'optional amount the customer saves up for in a pot';
+
+COMMENT ON COLUMN "accounts"."name" IS 'name of a pot, unique among the pots of an account';
+
+COMMENT ON COLUMN "accounts"."kind" IS 'customer, pot, or the purpose of an internal bank account (suspense, ...)';
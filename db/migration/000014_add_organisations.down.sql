@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS "transfer_approvals";
+
+DROP INDEX IF EXISTS "organisation_currency_key";
+
+DROP INDEX IF EXISTS "user_currency_key";
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "organisation_id";
+
+CREATE UNIQUE INDEX "user_currency_key" ON "accounts" ("user_id", "currency") WHERE "kind" = 'customer';
+
+COMMENT ON INDEX "user_currency_key" IS 'a user owns at most one customer account per currency, co-owned and viewed accounts are not counted';
+
+DROP TABLE IF EXISTS "organisation_members";
+
+DROP TABLE IF EXISTS "organisations";
@@ -0,0 +1,80 @@
+CREATE TABLE "organisations" (
+                                 "id" bigserial PRIMARY KEY,
+                                 "name" varchar NOT NULL,
+                                 "created_by" bigint NOT NULL,
+                                 "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "organisation_members" (
+                                        "organisation_id" bigint NOT NULL,
+                                        "user_id" bigint NOT NULL,
+                                        "role" varchar NOT NULL,
+                                        "transfer_limit" bigint,
+                                        "created_at" timestamptz NOT NULL DEFAULT (now()),
+                                        PRIMARY KEY ("organisation_id", "user_id"),
+                                        CONSTRAINT "transfer_limit_check" CHECK ("transfer_limit" >= 0)
+);
+
+CREATE TABLE "transfer_approvals" (
+                                      "id" bigserial PRIMARY KEY,
+                                      "organisation_id" bigint NOT NULL,
+                                      "from_account_id" bigint NOT NULL,
+                                      "to_account_id" bigint NOT NULL,
+                                      "amount" bigint NOT NULL,
+                                      "description" varchar NOT NULL DEFAULT '',
+                                      "reference" varchar NOT NULL DEFAULT '',
+                                      "metadata" jsonb NOT NULL DEFAULT '{}',
+                                      "initiator_id" bigint NOT NULL,
+                                      "status" varchar NOT NULL DEFAULT 'pending',
+                                      "approver_id" bigint,
+                                      "transfer_id" bigint,
+                                      "created_at" timestamptz NOT NULL DEFAULT (now()),
+                                      "updated_at" timestamptz NOT NULL DEFAULT (now()),
+                                      CONSTRAINT "transfer_approval_amount_check" CHECK ("amount" > 0)
+);
+
+ALTER TABLE "accounts" ADD COLUMN "organisation_id" bigint;
+
+ALTER TABLE "organisations" ADD FOREIGN KEY ("created_by") REFERENCES "users" ("id");
+
+ALTER TABLE "organisation_members" ADD FOREIGN KEY ("organisation_id") REFERENCES "organisations" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "organisation_members" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
+
+ALTER TABLE "accounts" ADD FOREIGN KEY ("organisation_id") REFERENCES "organisations" ("id");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("organisation_id") REFERENCES "organisations" ("id");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("initiator_id") REFERENCES "users" ("id");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("approver_id") REFERENCES "users" ("id");
+
+ALTER TABLE "transfer_approvals" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "organisation_members" ("user_id");
+
+CREATE INDEX ON "transfer_approvals" ("organisation_id", "status");
+
+DROP INDEX IF EXISTS "user_currency_key";
+
+CREATE UNIQUE INDEX "user_currency_key" ON "accounts" ("user_id", "currency") WHERE "kind" = 'customer' AND "organisation_id" IS NULL;
+
+CREATE UNIQUE INDEX "organisation_currency_key" ON "accounts" ("organisation_id", "currency") WHERE "kind" = 'customer';
+
+COMMENT ON INDEX "user_currency_key" IS 'a user owns at most one personal customer account per currency, co-owned, viewed and organisation accounts are not counted';
+
+COMMENT ON INDEX "organisation_currency_key" IS 'an organisation owns at most one customer account per currency';
+
+COMMENT ON COLUMN "accounts"."organisation_id" IS 'organisation owning the account, its members are authorized through their role instead of account_holders';
+
+COMMENT ON COLUMN "organisation_members"."role" IS 'admin, initiator, approver or viewer';
+
+COMMENT ON COLUMN "organisation_members"."transfer_limit" IS 'largest amount the member can move in one payment, no limit when null';
+
+COMMENT ON COLUMN "transfer_approvals"."status" IS 'pending, approved or rejected';
+
+COMMENT ON COLUMN "transfer_approvals"."approver_id" IS 'the member who approved or rejected the transfer, never its initiator';
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS "admin_audit_logs";
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "frozen_reason";
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "frozen_at";
+
+ALTER TABLE "users" DROP CONSTRAINT IF EXISTS "user_role_check";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "role";
@@ -0,0 +1,28 @@
+ALTER TABLE "users" ADD COLUMN "role" varchar NOT NULL DEFAULT 'customer';
+
+ALTER TABLE "users" ADD CONSTRAINT "user_role_check" CHECK ("role" IN ('customer', 'support', 'admin'));
+
+ALTER TABLE "accounts" ADD COLUMN "frozen_at" timestamptz;
+
+ALTER TABLE "accounts" ADD COLUMN "frozen_reason" varchar NOT NULL DEFAULT '';
+
+CREATE TABLE "admin_audit_logs" (
+                                    "id" bigserial PRIMARY KEY,
+                                    "actor_id" bigint NOT NULL,
+                                    "action" varchar NOT NULL,
+                                    "path" varchar NOT NULL,
+                                    "status_code" int NOT NULL,
+                                    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "admin_audit_logs" ADD FOREIGN KEY ("actor_id") REFERENCES "users" ("id");
+
+CREATE INDEX ON "admin_audit_logs" ("actor_id");
+
+COMMENT ON COLUMN "users"."role" IS 'customer, or support and admin for the staff using the back office';
+
+COMMENT ON COLUMN "accounts"."frozen_at" IS 'no money can be moved out of a frozen account until it is unfrozen';
+
+COMMENT ON COLUMN "admin_audit_logs"."action" IS 'method and route of the back office endpoint, e.g. POST /admin/accounts/:id/freeze';
+
+COMMENT ON COLUMN "admin_audit_logs"."path" IS 'requested path with its query string';
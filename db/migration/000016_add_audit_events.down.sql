@@ -0,0 +1,41 @@
+DROP TRIGGER IF EXISTS "audit_transfer_approvals" ON "transfer_approvals";
+
+DROP TRIGGER IF EXISTS "audit_organisation_members" ON "organisation_members";
+
+DROP TRIGGER IF EXISTS "audit_organisations" ON "organisations";
+
+DROP TRIGGER IF EXISTS "audit_account_invitations" ON "account_invitations";
+
+DROP TRIGGER IF EXISTS "audit_account_holders" ON "account_holders";
+
+DROP TRIGGER IF EXISTS "audit_payment_requests" ON "payment_requests";
+
+DROP TRIGGER IF EXISTS "audit_payees" ON "payees";
+
+DROP TRIGGER IF EXISTS "audit_sepa_batches" ON "sepa_batches";
+
+DROP TRIGGER IF EXISTS "audit_outbound_payments" ON "outbound_payments";
+
+DROP TRIGGER IF EXISTS "audit_ach_files" ON "ach_files";
+
+DROP TRIGGER IF EXISTS "audit_beneficiaries" ON "beneficiaries";
+
+DROP TRIGGER IF EXISTS "audit_payment_batches" ON "payment_batches";
+
+DROP TRIGGER IF EXISTS "audit_external_transfers" ON "external_transfers";
+
+DROP TRIGGER IF EXISTS "audit_balance_adjustments" ON "balance_adjustments";
+
+DROP TRIGGER IF EXISTS "audit_entries" ON "entries";
+
+DROP TRIGGER IF EXISTS "audit_transfers" ON "transfers";
+
+DROP TRIGGER IF EXISTS "audit_accounts" ON "accounts";
+
+DROP TRIGGER IF EXISTS "audit_users" ON "users";
+
+DROP TABLE IF EXISTS "audit_events";
+
+DROP FUNCTION IF EXISTS forbid_audit_event_change();
+
+DROP FUNCTION IF EXISTS record_audit_event();
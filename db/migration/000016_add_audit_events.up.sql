@@ -0,0 +1,133 @@
+CREATE TABLE "audit_events" (
+                                "id" bigserial PRIMARY KEY,
+                                "actor_id" bigint,
+                                "action" varchar NOT NULL,
+                                "resource_type" varchar NOT NULL,
+                                "resource_id" varchar NOT NULL,
+                                "before" jsonb,
+                                "after" jsonb,
+                                "route" varchar NOT NULL DEFAULT '',
+                                "request_id" varchar NOT NULL DEFAULT '',
+                                "ip" varchar NOT NULL DEFAULT '',
+                                "user_agent" varchar NOT NULL DEFAULT '',
+                                "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "audit_events" ("actor_id");
+
+CREATE INDEX ON "audit_events" ("resource_type", "resource_id");
+
+CREATE INDEX ON "audit_events" ("request_id");
+
+COMMENT ON COLUMN "audit_events"."actor_id" IS 'authenticated user of the request, null for the changes made by the bank itself';
+
+COMMENT ON COLUMN "audit_events"."action" IS 'insert, update or delete';
+
+COMMENT ON COLUMN "audit_events"."resource_type" IS 'table of the changed row';
+
+COMMENT ON COLUMN "audit_events"."resource_id" IS 'primary key of the changed row, the columns of a composite key are joined with a colon';
+
+COMMENT ON COLUMN "audit_events"."route" IS 'method and route of the request, e.g. POST /transfers';
+
+-- the request is described by transaction local settings written by the store before the change,
+-- the snapshots never contain password hashes
+CREATE FUNCTION record_audit_event() RETURNS trigger AS $$
+DECLARE
+    before_row jsonb;
+    after_row jsonb;
+    key_values text[] := '{}';
+    key_column text;
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        before_row := to_jsonb(OLD) - 'hashed_password';
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        after_row := to_jsonb(NEW) - 'hashed_password';
+    END IF;
+
+    FOREACH key_column IN ARRAY TG_ARGV LOOP
+        key_values := key_values || (COALESCE(after_row, before_row) ->> key_column);
+    END LOOP;
+
+    INSERT INTO audit_events (actor_id, action, resource_type, resource_id, before, after, route, request_id, ip, user_agent)
+    VALUES (
+        NULLIF(current_setting('audit.actor_id', true), '')::bigint,
+        lower(TG_OP),
+        TG_TABLE_NAME,
+        array_to_string(key_values, ':'),
+        before_row,
+        after_row,
+        COALESCE(current_setting('audit.route', true), ''),
+        COALESCE(current_setting('audit.request_id', true), ''),
+        COALESCE(current_setting('audit.ip', true), ''),
+        COALESCE(current_setting('audit.user_agent', true), '')
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION forbid_audit_event_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit events are append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "audit_events_append_only" BEFORE UPDATE OR DELETE ON "audit_events"
+    FOR EACH ROW EXECUTE FUNCTION forbid_audit_event_change();
+
+CREATE TRIGGER "audit_events_no_truncate" BEFORE TRUNCATE ON "audit_events"
+    FOR EACH STATEMENT EXECUTE FUNCTION forbid_audit_event_change();
+
+CREATE TRIGGER "audit_users" AFTER INSERT OR UPDATE OR DELETE ON "users"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('id');
+
+CREATE TRIGGER "audit_accounts" AFTER INSERT OR UPDATE OR DELETE ON "accounts"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('id');
+
+CREATE TRIGGER "audit_transfers" AFTER INSERT OR UPDATE OR DELETE ON "transfers"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('id');
+
+CREATE TRIGGER "audit_entries" AFTER INSERT OR UPDATE OR DELETE ON "entries"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('id');
+
+CREATE TRIGGER "audit_balance_adjustments" AFTER INSERT OR UPDATE OR DELETE ON "balance_adjustments"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('id');
+
+CREATE TRIGGER "audit_external_transfers" AFTER INSERT OR UPDATE OR DELETE ON "external_transfers"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('id');
+
+CREATE TRIGGER "audit_payment_batches" AFTER INSERT OR UPDATE OR DELETE ON "payment_batches"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('id');
+
+CREATE TRIGGER "audit_beneficiaries" AFTER INSERT OR UPDATE OR DELETE ON "beneficiaries"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('id');
+
+CREATE TRIGGER "audit_ach_files" AFTER INSERT OR UPDATE OR DELETE ON "ach_files"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('id');
+
+CREATE TRIGGER "audit_outbound_payments" AFTER INSERT OR UPDATE OR DELETE ON "outbound_payments"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('id');
+
+CREATE TRIGGER "audit_sepa_batches" AFTER INSERT OR UPDATE OR DELETE ON "sepa_batches"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('id');
+
+CREATE TRIGGER "audit_payees" AFTER INSERT OR UPDATE OR DELETE ON "payees"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('id');
+
+CREATE TRIGGER "audit_payment_requests" AFTER INSERT OR UPDATE OR DELETE ON "payment_requests"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('id');
+
+CREATE TRIGGER "audit_account_holders" AFTER INSERT OR UPDATE OR DELETE ON "account_holders"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('account_id', 'user_id');
+
+CREATE TRIGGER "audit_account_invitations" AFTER INSERT OR UPDATE OR DELETE ON "account_invitations"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('id');
+
+CREATE TRIGGER "audit_organisations" AFTER INSERT OR UPDATE OR DELETE ON "organisations"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('id');
+
+CREATE TRIGGER "audit_organisation_members" AFTER INSERT OR UPDATE OR DELETE ON "organisation_members"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('organisation_id', 'user_id');
+
+CREATE TRIGGER "audit_transfer_approvals" AFTER INSERT OR UPDATE OR DELETE ON "transfer_approvals"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('id');
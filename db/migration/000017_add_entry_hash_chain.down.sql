@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "entries_account_id_prev_hash_key";
+
+ALTER TABLE IF EXISTS "entries" DROP COLUMN IF EXISTS "hash";
+
+ALTER TABLE IF EXISTS "entries" DROP COLUMN IF EXISTS "prev_hash";
@@ -0,0 +1,9 @@
+ALTER TABLE "entries" ADD COLUMN "prev_hash" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "entries" ADD COLUMN "hash" varchar NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX "entries_account_id_prev_hash_key" ON "entries" ("account_id", "prev_hash") WHERE "hash" <> '';
+
+COMMENT ON COLUMN "entries"."prev_hash" IS 'hash of the previous entry of the account, empty for the first chained entry';
+
+COMMENT ON COLUMN "entries"."hash" IS 'sha256 of the content of the entry and prev_hash, empty for the entries booked before the chain';
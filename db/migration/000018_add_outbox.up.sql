@@ -0,0 +1,22 @@
+CREATE TABLE "outbox" (
+                          "id" bigserial PRIMARY KEY,
+                          "event_type" varchar NOT NULL,
+                          "event_version" int NOT NULL,
+                          "aggregate_type" varchar NOT NULL,
+                          "aggregate_id" varchar NOT NULL,
+                          "payload" jsonb NOT NULL,
+                          "created_at" timestamptz NOT NULL DEFAULT (now()),
+                          "published_at" timestamptz,
+                          "attempts" int NOT NULL DEFAULT 0,
+                          "last_error" varchar NOT NULL DEFAULT ''
+);
+
+CREATE INDEX "outbox_pending_idx" ON "outbox" ("id") WHERE "published_at" IS NULL;
+
+COMMENT ON COLUMN "outbox"."event_type" IS 'AccountCreated, TransferPosted, BalanceAdjusted, ...';
+
+COMMENT ON COLUMN "outbox"."event_version" IS 'version of the payload schema of the event type';
+
+COMMENT ON COLUMN "outbox"."published_at" IS 'set once the relay handed the event to the publisher';
+
+COMMENT ON COLUMN "outbox"."attempts" IS 'failed publications of the event';
@@ -0,0 +1,40 @@
+DROP TRIGGER IF EXISTS "audit_webhook_subscriptions" ON "webhook_subscriptions";
+
+CREATE OR REPLACE FUNCTION record_audit_event() RETURNS trigger AS $$
+DECLARE
+    before_row jsonb;
+    after_row jsonb;
+    key_values text[] := '{}';
+    key_column text;
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        before_row := to_jsonb(OLD) - 'hashed_password';
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        after_row := to_jsonb(NEW) - 'hashed_password';
+    END IF;
+
+    FOREACH key_column IN ARRAY TG_ARGV LOOP
+        key_values := key_values || (COALESCE(after_row, before_row) ->> key_column);
+    END LOOP;
+
+    INSERT INTO audit_events (actor_id, action, resource_type, resource_id, before, after, route, request_id, ip, user_agent)
+    VALUES (
+        NULLIF(current_setting('audit.actor_id', true), '')::bigint,
+        lower(TG_OP),
+        TG_TABLE_NAME,
+        array_to_string(key_values, ':'),
+        before_row,
+        after_row,
+        COALESCE(current_setting('audit.route', true), ''),
+        COALESCE(current_setting('audit.request_id', true), ''),
+        COALESCE(current_setting('audit.ip', true), ''),
+        COALESCE(current_setting('audit.user_agent', true), '')
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS "webhook_deliveries";
+
+DROP TABLE IF EXISTS "webhook_subscriptions";
@@ -0,0 +1,93 @@
+CREATE TABLE "webhook_subscriptions" (
+                                         "id" bigserial PRIMARY KEY,
+                                         "user_id" bigint NOT NULL,
+                                         "url" varchar NOT NULL,
+                                         "event_types" jsonb NOT NULL,
+                                         "secret" varchar NOT NULL,
+                                         "status" varchar NOT NULL DEFAULT 'active',
+                                         "consecutive_failures" int NOT NULL DEFAULT 0,
+                                         "disabled_at" timestamptz,
+                                         "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "webhook_deliveries" (
+                                      "id" bigserial PRIMARY KEY,
+                                      "subscription_id" bigint NOT NULL,
+                                      "event_id" bigint NOT NULL,
+                                      "event_type" varchar NOT NULL,
+                                      "payload" jsonb NOT NULL,
+                                      "status" varchar NOT NULL DEFAULT 'pending',
+                                      "attempts" int NOT NULL DEFAULT 0,
+                                      "next_attempt_at" timestamptz NOT NULL DEFAULT (now()),
+                                      "last_status_code" int NOT NULL DEFAULT 0,
+                                      "last_error" varchar NOT NULL DEFAULT '',
+                                      "delivered_at" timestamptz,
+                                      "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "webhook_subscriptions" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
+
+ALTER TABLE "webhook_subscriptions" ADD CONSTRAINT "webhook_subscription_status_check" CHECK ("status" IN ('active', 'disabled'));
+
+ALTER TABLE "webhook_deliveries" ADD FOREIGN KEY ("subscription_id") REFERENCES "webhook_subscriptions" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "webhook_deliveries" ADD FOREIGN KEY ("event_id") REFERENCES "outbox" ("id");
+
+ALTER TABLE "webhook_deliveries" ADD CONSTRAINT "webhook_delivery_status_check" CHECK ("status" IN ('pending', 'succeeded', 'failed'));
+
+CREATE INDEX ON "webhook_subscriptions" ("user_id");
+
+CREATE UNIQUE INDEX ON "webhook_deliveries" ("subscription_id", "event_id");
+
+CREATE INDEX "webhook_deliveries_due_idx" ON "webhook_deliveries" ("next_attempt_at") WHERE "status" = 'pending';
+
+COMMENT ON COLUMN "webhook_subscriptions"."event_types" IS 'JSON array of the event types delivered to the url';
+
+COMMENT ON COLUMN "webhook_subscriptions"."secret" IS 'signs the deliveries with HMAC-SHA256';
+
+COMMENT ON COLUMN "webhook_subscriptions"."consecutive_failures" IS 'failed attempts since the last successful delivery, the subscription is disabled past a limit';
+
+COMMENT ON COLUMN "webhook_deliveries"."event_id" IS 'outbox event delivered';
+
+COMMENT ON COLUMN "webhook_deliveries"."status" IS 'pending, succeeded or failed once the attempts are exhausted';
+
+COMMENT ON COLUMN "webhook_deliveries"."last_status_code" IS 'HTTP status of the last attempt, 0 when the receiver could not be reached';
+
+-- the snapshots of the webhook subscriptions don't contain their signing secret either
+CREATE OR REPLACE FUNCTION record_audit_event() RETURNS trigger AS $$
+DECLARE
+    before_row jsonb;
+    after_row jsonb;
+    key_values text[] := '{}';
+    key_column text;
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        before_row := to_jsonb(OLD) - 'hashed_password' - 'secret';
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        after_row := to_jsonb(NEW) - 'hashed_password' - 'secret';
+    END IF;
+
+    FOREACH key_column IN ARRAY TG_ARGV LOOP
+        key_values := key_values || (COALESCE(after_row, before_row) ->> key_column);
+    END LOOP;
+
+    INSERT INTO audit_events (actor_id, action, resource_type, resource_id, before, after, route, request_id, ip, user_agent)
+    VALUES (
+        NULLIF(current_setting('audit.actor_id', true), '')::bigint,
+        lower(TG_OP),
+        TG_TABLE_NAME,
+        array_to_string(key_values, ':'),
+        before_row,
+        after_row,
+        COALESCE(current_setting('audit.route', true), ''),
+        COALESCE(current_setting('audit.request_id', true), ''),
+        COALESCE(current_setting('audit.ip', true), ''),
+        COALESCE(current_setting('audit.user_agent', true), '')
+    );
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "audit_webhook_subscriptions" AFTER INSERT OR UPDATE OR DELETE ON "webhook_subscriptions"
+    FOR EACH ROW EXECUTE FUNCTION record_audit_event('id');
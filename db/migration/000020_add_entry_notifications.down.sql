@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS "notify_entries" ON "entries";
+
+DROP FUNCTION IF EXISTS notify_entry();
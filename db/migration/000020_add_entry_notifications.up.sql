@@ -0,0 +1,11 @@
+CREATE FUNCTION notify_entry() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('entries', NEW.account_id::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+COMMENT ON FUNCTION notify_entry() IS 'wakes the balance streams of the account, the notification is delivered on commit';
+
+CREATE TRIGGER "notify_entries" AFTER INSERT ON "entries"
+    FOR EACH ROW EXECUTE FUNCTION notify_entry();
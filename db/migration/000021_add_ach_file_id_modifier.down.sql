@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "outbound_payments_ach_file_id_trace_number_idx";
+
+CREATE UNIQUE INDEX ON "outbound_payments" ("trace_number");
+
+ALTER TABLE "ach_files" DROP COLUMN IF EXISTS "file_id_modifier";
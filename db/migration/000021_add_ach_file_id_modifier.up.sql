@@ -0,0 +1,7 @@
+ALTER TABLE "ach_files" ADD COLUMN "file_id_modifier" varchar(1) NOT NULL DEFAULT 'A';
+
+COMMENT ON COLUMN "ach_files"."file_id_modifier" IS 'A to Z then 0 to 9, tells apart the files sent the same day';
+
+DROP INDEX IF EXISTS "outbound_payments_trace_number_idx";
+
+CREATE UNIQUE INDEX ON "outbound_payments" ("ach_file_id", "trace_number");
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS "entries_hash_check" ON "entries";
+
+DROP FUNCTION IF EXISTS check_entry_hash();
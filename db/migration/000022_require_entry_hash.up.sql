@@ -0,0 +1,14 @@
+CREATE FUNCTION check_entry_hash() RETURNS trigger AS $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM "entries" WHERE "id" = NEW.id AND "hash" = '') THEN
+        RAISE EXCEPTION 'entry % has no hash', NEW.id USING ERRCODE = 'check_violation';
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+COMMENT ON FUNCTION check_entry_hash() IS 'refuses the entries left without a hash, the entries are hashed after the insert so the check runs on commit';
+
+CREATE CONSTRAINT TRIGGER "entries_hash_check" AFTER INSERT OR UPDATE OF "hash" ON "entries"
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION check_entry_hash();
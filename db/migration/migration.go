@@ -0,0 +1,259 @@
+// Package migration embeds the migrations of the database schema, so that the binary can apply them without
+// the migrate tool. They are recorded in the schema_migrations table of the migrate tool, the two can be mixed
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+// lockID is the key of the advisory lock held while a server checks and migrates the schema at startup,
+// so that the replicas starting together migrate it one after the other
+const lockID int64 = 0x73696d706c656261
+
+// ErrSchemaAhead is returned when the schema has been migrated by a newer binary
+var ErrSchemaAhead = errors.New("schema is ahead of the migrations of the binary")
+
+// ErrSchemaDirty is returned when a migration failed to change the schema
+var ErrSchemaDirty = errors.New("schema is dirty")
+
+// ErrSchemaBehind is returned by the readiness check when the schema misses some embedded migrations
+var ErrSchemaBehind = errors.New("schema is behind the migrations of the binary")
+
+// Status is a migration and whether it has been applied
+type Status struct {
+	Version uint
+	Name    string
+	Applied bool
+}
+
+// Migrator applies the embedded migrations to a database
+type Migrator struct {
+	migrate *migrate.Migrate
+	source  source.Driver
+}
+
+// NewMigrator creates a new Migrator for the database of dbSource
+func NewMigrator(dbSource string) (*Migrator, error) {
+	src, err := iofs.New(files, ".")
+	if err != nil {
+		return nil, fmt.Errorf("cannot read migrations: %w", err)
+	}
+
+	m, err := migrate.NewWithSourceInstance("iofs", src, dbSource)
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to db: %w", err)
+	}
+
+	return &Migrator{migrate: m, source: src}, nil
+}
+
+// Up applies the next steps migrations, or all of them when steps is 0
+func (migrator *Migrator) Up(steps int) error {
+	var err error
+	if steps > 0 {
+		err = migrator.migrate.Steps(steps)
+	} else {
+		err = migrator.migrate.Up()
+	}
+	return ignoreNoChange(err)
+}
+
+// Down reverts the last steps migrations, or all of them when steps is 0
+func (migrator *Migrator) Down(steps int) error {
+	var err error
+	if steps > 0 {
+		err = migrator.migrate.Steps(-steps)
+	} else {
+		err = migrator.migrate.Down()
+	}
+	return ignoreNoChange(err)
+}
+
+// Version returns the version of the schema, 0 when no migration has been applied. A dirty schema
+// is one a migration failed to change, it has to be fixed by hand
+func (migrator *Migrator) Version() (version uint, dirty bool, err error) {
+	version, dirty, err = migrator.migrate.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	return
+}
+
+// Status lists the embedded migrations in order
+func (migrator *Migrator) Status() ([]Status, error) {
+	current, _, err := migrator.Version()
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []Status
+	version, err := migrator.source.First()
+	for err == nil {
+		status := Status{Version: version, Applied: version <= current}
+		r, name, readErr := migrator.source.ReadUp(version)
+		if readErr != nil {
+			return nil, readErr
+		}
+		r.Close()
+		status.Name = name
+		statuses = append(statuses, status)
+
+		version, err = migrator.source.Next(version)
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// LatestVersion returns the version of the last embedded migration
+func LatestVersion() (uint, error) {
+	src, err := iofs.New(files, ".")
+	if err != nil {
+		return 0, fmt.Errorf("cannot read migrations: %w", err)
+	}
+	defer src.Close()
+
+	var latest uint
+	version, err := src.First()
+	for err == nil {
+		latest = version
+		version, err = src.Next(version)
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return 0, err
+	}
+	return latest, nil
+}
+
+// CheckVersion fails unless the schema is at the version of the last embedded migration, it is the readiness
+// check of the schema. It reads the schema_migrations table on dbConnection rather than opening a connection
+// of its own, as the probe runs it every few seconds
+func CheckVersion(ctx context.Context, dbConnection *sql.DB) error {
+	var version int64
+	var dirty bool
+	err := dbConnection.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("cannot read schema version: %w", err)
+	}
+
+	latest, err := LatestVersion()
+	if err != nil {
+		return err
+	}
+
+	if err := checkVersion(uint(version), dirty, latest); err != nil {
+		return err
+	}
+	if uint(version) < latest {
+		return fmt.Errorf("%w: version %d, latest migration %d", ErrSchemaBehind, version, latest)
+	}
+	return nil
+}
+
+// Check makes sure the binary can run on the schema, it only logs a schema missing some migrations
+func Check(dbSource string) error {
+	migrator, err := NewMigrator(dbSource)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	version, latest, err := migrator.check()
+	if err != nil {
+		return err
+	}
+
+	if version < latest {
+		log.Printf("schema version %d is behind the latest migration %d, run the migrations", version, latest)
+	}
+	return nil
+}
+
+// Apply applies the pending migrations while holding the advisory lock of the startup on a connection of
+// dbConnection, the replicas waiting for it find the schema up to date once they hold it
+func Apply(ctx context.Context, dbConnection *sql.DB, dbSource string) error {
+	conn, err := dbConnection.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot connect to db: %w", err)
+	}
+	defer conn.Close()
+
+	_, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockID)
+	if err != nil {
+		return fmt.Errorf("cannot lock migrations: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockID)
+
+	migrator, err := NewMigrator(dbSource)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	version, latest, err := migrator.check()
+	if err != nil || version == latest {
+		return err
+	}
+
+	if err := migrator.Up(0); err != nil {
+		return fmt.Errorf("cannot migrate schema from version %d: %w", version, err)
+	}
+	log.Printf("migrated schema from version %d to %d", version, latest)
+	return nil
+}
+
+// check returns the version of the schema and of the last embedded migration, with an error when
+// the binary can't run on the schema
+func (migrator *Migrator) check() (version uint, latest uint, err error) {
+	version, dirty, err := migrator.Version()
+	if err != nil {
+		return
+	}
+
+	latest, err = LatestVersion()
+	if err != nil {
+		return
+	}
+	return version, latest, checkVersion(version, dirty, latest)
+}
+
+// checkVersion rejects a dirty schema, and a schema with migrations the binary doesn't know about
+func checkVersion(version uint, dirty bool, latest uint) error {
+	if dirty {
+		return fmt.Errorf("%w: version %d failed to migrate and has to be fixed by hand", ErrSchemaDirty, version)
+	}
+
+	if version > latest {
+		return fmt.Errorf("%w: version %d, latest migration %d", ErrSchemaAhead, version, latest)
+	}
+	return nil
+}
+
+// Close closes the connection to the database
+func (migrator *Migrator) Close() error {
+	sourceErr, dbErr := migrator.migrate.Close()
+	return errors.Join(sourceErr, dbErr)
+}
+
+func ignoreNoChange(err error) error {
+	if errors.Is(err, migrate.ErrNoChange) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,70 @@
+package migration
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	src, err := iofs.New(files, ".")
+	require.NoError(t, err)
+	defer src.Close()
+
+	var versions []uint
+	version, err := src.First()
+	for err == nil {
+		versions = append(versions, version)
+
+		up, _, readErr := src.ReadUp(version)
+		require.NoError(t, readErr)
+		up.Close()
+
+		down, _, readErr := src.ReadDown(version)
+		require.NoErrorf(t, readErr, "migration %d has no down migration", version)
+		down.Close()
+
+		version, err = src.Next(version)
+	}
+	require.True(t, errors.Is(err, fs.ErrNotExist))
+
+	// the versions follow each other from 1
+	require.NotEmpty(t, versions)
+	for i, version := range versions {
+		require.Equal(t, uint(i+1), version)
+	}
+}
+
+func TestCheckVersion(t *testing.T) {
+	testCases := []struct {
+		name    string
+		version uint
+		dirty   bool
+		err     error
+	}{
+		{name: "empty", version: 0},
+		{name: "behind", version: 3},
+		{name: "up to date", version: 20},
+		{name: "ahead", version: 21, err: ErrSchemaAhead},
+		{name: "dirty", version: 12, dirty: true, err: ErrSchemaDirty},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkVersion(tc.version, tc.dirty, 20)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestLatestVersion(t *testing.T) {
+	entries, err := fs.Glob(files, "*.up.sql")
+	require.NoError(t, err)
+
+	latest, err := LatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint(len(entries)), latest)
+}
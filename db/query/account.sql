@@ -11,22 +11,39 @@ SELECT * FROM accounts
 WHERE id = $1 LIMIT 1
 FOR NO KEY UPDATE;
 
+-- name: GetInternalAccount :one
+SELECT * FROM accounts
+WHERE kind = $1 AND currency = $2 LIMIT 1;
+
 -- name: ListAccounts :many
 SELECT * FROM accounts
+WHERE kind = 'customer'
 ORDER BY id
 limit $1
 offset $2;
 
--- name: UpdateAccount :one
+-- name: SearchAccounts :many
+SELECT * FROM accounts
+WHERE (sqlc.narg(user_id)::bigint IS NULL OR user_id = sqlc.narg(user_id))
+  AND (sqlc.narg(currency)::varchar IS NULL OR currency = sqlc.narg(currency))
+  AND (sqlc.narg(kind)::varchar IS NULL OR kind = sqlc.narg(kind))
+  AND (NOT sqlc.arg(frozen_only)::boolean OR frozen_at IS NOT NULL)
+ORDER BY id
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
+
+-- name: FreezeAccount :many
 UPDATE accounts
-set balance = $2
-WHERE id = $1
+SET frozen_at = now(),
+    frozen_reason = sqlc.arg(reason)
+WHERE id = sqlc.arg(id) OR parent_id = sqlc.arg(id)
 RETURNING *;
 
--- name: AddAccountBalance :one
+-- name: UnfreezeAccount :many
 UPDATE accounts
-set balance = balance + sqlc.arg(amount)
-WHERE id = sqlc.arg(id)
+SET frozen_at = NULL,
+    frozen_reason = ''
+WHERE id = sqlc.arg(id) OR parent_id = sqlc.arg(id)
 RETURNING *;
 
 -- name: DeleteAccount :exec
@@ -0,0 +1,55 @@
+-- name: CreateAccountHolder :one
+INSERT INTO account_holders (
+    account_id,
+    user_id,
+    role
+) VALUES (
+             $1, $2, $3
+         ) RETURNING *;
+
+-- name: GetAccountHolder :one
+SELECT * FROM account_holders
+WHERE account_id = $1 AND user_id = $2 LIMIT 1;
+
+-- name: ListAccountHolders :many
+SELECT * FROM account_holders
+WHERE account_id = $1
+ORDER BY created_at, user_id;
+
+-- name: ListHeldAccounts :many
+SELECT * FROM accounts
+WHERE id IN (
+    SELECT account_id FROM account_holders
+    WHERE user_id = $1
+)
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: DeleteAccountHolder :exec
+DELETE FROM account_holders
+WHERE account_id = $1 AND user_id = $2;
+
+-- name: ListHeldAccountsByUsers :many
+SELECT holders.user_id::bigint AS holder_id, accounts.*
+FROM jsonb_array_elements_text(sqlc.arg(user_ids)::jsonb) AS holders(user_id)
+CROSS JOIN LATERAL (
+    SELECT * FROM accounts
+    WHERE id IN (
+        SELECT account_id FROM account_holders
+        WHERE user_id = holders.user_id::bigint
+    )
+    ORDER BY id
+    LIMIT sqlc.arg(page_limit)
+) accounts
+ORDER BY holder_id, accounts.id;
+
+-- name: ListAccountsWithRoles :many
+SELECT a.*,
+       COALESCE(h.role, '')::varchar AS holder_role,
+       COALESCE(m.role, '')::varchar AS member_role
+FROM accounts a
+LEFT JOIN account_holders h ON h.account_id = COALESCE(a.parent_id, a.id) AND h.user_id = sqlc.arg(user_id)
+LEFT JOIN organisation_members m ON m.organisation_id = a.organisation_id AND m.user_id = sqlc.arg(user_id)
+WHERE a.id IN (SELECT jsonb_array_elements_text(sqlc.arg(account_ids)::jsonb)::bigint)
+ORDER BY a.id;
@@ -0,0 +1,30 @@
+-- name: CreateAccountInvitation :one
+INSERT INTO account_invitations (
+    account_id,
+    inviter_id,
+    invitee_id,
+    role
+) VALUES (
+             $1, $2, $3, $4
+         ) RETURNING *;
+
+-- name: GetAccountInvitation :one
+SELECT * FROM account_invitations
+WHERE id = $1 LIMIT 1;
+
+-- name: GetAccountInvitationForUpdate :one
+SELECT * FROM account_invitations
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListPendingAccountInvitations :many
+SELECT * FROM account_invitations
+WHERE invitee_id = $1 AND status = 'pending'
+ORDER BY id;
+
+-- name: UpdatePendingAccountInvitationStatus :one
+UPDATE account_invitations
+SET status = sqlc.arg(status),
+    updated_at = now()
+WHERE id = sqlc.arg(id) AND status = 'pending'
+RETURNING *;
@@ -0,0 +1,13 @@
+-- name: CreateAchFile :one
+INSERT INTO ach_files (
+    file_name,
+    file_id_modifier,
+    entry_count,
+    total_amount
+) VALUES (
+             $1, $2, $3, $4
+         ) RETURNING *;
+
+-- name: CountAchFilesCreatedSince :one
+SELECT count(*) FROM ach_files
+WHERE created_at >= sqlc.arg(created_since);
@@ -0,0 +1,19 @@
+-- name: CreateBalanceAdjustment :one
+INSERT INTO balance_adjustments (
+    account_id,
+    suspense_account_id,
+    account_entry_id,
+    suspense_entry_id,
+    amount,
+    reason_code,
+    justification
+) VALUES (
+             $1, $2, $3, $4, $5, $6, $7
+         ) RETURNING *;
+
+-- name: ListBalanceAdjustments :many
+SELECT * FROM balance_adjustments
+WHERE account_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
@@ -0,0 +1,16 @@
+-- name: CreateAdminAuditLog :one
+INSERT INTO admin_audit_logs (
+    actor_id,
+    action,
+    path,
+    status_code
+) VALUES (
+             $1, $2, $3, $4
+         ) RETURNING *;
+
+-- name: ListAdminAuditLogs :many
+SELECT * FROM admin_audit_logs
+WHERE sqlc.narg(actor_id)::bigint IS NULL OR actor_id = sqlc.narg(actor_id)
+ORDER BY id DESC
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
@@ -0,0 +1,19 @@
+-- name: SetAuditContext :exec
+SELECT set_config('audit.actor_id', sqlc.arg(actor_id)::text, true),
+       set_config('audit.route', sqlc.arg(route)::text, true),
+       set_config('audit.request_id', sqlc.arg(request_id)::text, true),
+       set_config('audit.ip', sqlc.arg(ip)::text, true),
+       set_config('audit.user_agent', sqlc.arg(user_agent)::text, true);
+
+-- name: ListAuditEvents :many
+SELECT * FROM audit_events
+WHERE (sqlc.narg(actor_id)::bigint IS NULL OR actor_id = sqlc.narg(actor_id))
+  AND (sqlc.narg(action)::varchar IS NULL OR action = sqlc.narg(action))
+  AND (sqlc.narg(resource_type)::varchar IS NULL OR resource_type = sqlc.narg(resource_type))
+  AND (sqlc.narg(resource_id)::varchar IS NULL OR resource_id = sqlc.narg(resource_id))
+  AND (sqlc.narg(request_id)::varchar IS NULL OR request_id = sqlc.narg(request_id))
+  AND (sqlc.narg(from_time)::timestamptz IS NULL OR created_at >= sqlc.narg(from_time))
+  AND (sqlc.narg(to_time)::timestamptz IS NULL OR created_at < sqlc.narg(to_time))
+ORDER BY id DESC
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
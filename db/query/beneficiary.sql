@@ -0,0 +1,24 @@
+-- name: CreateBeneficiary :one
+INSERT INTO beneficiaries (
+    user_id,
+    name,
+    routing_number,
+    account_number,
+    account_type,
+    currency,
+    iban,
+    bic
+) VALUES (
+             $1, $2, $3, $4, $5, $6, $7, $8
+         ) RETURNING *;
+
+-- name: GetBeneficiary :one
+SELECT * FROM beneficiaries
+WHERE id = $1 LIMIT 1;
+
+-- name: ListBeneficiaries :many
+SELECT * FROM beneficiaries
+WHERE user_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
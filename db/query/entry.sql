@@ -1,11 +1,3 @@
--- name: CreateEntry :one
-INSERT INTO entries (
-    account_id,
-    amount
-) VALUES (
-             $1, $2
-         ) RETURNING *;
-
 -- name: GetEntry :one
 SELECT * FROM entries
 WHERE id = $1 LIMIT 1;
@@ -15,4 +7,57 @@ SELECT * FROM entries
 WHERE account_id = $1
 ORDER BY id
 LIMIT $2
-OFFSET $3;
\ No newline at end of file
+OFFSET $3;
+
+-- name: SearchAccountEntries :many
+SELECT * FROM entries
+WHERE account_id = sqlc.arg(account_id)
+  AND (sqlc.arg(reference)::varchar = '' OR reference = sqlc.arg(reference)::varchar)
+  AND strpos(lower(description), lower(sqlc.arg(description)::varchar)) > 0
+  AND metadata @> sqlc.arg(metadata)::jsonb
+ORDER BY id
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
+
+-- name: ListEntriesForPeriod :many
+SELECT * FROM entries
+WHERE account_id = sqlc.arg(account_id)
+  AND created_at >= sqlc.arg(from_time)
+  AND created_at < sqlc.arg(to_time)
+ORDER BY created_at, id;
+
+-- name: SumEntriesSince :one
+SELECT COALESCE(SUM(amount), 0)::bigint AS total FROM entries
+WHERE account_id = sqlc.arg(account_id)
+  AND created_at >= sqlc.arg(since);
+
+-- name: GetLastEntryHash :one
+SELECT hash FROM entries
+WHERE account_id = sqlc.arg(account_id)
+  AND id < sqlc.arg(before_id)
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: ListEntryChain :many
+SELECT * FROM entries
+WHERE (sqlc.narg(account_id)::bigint IS NULL OR account_id = sqlc.narg(account_id)::bigint)
+  AND (account_id, id) > (sqlc.arg(after_account_id)::bigint, sqlc.arg(after_id)::bigint)
+ORDER BY account_id, id
+LIMIT sqlc.arg(page_limit);
+
+-- name: GetAccountStreamHead :one
+SELECT a.balance, COALESCE(MAX(e.id), 0)::bigint AS last_entry_id
+FROM accounts a
+LEFT JOIN entries e ON e.account_id = a.id
+WHERE a.id = sqlc.arg(account_id)
+GROUP BY a.id;
+
+-- name: ListAccountEntriesAfter :many
+SELECT e.*,
+       (a.balance - SUM(e.amount) OVER (ORDER BY e.id DESC) + e.amount)::bigint AS balance_after
+FROM entries e
+JOIN accounts a ON a.id = e.account_id
+WHERE e.account_id = sqlc.arg(account_id)
+  AND e.id > sqlc.arg(after_id)
+ORDER BY e.id
+LIMIT sqlc.arg(page_limit);
@@ -0,0 +1,36 @@
+-- name: CreateExternalTransfer :one
+INSERT INTO external_transfers (
+    account_id,
+    clearing_account_id,
+    direction,
+    amount,
+    currency,
+    rail
+) VALUES (
+             $1, $2, $3, $4, $5, $6
+         ) RETURNING *;
+
+-- name: GetExternalTransfer :one
+SELECT * FROM external_transfers
+WHERE id = $1 LIMIT 1;
+
+-- name: GetExternalTransferForUpdate :one
+SELECT * FROM external_transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListExternalTransfers :many
+SELECT * FROM external_transfers
+WHERE account_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: UpdateExternalTransferStatus :one
+UPDATE external_transfers
+SET status = sqlc.arg(status),
+    external_reference = COALESCE(sqlc.narg(external_reference)::varchar, external_reference),
+    failure_reason = sqlc.arg(failure_reason),
+    updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
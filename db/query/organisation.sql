@@ -0,0 +1,48 @@
+-- name: CreateOrganisation :one
+INSERT INTO organisations (name, created_by)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: GetOrganisation :one
+SELECT * FROM organisations
+WHERE id = $1 LIMIT 1;
+
+-- name: ListMemberOrganisations :many
+SELECT * FROM organisations
+WHERE id IN (SELECT organisation_id FROM organisation_members WHERE organisation_members.user_id = $1)
+ORDER BY id;
+
+-- name: CreateOrganisationMember :one
+INSERT INTO organisation_members (organisation_id, user_id, role, transfer_limit)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetOrganisationMember :one
+SELECT * FROM organisation_members
+WHERE organisation_id = $1 AND user_id = $2 LIMIT 1;
+
+-- name: ListOrganisationMembers :many
+SELECT * FROM organisation_members
+WHERE organisation_id = $1
+ORDER BY created_at, user_id;
+
+-- name: UpdateOrganisationMember :one
+UPDATE organisation_members
+SET role = sqlc.arg(role),
+    transfer_limit = sqlc.narg(transfer_limit)
+WHERE organisation_id = sqlc.arg(organisation_id) AND user_id = sqlc.arg(user_id)
+RETURNING *;
+
+-- name: DeleteOrganisationMember :exec
+DELETE FROM organisation_members
+WHERE organisation_id = $1 AND user_id = $2;
+
+-- name: CreateOrganisationAccount :one
+INSERT INTO accounts (user_id, balance, currency, organisation_id)
+VALUES (sqlc.arg(user_id), 0, sqlc.arg(currency), sqlc.arg(organisation_id)::bigint)
+RETURNING *;
+
+-- name: ListOrganisationAccounts :many
+SELECT * FROM accounts
+WHERE organisation_id = sqlc.arg(organisation_id)::bigint AND kind = 'customer'
+ORDER BY id;
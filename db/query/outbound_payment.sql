@@ -0,0 +1,58 @@
+-- name: CreateOutboundPayment :one
+INSERT INTO outbound_payments (
+    account_id,
+    beneficiary_id,
+    clearing_account_id,
+    amount,
+    currency,
+    rail
+) VALUES (
+             $1, $2, $3, $4, $5, $6
+         ) RETURNING *;
+
+-- name: GetOutboundPayment :one
+SELECT * FROM outbound_payments
+WHERE id = $1 LIMIT 1;
+
+-- name: GetOutboundPaymentByTraceNumberForUpdate :one
+SELECT * FROM outbound_payments
+WHERE id = sqlc.arg(id) AND trace_number = sqlc.arg(trace_number)::varchar LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListOutboundPayments :many
+SELECT * FROM outbound_payments
+WHERE account_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: ListPendingOutboundPaymentsForUpdate :many
+SELECT * FROM outbound_payments
+WHERE status = 'pending' AND rail = $1
+ORDER BY id
+FOR NO KEY UPDATE;
+
+-- name: MarkOutboundPaymentBatched :one
+UPDATE outbound_payments
+SET status = 'submitted',
+    sepa_batch_id = sqlc.arg(sepa_batch_id)::bigint,
+    updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: MarkOutboundPaymentReturned :one
+UPDATE outbound_payments
+SET status = 'returned',
+    return_code = sqlc.arg(return_code),
+    updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: MarkOutboundPaymentSubmitted :one
+UPDATE outbound_payments
+SET status = 'submitted',
+    ach_file_id = sqlc.arg(ach_file_id)::bigint,
+    trace_number = sqlc.arg(trace_number)::varchar,
+    updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
@@ -0,0 +1,40 @@
+-- name: CreateOutboxEvent :one
+INSERT INTO outbox (
+    event_type,
+    event_version,
+    aggregate_type,
+    aggregate_id,
+    payload
+) VALUES (
+             $1, $2, $3, $4, $5
+         ) RETURNING *;
+
+-- name: ListPendingOutboxEventsForUpdate :many
+SELECT * FROM outbox
+WHERE published_at IS NULL
+ORDER BY id
+LIMIT $1
+FOR NO KEY UPDATE SKIP LOCKED;
+
+-- name: MarkOutboxEventPublished :one
+UPDATE outbox
+SET published_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: RecordOutboxEventFailure :one
+UPDATE outbox
+SET attempts = attempts + 1,
+    last_error = sqlc.arg(last_error)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: GetOutboxEvent :one
+SELECT * FROM outbox
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAggregateOutboxEvents :many
+SELECT * FROM outbox
+WHERE aggregate_type = $1
+  AND aggregate_id = $2
+ORDER BY id;
@@ -0,0 +1,39 @@
+-- name: CreatePayee :one
+INSERT INTO payees (
+    user_id,
+    nickname,
+    account_id,
+    beneficiary_id,
+    verified
+) VALUES (
+             $1, $2, $3, $4, $5
+         ) RETURNING *;
+
+-- name: DeletePayee :exec
+DELETE FROM payees
+WHERE id = $1;
+
+-- name: GetPayee :one
+SELECT * FROM payees
+WHERE id = $1 LIMIT 1;
+
+-- name: ListPayees :many
+SELECT * FROM payees
+WHERE user_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: UpdatePayeeNickname :one
+UPDATE payees
+SET nickname = sqlc.arg(nickname),
+    updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: GetNewestPayeeOfDestination :one
+SELECT * FROM payees
+WHERE user_id = sqlc.arg(user_id)
+  AND (account_id = sqlc.narg(account_id) OR beneficiary_id = sqlc.narg(beneficiary_id))
+ORDER BY created_at DESC
+LIMIT 1;
@@ -0,0 +1,19 @@
+-- name: CreatePaymentBatch :one
+INSERT INTO payment_batches (
+    user_id,
+    message_id,
+    number_of_transactions
+) VALUES (
+             $1, $2, $3
+         ) RETURNING *;
+
+-- name: GetPaymentBatch :one
+SELECT * FROM payment_batches
+WHERE user_id = $1 AND message_id = $2 LIMIT 1;
+
+-- name: UpdatePaymentBatchResult :one
+UPDATE payment_batches
+SET accepted_transactions = sqlc.arg(accepted_transactions),
+    rejected_transactions = sqlc.arg(rejected_transactions)
+WHERE id = sqlc.arg(id)
+RETURNING *;
@@ -0,0 +1,50 @@
+-- name: CreatePaymentRequest :one
+INSERT INTO payment_requests (
+    requester_id,
+    requester_account_id,
+    payer_id,
+    amount,
+    currency,
+    memo,
+    expires_at
+) VALUES (
+             $1, $2, $3, $4, $5, $6, $7
+         ) RETURNING *;
+
+-- name: GetPaymentRequest :one
+SELECT * FROM payment_requests
+WHERE id = $1 LIMIT 1;
+
+-- name: GetPaymentRequestForUpdate :one
+SELECT * FROM payment_requests
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListIncomingPaymentRequests :many
+SELECT * FROM payment_requests
+WHERE payer_id = $1
+ORDER BY id DESC
+LIMIT $2
+OFFSET $3;
+
+-- name: ListOutgoingPaymentRequests :many
+SELECT * FROM payment_requests
+WHERE requester_id = $1
+ORDER BY id DESC
+LIMIT $2
+OFFSET $3;
+
+-- name: MarkPaymentRequestAccepted :one
+UPDATE payment_requests
+SET status = 'accepted',
+    transfer_id = sqlc.arg(transfer_id)::bigint,
+    updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: UpdatePendingPaymentRequestStatus :one
+UPDATE payment_requests
+SET status = sqlc.arg(status),
+    updated_at = now()
+WHERE id = sqlc.arg(id) AND status = 'pending'
+RETURNING *;
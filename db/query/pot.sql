@@ -0,0 +1,21 @@
+-- name: CreatePot :one
+INSERT INTO accounts (user_id, balance, currency, kind, parent_id, organisation_id, name, goal_amount, target_date)
+VALUES (sqlc.arg(user_id), 0, sqlc.arg(currency), 'pot', sqlc.arg(parent_id)::bigint, sqlc.narg(organisation_id), sqlc.arg(name), sqlc.narg(goal_amount), sqlc.narg(target_date))
+RETURNING *;
+
+-- name: ListPots :many
+SELECT * FROM accounts
+WHERE parent_id = sqlc.arg(parent_id)::bigint
+ORDER BY id;
+
+-- name: UpdatePot :one
+UPDATE accounts
+SET name = sqlc.arg(name),
+    goal_amount = sqlc.narg(goal_amount),
+    target_date = sqlc.narg(target_date)
+WHERE id = sqlc.arg(id) AND kind = 'pot'
+RETURNING *;
+
+-- name: SumPotBalances :one
+SELECT COALESCE(SUM(balance), 0)::bigint AS total FROM accounts
+WHERE parent_id = sqlc.arg(parent_id)::bigint;
@@ -0,0 +1,9 @@
+-- name: CreateSepaBatch :one
+INSERT INTO sepa_batches (
+    message_id,
+    file_name,
+    entry_count,
+    total_amount
+) VALUES (
+             $1, $2, $3, $4
+         ) RETURNING *;
@@ -2,9 +2,12 @@
 INSERT INTO transfers (
     from_account_id,
     to_account_id,
-    amount
+    amount,
+    description,
+    reference,
+    metadata
 ) VALUES (
-             $1, $2, $3
+             $1, $2, $3, $4, $5, $6
          ) RETURNING *;
 
 -- name: GetTransfer :one
@@ -18,4 +21,25 @@ WHERE
     to_account_id = $2
 ORDER BY id
 LIMIT $3
-OFFSET $4;
\ No newline at end of file
+OFFSET $4;
+
+-- name: SearchAccountTransfers :many
+SELECT * FROM transfers
+WHERE (from_account_id = sqlc.arg(account_id) OR to_account_id = sqlc.arg(account_id))
+  AND (sqlc.arg(reference)::varchar = '' OR reference = sqlc.arg(reference)::varchar)
+  AND strpos(lower(description), lower(sqlc.arg(description)::varchar)) > 0
+  AND metadata @> sqlc.arg(metadata)::jsonb
+ORDER BY id
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
+
+-- name: ListRecentTransfersByAccounts :many
+SELECT ids.account_id::bigint AS account_id, transfers.*
+FROM jsonb_array_elements_text(sqlc.arg(account_ids)::jsonb) AS ids(account_id)
+CROSS JOIN LATERAL (
+    SELECT * FROM transfers
+    WHERE from_account_id = ids.account_id::bigint OR to_account_id = ids.account_id::bigint
+    ORDER BY id DESC
+    LIMIT sqlc.arg(page_limit)
+) transfers
+ORDER BY account_id, transfers.id DESC;
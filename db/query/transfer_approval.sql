@@ -0,0 +1,44 @@
+-- name: CreateTransferApproval :one
+INSERT INTO transfer_approvals (
+    organisation_id,
+    from_account_id,
+    to_account_id,
+    amount,
+    description,
+    reference,
+    metadata,
+    initiator_id
+) VALUES (
+             $1, $2, $3, $4, $5, $6, $7, $8
+         ) RETURNING *;
+
+-- name: GetTransferApproval :one
+SELECT * FROM transfer_approvals
+WHERE id = $1 LIMIT 1;
+
+-- name: GetTransferApprovalForUpdate :one
+SELECT * FROM transfer_approvals
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListPendingTransferApprovals :many
+SELECT * FROM transfer_approvals
+WHERE organisation_id = $1 AND status = 'pending'
+ORDER BY id;
+
+-- name: MarkTransferApprovalApproved :one
+UPDATE transfer_approvals
+SET status = 'approved',
+    approver_id = sqlc.arg(approver_id)::bigint,
+    transfer_id = sqlc.arg(transfer_id)::bigint,
+    updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: RejectPendingTransferApproval :one
+UPDATE transfer_approvals
+SET status = 'rejected',
+    approver_id = sqlc.arg(approver_id)::bigint,
+    updated_at = now()
+WHERE id = sqlc.arg(id) AND status = 'pending'
+RETURNING *;
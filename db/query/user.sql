@@ -5,4 +5,28 @@ INSERT INTO users (
 
 -- name: GetUser :one
 SELECT * FROM users
-WHERE username = $1 LIMIT 1;
\ No newline at end of file
+WHERE username = $1 LIMIT 1;
+
+-- name: GetUserByID :one
+SELECT * FROM users
+WHERE id = $1 LIMIT 1;
+
+-- name: SearchUsers :many
+SELECT * FROM users
+WHERE username ILIKE '%' || sqlc.arg(query)::text || '%'
+   OR full_name ILIKE '%' || sqlc.arg(query)::text || '%'
+   OR email ILIKE '%' || sqlc.arg(query)::text || '%'
+ORDER BY id
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
+
+-- name: UpdateUserRole :one
+UPDATE users
+SET role = sqlc.arg(role)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: ListUsersByIDs :many
+SELECT * FROM users
+WHERE id IN (SELECT jsonb_array_elements_text(sqlc.arg(ids)::jsonb)::bigint)
+ORDER BY id;
@@ -0,0 +1,125 @@
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (
+    user_id,
+    url,
+    event_types,
+    secret
+) VALUES (
+             $1, $2, $3, $4
+         ) RETURNING *;
+
+-- name: GetWebhookSubscription :one
+SELECT * FROM webhook_subscriptions
+WHERE id = $1 AND user_id = $2 LIMIT 1;
+
+-- name: ListWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions
+WHERE user_id = $1
+ORDER BY id;
+
+-- name: UpdateWebhookSubscription :one
+UPDATE webhook_subscriptions
+SET url = sqlc.arg(url),
+    event_types = sqlc.arg(event_types),
+    status = sqlc.arg(status),
+    consecutive_failures = CASE WHEN sqlc.arg(status)::varchar = 'active' THEN 0 ELSE consecutive_failures END,
+    disabled_at = CASE
+        WHEN sqlc.arg(status)::varchar = 'active' THEN NULL
+        ELSE COALESCE(disabled_at, now())
+    END
+WHERE id = sqlc.arg(id) AND user_id = sqlc.arg(user_id)
+RETURNING *;
+
+-- name: DeleteWebhookSubscription :exec
+DELETE FROM webhook_subscriptions
+WHERE id = $1 AND user_id = $2;
+
+-- name: RecordWebhookSubscriptionSuccess :one
+UPDATE webhook_subscriptions
+SET consecutive_failures = 0
+WHERE id = $1
+RETURNING *;
+
+-- name: RecordWebhookSubscriptionFailure :one
+UPDATE webhook_subscriptions
+SET consecutive_failures = consecutive_failures + 1,
+    status = CASE WHEN consecutive_failures + 1 >= sqlc.arg(disable_after)::int THEN 'disabled' ELSE status END,
+    disabled_at = CASE
+        WHEN consecutive_failures + 1 >= sqlc.arg(disable_after)::int THEN COALESCE(disabled_at, now())
+        ELSE disabled_at
+    END
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: CreateWebhookDeliveries :many
+INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload)
+SELECT s.id, sqlc.arg(event_id)::bigint, sqlc.arg(event_type)::varchar, sqlc.arg(payload)::jsonb
+FROM webhook_subscriptions s
+WHERE s.status = 'active'
+  AND s.event_types @> jsonb_build_array(sqlc.arg(event_type)::varchar)
+  AND s.user_id IN (
+    SELECT h.user_id FROM account_holders h
+    JOIN accounts a ON h.account_id = COALESCE(a.parent_id, a.id)
+    WHERE a.id = sqlc.arg(account_id)::bigint
+    UNION
+    SELECT m.user_id FROM organisation_members m
+    JOIN accounts a ON m.organisation_id = a.organisation_id
+    WHERE a.id = sqlc.arg(account_id)::bigint
+)
+ON CONFLICT (subscription_id, event_id) DO NOTHING
+RETURNING *;
+
+-- name: ClaimDueWebhookDeliveries :many
+UPDATE webhook_deliveries
+SET next_attempt_at = sqlc.arg(leased_until)::timestamptz
+WHERE id IN (
+    SELECT d.id FROM webhook_deliveries d
+    JOIN webhook_subscriptions s ON s.id = d.subscription_id
+    WHERE d.status = 'pending'
+      AND d.next_attempt_at <= now()
+      AND s.status = 'active'
+    ORDER BY d.next_attempt_at, d.id
+    LIMIT sqlc.arg(page_limit)
+    FOR UPDATE OF d SKIP LOCKED
+)
+RETURNING *;
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_deliveries
+WHERE id = $1 AND subscription_id = $2 LIMIT 1;
+
+-- name: GetWebhookDeliveryForUpdate :one
+SELECT * FROM webhook_deliveries
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetWebhookSubscriptionByID :one
+SELECT * FROM webhook_subscriptions
+WHERE id = $1 LIMIT 1;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE subscription_id = $1
+ORDER BY id DESC
+LIMIT $2
+OFFSET $3;
+
+-- name: RecordWebhookDeliveryAttempt :one
+UPDATE webhook_deliveries
+SET attempts = attempts + 1,
+    status = sqlc.arg(status),
+    next_attempt_at = sqlc.arg(next_attempt_at),
+    last_status_code = sqlc.arg(last_status_code),
+    last_error = sqlc.arg(last_error),
+    delivered_at = CASE WHEN sqlc.arg(status)::varchar = 'succeeded' THEN now() ELSE delivered_at END
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: ReplayWebhookDelivery :one
+UPDATE webhook_deliveries
+SET status = 'pending',
+    attempts = 0,
+    next_attempt_at = now(),
+    last_error = ''
+WHERE id = $1 AND status <> 'pending'
+RETURNING *;
@@ -7,36 +7,12 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
-const addAccountBalance = `-- name: AddAccountBalance :one
-UPDATE accounts
-set balance = balance + $1
-WHERE id = $2
-RETURNING id, user_id, balance, currency, created_at
-`
-
-type AddAccountBalanceParams struct {
-	Amount int64 `json:"amount"`
-	ID     int64 `json:"id"`
-}
-
-func (q *Queries) AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (Account, error) {
-	row := q.db.QueryRowContext(ctx, addAccountBalance, arg.Amount, arg.ID)
-	var i Account
-	err := row.Scan(
-		&i.ID,
-		&i.UserID,
-		&i.Balance,
-		&i.Currency,
-		&i.CreatedAt,
-	)
-	return i, err
-}
-
 const createAccount = `-- name: CreateAccount :one
 INSERT INTO accounts (user_id,    balance,    currency
-) VALUES ($1, $2, $3 ) RETURNING id, user_id, balance, currency, created_at
+) VALUES ($1, $2, $3 ) RETURNING id, user_id, balance, currency, created_at, kind, parent_id, name, goal_amount, target_date, organisation_id, frozen_at, frozen_reason
 `
 
 type CreateAccountParams struct {
@@ -54,6 +30,14 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Kind,
+		&i.ParentID,
+		&i.Name,
+		&i.GoalAmount,
+		&i.TargetDate,
+		&i.OrganisationID,
+		&i.FrozenAt,
+		&i.FrozenReason,
 	)
 	return i, err
 }
@@ -68,8 +52,58 @@ func (q *Queries) DeleteAccount(ctx context.Context, id int64) error {
 	return err
 }
 
+const freezeAccount = `-- name: FreezeAccount :many
+UPDATE accounts
+SET frozen_at = now(),
+    frozen_reason = $1
+WHERE id = $2 OR parent_id = $2
+RETURNING id, user_id, balance, currency, created_at, kind, parent_id, name, goal_amount, target_date, organisation_id, frozen_at, frozen_reason
+`
+
+type FreezeAccountParams struct {
+	Reason string `json:"reason"`
+	ID     int64  `json:"id"`
+}
+
+func (q *Queries) FreezeAccount(ctx context.Context, arg FreezeAccountParams) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, freezeAccount, arg.Reason, arg.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Kind,
+			&i.ParentID,
+			&i.Name,
+			&i.GoalAmount,
+			&i.TargetDate,
+			&i.OrganisationID,
+			&i.FrozenAt,
+			&i.FrozenReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAccount = `-- name: GetAccount :one
-SELECT id, user_id, balance, currency, created_at FROM accounts
+SELECT id, user_id, balance, currency, created_at, kind, parent_id, name, goal_amount, target_date, organisation_id, frozen_at, frozen_reason FROM accounts
 WHERE id = $1 LIMIT 1
 `
 
@@ -82,12 +116,20 @@ func (q *Queries) GetAccount(ctx context.Context, id int64) (Account, error) {
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Kind,
+		&i.ParentID,
+		&i.Name,
+		&i.GoalAmount,
+		&i.TargetDate,
+		&i.OrganisationID,
+		&i.FrozenAt,
+		&i.FrozenReason,
 	)
 	return i, err
 }
 
 const getAccountForUpdate = `-- name: GetAccountForUpdate :one
-SELECT id, user_id, balance, currency, created_at FROM accounts
+SELECT id, user_id, balance, currency, created_at, kind, parent_id, name, goal_amount, target_date, organisation_id, frozen_at, frozen_reason FROM accounts
 WHERE id = $1 LIMIT 1
 FOR NO KEY UPDATE
 `
@@ -101,12 +143,52 @@ func (q *Queries) GetAccountForUpdate(ctx context.Context, id int64) (Account, e
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Kind,
+		&i.ParentID,
+		&i.Name,
+		&i.GoalAmount,
+		&i.TargetDate,
+		&i.OrganisationID,
+		&i.FrozenAt,
+		&i.FrozenReason,
+	)
+	return i, err
+}
+
+const getInternalAccount = `-- name: GetInternalAccount :one
+SELECT id, user_id, balance, currency, created_at, kind, parent_id, name, goal_amount, target_date, organisation_id, frozen_at, frozen_reason FROM accounts
+WHERE kind = $1 AND currency = $2 LIMIT 1
+`
+
+type GetInternalAccountParams struct {
+	Kind     string `json:"kind"`
+	Currency string `json:"currency"`
+}
+
+func (q *Queries) GetInternalAccount(ctx context.Context, arg GetInternalAccountParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, getInternalAccount, arg.Kind, arg.Currency)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.Kind,
+		&i.ParentID,
+		&i.Name,
+		&i.GoalAmount,
+		&i.TargetDate,
+		&i.OrganisationID,
+		&i.FrozenAt,
+		&i.FrozenReason,
 	)
 	return i, err
 }
 
 const listAccounts = `-- name: ListAccounts :many
-SELECT id, user_id, balance, currency, created_at FROM accounts
+SELECT id, user_id, balance, currency, created_at, kind, parent_id, name, goal_amount, target_date, organisation_id, frozen_at, frozen_reason FROM accounts
+WHERE kind = 'customer'
 ORDER BY id
 limit $1
 offset $2
@@ -132,6 +214,14 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 			&i.Balance,
 			&i.Currency,
 			&i.CreatedAt,
+			&i.Kind,
+			&i.ParentID,
+			&i.Name,
+			&i.GoalAmount,
+			&i.TargetDate,
+			&i.OrganisationID,
+			&i.FrozenAt,
+			&i.FrozenReason,
 		); err != nil {
 			return nil, err
 		}
@@ -146,27 +236,111 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 	return items, nil
 }
 
-const updateAccount = `-- name: UpdateAccount :one
-UPDATE accounts
-set balance = $2
-WHERE id = $1
-RETURNING id, user_id, balance, currency, created_at
+const searchAccounts = `-- name: SearchAccounts :many
+SELECT id, user_id, balance, currency, created_at, kind, parent_id, name, goal_amount, target_date, organisation_id, frozen_at, frozen_reason FROM accounts
+WHERE ($1::bigint IS NULL OR user_id = $1)
+  AND ($2::varchar IS NULL OR currency = $2)
+  AND ($3::varchar IS NULL OR kind = $3)
+  AND (NOT $4::boolean OR frozen_at IS NOT NULL)
+ORDER BY id
+LIMIT $5
+OFFSET $6
 `
 
-type UpdateAccountParams struct {
-	ID      int64 `json:"id"`
-	Balance int64 `json:"balance"`
+type SearchAccountsParams struct {
+	UserID     sql.NullInt64  `json:"user_id"`
+	Currency   sql.NullString `json:"currency"`
+	Kind       sql.NullString `json:"kind"`
+	FrozenOnly bool           `json:"frozen_only"`
+	PageLimit  int32          `json:"page_limit"`
+	PageOffset int32          `json:"page_offset"`
 }
 
-func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error) {
-	row := q.db.QueryRowContext(ctx, updateAccount, arg.ID, arg.Balance)
-	var i Account
-	err := row.Scan(
-		&i.ID,
-		&i.UserID,
-		&i.Balance,
-		&i.Currency,
-		&i.CreatedAt,
+func (q *Queries) SearchAccounts(ctx context.Context, arg SearchAccountsParams) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, searchAccounts,
+		arg.UserID,
+		arg.Currency,
+		arg.Kind,
+		arg.FrozenOnly,
+		arg.PageLimit,
+		arg.PageOffset,
 	)
-	return i, err
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Kind,
+			&i.ParentID,
+			&i.Name,
+			&i.GoalAmount,
+			&i.TargetDate,
+			&i.OrganisationID,
+			&i.FrozenAt,
+			&i.FrozenReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const unfreezeAccount = `-- name: UnfreezeAccount :many
+UPDATE accounts
+SET frozen_at = NULL,
+    frozen_reason = ''
+WHERE id = $1 OR parent_id = $1
+RETURNING id, user_id, balance, currency, created_at, kind, parent_id, name, goal_amount, target_date, organisation_id, frozen_at, frozen_reason
+`
+
+func (q *Queries) UnfreezeAccount(ctx context.Context, id int64) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, unfreezeAccount, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Kind,
+			&i.ParentID,
+			&i.Name,
+			&i.GoalAmount,
+			&i.TargetDate,
+			&i.OrganisationID,
+			&i.FrozenAt,
+			&i.FrozenReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
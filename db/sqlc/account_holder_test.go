@@ -0,0 +1,137 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAccountTx(t *testing.T) {
+	store := NewStore(testDBConnection)
+	user := createRandomAccountWithCurrency(t, util.USD).UserID
+
+	result, err := store.CreateAccountTx(context.Background(), CreateAccountTxParams{UserID: user, Currency: util.EUR})
+	require.NoError(t, err)
+	require.Equal(t, user, result.Account.UserID)
+	require.Equal(t, int64(0), result.Account.Balance)
+	require.Equal(t, result.Account.ID, result.Holder.AccountID)
+	require.Equal(t, util.AccountRoleOwner, result.Holder.Role)
+
+	// a user owns at most one account per currency
+	_, err = store.CreateAccountTx(context.Background(), CreateAccountTxParams{UserID: user, Currency: util.EUR})
+	require.Error(t, err)
+
+	holders, err := testQueries.ListAccountHolders(context.Background(), result.Account.ID)
+	require.NoError(t, err)
+	require.Len(t, holders, 1)
+}
+
+func TestAcceptAccountInvitationTx(t *testing.T) {
+	store := NewStore(testDBConnection)
+	owner, err := store.CreateAccountTx(context.Background(), CreateAccountTxParams{
+		UserID:   createRandomAccountWithCurrency(t, util.CAD).UserID,
+		Currency: util.USD,
+	})
+	require.NoError(t, err)
+
+	// co-owning an account doesn't count against the invitee's own USD account
+	invitee := createRandomAccountWithCurrency(t, util.USD).UserID
+
+	invitation, err := testQueries.CreateAccountInvitation(context.Background(), CreateAccountInvitationParams{
+		AccountID: owner.Account.ID,
+		InviterID: owner.Account.UserID,
+		InviteeID: invitee,
+		Role:      util.AccountRoleCoOwner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.InvitationStatusPending, invitation.Status)
+
+	_, err = testQueries.CreateAccountInvitation(context.Background(), CreateAccountInvitationParams{
+		AccountID: owner.Account.ID,
+		InviterID: owner.Account.UserID,
+		InviteeID: invitee,
+		Role:      util.AccountRoleViewer,
+	})
+	require.Error(t, err)
+
+	result, err := store.AcceptAccountInvitationTx(context.Background(), invitation.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.InvitationStatusAccepted, result.Invitation.Status)
+	require.Equal(t, invitee, result.Holder.UserID)
+	require.Equal(t, util.AccountRoleCoOwner, result.Holder.Role)
+
+	_, err = store.AcceptAccountInvitationTx(context.Background(), invitation.ID)
+	require.ErrorIs(t, err, ErrInvitationNotPending)
+
+	accounts, err := testQueries.ListHeldAccounts(context.Background(), ListHeldAccountsParams{UserID: invitee, Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, owner.Account.ID, accounts[0].ID)
+}
+
+func TestListHeldAccountsByUsers(t *testing.T) {
+	store := NewStore(testDBConnection)
+	user1 := createRandomAccountWithCurrency(t, util.CAD).UserID
+	user2 := createRandomAccountWithCurrency(t, util.CAD).UserID
+
+	var held []Account
+	for _, currency := range []string{util.USD, util.EUR} {
+		result, err := store.CreateAccountTx(context.Background(), CreateAccountTxParams{UserID: user1, Currency: currency})
+		require.NoError(t, err)
+		held = append(held, result.Account)
+	}
+
+	// the page size applies to every user on its own
+	rows, err := testQueries.ListHeldAccountsByUsers(context.Background(), ListHeldAccountsByUsersParams{
+		UserIds:   json.RawMessage(fmt.Sprintf("[%d,%d]", user1, user2)),
+		PageLimit: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, user1, rows[0].HolderID)
+	require.Equal(t, held[0].ID, rows[0].ID)
+
+	rows, err = testQueries.ListHeldAccountsByUsers(context.Background(), ListHeldAccountsByUsersParams{
+		UserIds:   json.RawMessage(fmt.Sprintf("[%d]", user1)),
+		PageLimit: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, held[1].ID, rows[1].ID)
+}
+
+func TestListAccountsWithRoles(t *testing.T) {
+	store := NewStore(testDBConnection)
+	organisation := createRandomOrganisation(t)
+	admin := organisation.Member.UserID
+
+	owned, err := store.CreateAccountTx(context.Background(), CreateAccountTxParams{UserID: admin, Currency: util.EUR})
+	require.NoError(t, err)
+
+	organisationAccount, err := testQueries.CreateOrganisationAccount(context.Background(), CreateOrganisationAccountParams{
+		UserID:         admin,
+		Currency:       util.USD,
+		OrganisationID: organisation.Organisation.ID,
+	})
+	require.NoError(t, err)
+
+	other := createRandomAccount(t)
+
+	rows, err := testQueries.ListAccountsWithRoles(context.Background(), ListAccountsWithRolesParams{
+		UserID:     admin,
+		AccountIds: json.RawMessage(fmt.Sprintf("[%d,%d,%d]", owned.Account.ID, organisationAccount.ID, other.ID)),
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	require.Equal(t, util.AccountRoleOwner, rows[0].HolderRole)
+	require.Empty(t, rows[0].MemberRole)
+	require.Empty(t, rows[1].HolderRole)
+	require.Equal(t, util.OrganisationRoleAdmin, rows[1].MemberRole)
+	require.Empty(t, rows[2].HolderRole)
+	require.Empty(t, rows[2].MemberRole)
+}
@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Sinothic/simplebank/util"
+)
+
+// ErrInvitationNotPending is returned when answering an invitation that was already answered or revoked
+var ErrInvitationNotPending = errors.New("invitation is not pending")
+
+// CreateAccountTxParams contains the input parameters of the create account transaction
+type CreateAccountTxParams struct {
+	UserID   int64  `json:"user_id"`
+	Currency string `json:"currency"`
+}
+
+// CreateAccountTxResult is the result of the create account transaction
+type CreateAccountTxResult struct {
+	Account Account       `json:"account"`
+	Holder  AccountHolder `json:"holder"`
+}
+
+// CreateAccountTx opens an empty customer account with the user as its owner
+func (store *SQLStore) CreateAccountTx(ctx context.Context, arg CreateAccountTxParams) (CreateAccountTxResult, error) {
+	var result CreateAccountTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result.Account, err = q.CreateAccount(ctx, CreateAccountParams{
+			UserID:   arg.UserID,
+			Currency: arg.Currency,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Holder, err = q.CreateAccountHolder(ctx, CreateAccountHolderParams{
+			AccountID: result.Account.ID,
+			UserID:    arg.UserID,
+			Role:      util.AccountRoleOwner,
+		})
+		if err != nil {
+			return err
+		}
+
+		return enqueueEvent(ctx, q, EventTypeAccountCreated, 1, AggregateTypeAccount, result.Account.ID, AccountCreatedV1{
+			AccountID: result.Account.ID,
+			UserID:    result.Account.UserID,
+			Currency:  result.Account.Currency,
+			Kind:      result.Account.Kind,
+			CreatedAt: result.Account.CreatedAt,
+		})
+	})
+	return result, err
+}
+
+// AcceptAccountInvitationTxResult is the result of the accept account invitation transaction
+type AcceptAccountInvitationTxResult struct {
+	Invitation AccountInvitation `json:"invitation"`
+	Holder     AccountHolder     `json:"holder"`
+}
+
+// AcceptAccountInvitationTx makes the invitee of a pending invitation a holder of the account with the invited role
+func (store *SQLStore) AcceptAccountInvitationTx(ctx context.Context, invitationID int64) (AcceptAccountInvitationTxResult, error) {
+	var result AcceptAccountInvitationTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		invitation, err := q.GetAccountInvitationForUpdate(ctx, invitationID)
+		if err != nil {
+			return err
+		}
+
+		if invitation.Status != util.InvitationStatusPending {
+			return ErrInvitationNotPending
+		}
+
+		result.Holder, err = q.CreateAccountHolder(ctx, CreateAccountHolderParams{
+			AccountID: invitation.AccountID,
+			UserID:    invitation.InviteeID,
+			Role:      invitation.Role,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Invitation, err = q.UpdatePendingAccountInvitationStatus(ctx, UpdatePendingAccountInvitationStatusParams{
+			ID:     invitation.ID,
+			Status: util.InvitationStatusAccepted,
+		})
+		return err
+	})
+	return result, err
+}
@@ -6,11 +6,16 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Sinothic/simplebank/util"
 	"github.com/go-faker/faker/v4"
 	"github.com/stretchr/testify/require"
 )
 
 func createRandomAccount(t *testing.T) Account {
+	return createRandomAccountWithCurrency(t, faker.Currency())
+}
+
+func createRandomAccountWithCurrency(t *testing.T, currency string) Account {
 	balance, err := faker.RandomInt(1, 1000, 1)
 	require.NoError(t, err)
 
@@ -25,7 +30,7 @@ func createRandomAccount(t *testing.T) Account {
 	args := CreateAccountParams{
 		UserID:   user.ID,
 		Balance:  int64(balance[0]),
-		Currency: faker.Currency(),
+		Currency: currency,
 	}
 
 	account, err := testQueries.CreateAccount(context.Background(), args)
@@ -59,20 +64,14 @@ func TestGetAccount(t *testing.T) {
 	require.WithinDuration(t, expectedAccount.CreatedAt, dbAccount.CreatedAt, time.Second)
 }
 
-func TestUpdateAccount(t *testing.T) {
-	account := createRandomAccount(t)
-
-	args := UpdateAccountParams{
-		ID:      account.ID,
-		Balance: account.Balance + 100,
-	}
-
-	updatedAccount, err := testQueries.UpdateAccount(context.Background(), args)
+func TestGetInternalAccount(t *testing.T) {
+	account, err := testQueries.GetInternalAccount(context.Background(), GetInternalAccountParams{
+		Kind:     util.AccountKindSuspense,
+		Currency: util.USD,
+	})
 	require.NoError(t, err)
-	require.NotEmpty(t, account)
-
-	require.Equal(t, account.ID, updatedAccount.ID)
-	require.Equal(t, args.Balance, updatedAccount.Balance)
+	require.Equal(t, util.AccountKindSuspense, account.Kind)
+	require.Equal(t, util.USD, account.Currency)
 }
 
 func TestDeleteAccount(t *testing.T) {
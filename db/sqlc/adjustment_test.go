@@ -0,0 +1,49 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdjustBalanceTx(t *testing.T) {
+	store := NewStore(testDBConnection)
+	account := createRandomAccountWithCurrency(t, util.USD)
+
+	suspense, err := store.GetInternalAccount(context.Background(), GetInternalAccountParams{
+		Kind:     util.AccountKindSuspense,
+		Currency: util.USD,
+	})
+	require.NoError(t, err)
+
+	result, err := store.AdjustBalanceTx(context.Background(), AdjustBalanceTxParams{
+		AccountID:     account.ID,
+		Amount:        -25,
+		ReasonCode:    util.ReasonCorrection,
+		Justification: "duplicate card settlement",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, account.Balance-25, result.Account.Balance)
+	require.Equal(t, suspense.ID, result.SuspenseAccount.ID)
+	require.Equal(t, int64(-25), result.AccountEntry.Amount)
+	require.Equal(t, int64(25), result.SuspenseEntry.Amount)
+	require.Equal(t, result.AccountEntry.Amount+result.SuspenseEntry.Amount, int64(0))
+
+	require.Equal(t, account.ID, result.Adjustment.AccountID)
+	require.Equal(t, suspense.ID, result.Adjustment.SuspenseAccountID)
+	require.Equal(t, result.AccountEntry.ID, result.Adjustment.AccountEntryID)
+	require.Equal(t, result.SuspenseEntry.ID, result.Adjustment.SuspenseEntryID)
+	require.Equal(t, util.ReasonCorrection, result.Adjustment.ReasonCode)
+
+	adjustments, err := store.ListBalanceAdjustments(context.Background(), ListBalanceAdjustmentsParams{
+		AccountID: account.ID,
+		Limit:     5,
+		Offset:    0,
+	})
+	require.NoError(t, err)
+	require.Len(t, adjustments, 1)
+	require.Equal(t, result.Adjustment.ID, adjustments[0].ID)
+}
@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+
+	"github.com/Sinothic/simplebank/util"
+)
+
+// AdjustBalanceTxParams contains the input parameters of the balance adjustment transaction
+type AdjustBalanceTxParams struct {
+	AccountID     int64  `json:"account_id"`
+	Amount        int64  `json:"amount"`
+	ReasonCode    string `json:"reason_code"`
+	Justification string `json:"justification"`
+}
+
+// AdjustBalanceTxResult is the result of the balance adjustment transaction
+type AdjustBalanceTxResult struct {
+	Adjustment      BalanceAdjustment `json:"adjustment"`
+	Account         Account           `json:"account"`
+	SuspenseAccount Account           `json:"suspense_account"`
+	AccountEntry    Entry             `json:"account_entry"`
+	SuspenseEntry   Entry             `json:"suspense_entry"`
+}
+
+// AdjustBalanceTx corrects an account balance by posting a pair of entries
+// against the suspense account of the same currency, so the ledger stays balanced
+func (store *SQLStore) AdjustBalanceTx(ctx context.Context, arg AdjustBalanceTxParams) (AdjustBalanceTxResult, error) {
+	var result AdjustBalanceTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		suspense, err := q.GetInternalAccount(ctx, GetInternalAccountParams{
+			Kind:     util.AccountKindSuspense,
+			Currency: account.Currency,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.AccountEntry, err = postEntry(ctx, q, CreateTransferEntryParams{
+			AccountID: account.ID,
+			Amount:    arg.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.SuspenseEntry, err = postEntry(ctx, q, CreateTransferEntryParams{
+			AccountID: suspense.ID,
+			Amount:    -arg.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Account, err = q.addAccountBalance(ctx, AddAccountBalanceParams{
+			ID:     account.ID,
+			Amount: arg.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.SuspenseAccount, err = q.addAccountBalance(ctx, AddAccountBalanceParams{
+			ID:     suspense.ID,
+			Amount: -arg.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Adjustment, err = q.CreateBalanceAdjustment(ctx, CreateBalanceAdjustmentParams{
+			AccountID:         account.ID,
+			SuspenseAccountID: suspense.ID,
+			AccountEntryID:    result.AccountEntry.ID,
+			SuspenseEntryID:   result.SuspenseEntry.ID,
+			Amount:            arg.Amount,
+			ReasonCode:        arg.ReasonCode,
+			Justification:     arg.Justification,
+		})
+		if err != nil {
+			return err
+		}
+
+		return enqueueEvent(ctx, q, EventTypeBalanceAdjusted, 1, AggregateTypeAccount, account.ID, BalanceAdjustedV1{
+			AdjustmentID: result.Adjustment.ID,
+			AccountID:    account.ID,
+			Amount:       result.Adjustment.Amount,
+			ReasonCode:   result.Adjustment.ReasonCode,
+			Balance:      result.Account.Balance,
+			CreatedAt:    result.Adjustment.CreatedAt,
+		})
+	})
+	return result, err
+}
@@ -0,0 +1,88 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFreezeAccount(t *testing.T) {
+	parent := createRandomAccountWithCurrency(t, util.EUR)
+	pot := createRandomPot(t, parent)
+
+	// the pots of an account are frozen with it
+	accounts, err := testQueries.FreezeAccount(context.Background(), FreezeAccountParams{ID: parent.ID, Reason: "suspected fraud"})
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	for _, account := range accounts {
+		require.True(t, account.FrozenAt.Valid)
+		require.Equal(t, "suspected fraud", account.FrozenReason)
+	}
+
+	frozen, err := testQueries.SearchAccounts(context.Background(), SearchAccountsParams{
+		UserID:     sql.NullInt64{Int64: parent.UserID, Valid: true},
+		FrozenOnly: true,
+		PageLimit:  5,
+	})
+	require.NoError(t, err)
+	require.Len(t, frozen, 2)
+
+	accounts, err = testQueries.UnfreezeAccount(context.Background(), parent.ID)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+
+	unfrozen, err := testQueries.GetAccount(context.Background(), pot.ID)
+	require.NoError(t, err)
+	require.False(t, unfrozen.FrozenAt.Valid)
+	require.Empty(t, unfrozen.FrozenReason)
+}
+
+func TestUpdateUserRole(t *testing.T) {
+	user, err := testQueries.GetUserByID(context.Background(), createRandomAccount(t).UserID)
+	require.NoError(t, err)
+	require.Equal(t, util.UserRoleCustomer, user.Role)
+
+	users, err := testQueries.SearchUsers(context.Background(), SearchUsersParams{Query: user.Email, PageLimit: 5})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+
+	user, err = testQueries.UpdateUserRole(context.Background(), UpdateUserRoleParams{ID: user.ID, Role: util.UserRoleSupport})
+	require.NoError(t, err)
+	require.Equal(t, util.UserRoleSupport, user.Role)
+
+	_, err = testQueries.UpdateUserRole(context.Background(), UpdateUserRoleParams{ID: user.ID, Role: "root"})
+	require.Error(t, err)
+
+	log, err := testQueries.CreateAdminAuditLog(context.Background(), CreateAdminAuditLogParams{
+		ActorID:    user.ID,
+		Action:     "GET /admin/users",
+		Path:       "/admin/users?q=smith&page_id=1&page_size=5",
+		StatusCode: http.StatusOK,
+	})
+	require.NoError(t, err)
+
+	logs, err := testQueries.ListAdminAuditLogs(context.Background(), ListAdminAuditLogsParams{
+		ActorID:   sql.NullInt64{Int64: user.ID, Valid: true},
+		PageLimit: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Equal(t, log.ID, logs[0].ID)
+}
+
+func TestListUsersByIDs(t *testing.T) {
+	user1 := createRandomAccount(t).UserID
+	user2 := createRandomAccount(t).UserID
+
+	users, err := testQueries.ListUsersByIDs(context.Background(), json.RawMessage(fmt.Sprintf("[%d,%d,%d]", user2, user1, user2+1000000)))
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	require.Equal(t, user1, users[0].ID)
+	require.Equal(t, user2, users[1].ID)
+}
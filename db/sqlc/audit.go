@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+	"strconv"
+)
+
+// AuditContext describes the request a change is made for, the audit triggers record it
+// with the before and after snapshots of every changed row
+type AuditContext struct {
+	ActorID   int64
+	Route     string
+	RequestID string
+	IP        string
+	UserAgent string
+}
+
+type auditContextKey struct{}
+
+// WithAuditContext returns a copy of ctx carrying the audit context of a request
+func WithAuditContext(ctx context.Context, audit AuditContext) context.Context {
+	return context.WithValue(ctx, auditContextKey{}, audit)
+}
+
+// AuditContextFrom returns the audit context carried by ctx
+func AuditContextFrom(ctx context.Context) (AuditContext, bool) {
+	audit, ok := ctx.Value(auditContextKey{}).(AuditContext)
+	return audit, ok
+}
+
+// applyAuditContext hands the audit context of ctx to the audit triggers of the transaction of q,
+// the changes made without one are recorded as made by the bank itself
+func applyAuditContext(ctx context.Context, q *Queries) error {
+	audit, ok := AuditContextFrom(ctx)
+	if !ok {
+		return nil
+	}
+
+	arg := SetAuditContextParams{
+		Route:     audit.Route,
+		RequestID: audit.RequestID,
+		Ip:        audit.IP,
+		UserAgent: audit.UserAgent,
+	}
+	if audit.ActorID != 0 {
+		arg.ActorID = strconv.FormatInt(audit.ActorID, 10)
+	}
+	return q.SetAuditContext(ctx, arg)
+}
+
+// audited runs a query changing the database in a transaction, so that its audit events are recorded with
+// the audit context of ctx. Queries run without an audit context don't need a transaction of their own
+func audited[A, R any](ctx context.Context, store *SQLStore, query func(*Queries, context.Context, A) (R, error), arg A) (R, error) {
+	if _, ok := AuditContextFrom(ctx); !ok {
+		return query(store.Queries, ctx, arg)
+	}
+
+	var result R
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = query(q, ctx, arg)
+		return err
+	})
+	return result, err
+}
+
+// auditedExec is audited for the queries only returning an error
+func auditedExec[A any](ctx context.Context, store *SQLStore, query func(*Queries, context.Context, A) error, arg A) error {
+	_, err := audited(ctx, store, func(q *Queries, ctx context.Context, arg A) (struct{}, error) {
+		return struct{}{}, query(q, ctx, arg)
+	}, arg)
+	return err
+}
@@ -0,0 +1,190 @@
+package db
+
+import "context"
+
+// The queries changing the database are run through audited by the SQLStore,
+// the queries of a transaction are audited by execTx
+
+func (store *SQLStore) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
+	return audited(ctx, store, (*Queries).CreateAccount, arg)
+}
+
+func (store *SQLStore) FreezeAccount(ctx context.Context, arg FreezeAccountParams) ([]Account, error) {
+	return audited(ctx, store, (*Queries).FreezeAccount, arg)
+}
+
+func (store *SQLStore) UnfreezeAccount(ctx context.Context, id int64) ([]Account, error) {
+	return audited(ctx, store, (*Queries).UnfreezeAccount, id)
+}
+
+func (store *SQLStore) DeleteAccount(ctx context.Context, id int64) error {
+	return auditedExec(ctx, store, (*Queries).DeleteAccount, id)
+}
+
+func (store *SQLStore) CreateAccountHolder(ctx context.Context, arg CreateAccountHolderParams) (AccountHolder, error) {
+	return audited(ctx, store, (*Queries).CreateAccountHolder, arg)
+}
+
+func (store *SQLStore) DeleteAccountHolder(ctx context.Context, arg DeleteAccountHolderParams) error {
+	return auditedExec(ctx, store, (*Queries).DeleteAccountHolder, arg)
+}
+
+func (store *SQLStore) CreateAccountInvitation(ctx context.Context, arg CreateAccountInvitationParams) (AccountInvitation, error) {
+	return audited(ctx, store, (*Queries).CreateAccountInvitation, arg)
+}
+
+func (store *SQLStore) UpdatePendingAccountInvitationStatus(ctx context.Context, arg UpdatePendingAccountInvitationStatusParams) (AccountInvitation, error) {
+	return audited(ctx, store, (*Queries).UpdatePendingAccountInvitationStatus, arg)
+}
+
+func (store *SQLStore) CreateAchFile(ctx context.Context, arg CreateAchFileParams) (AchFile, error) {
+	return audited(ctx, store, (*Queries).CreateAchFile, arg)
+}
+
+func (store *SQLStore) CreateBalanceAdjustment(ctx context.Context, arg CreateBalanceAdjustmentParams) (BalanceAdjustment, error) {
+	return audited(ctx, store, (*Queries).CreateBalanceAdjustment, arg)
+}
+
+func (store *SQLStore) CreateBeneficiary(ctx context.Context, arg CreateBeneficiaryParams) (Beneficiary, error) {
+	return audited(ctx, store, (*Queries).CreateBeneficiary, arg)
+}
+
+func (store *SQLStore) CreateExternalTransfer(ctx context.Context, arg CreateExternalTransferParams) (ExternalTransfer, error) {
+	return audited(ctx, store, (*Queries).CreateExternalTransfer, arg)
+}
+
+func (store *SQLStore) UpdateExternalTransferStatus(ctx context.Context, arg UpdateExternalTransferStatusParams) (ExternalTransfer, error) {
+	return audited(ctx, store, (*Queries).UpdateExternalTransferStatus, arg)
+}
+
+func (store *SQLStore) CreateOrganisation(ctx context.Context, arg CreateOrganisationParams) (Organisation, error) {
+	return audited(ctx, store, (*Queries).CreateOrganisation, arg)
+}
+
+func (store *SQLStore) CreateOrganisationMember(ctx context.Context, arg CreateOrganisationMemberParams) (OrganisationMember, error) {
+	return audited(ctx, store, (*Queries).CreateOrganisationMember, arg)
+}
+
+func (store *SQLStore) UpdateOrganisationMember(ctx context.Context, arg UpdateOrganisationMemberParams) (OrganisationMember, error) {
+	return audited(ctx, store, (*Queries).UpdateOrganisationMember, arg)
+}
+
+func (store *SQLStore) DeleteOrganisationMember(ctx context.Context, arg DeleteOrganisationMemberParams) error {
+	return auditedExec(ctx, store, (*Queries).DeleteOrganisationMember, arg)
+}
+
+func (store *SQLStore) CreateOrganisationAccount(ctx context.Context, arg CreateOrganisationAccountParams) (Account, error) {
+	return audited(ctx, store, (*Queries).CreateOrganisationAccount, arg)
+}
+
+func (store *SQLStore) CreateOutboundPayment(ctx context.Context, arg CreateOutboundPaymentParams) (OutboundPayment, error) {
+	return audited(ctx, store, (*Queries).CreateOutboundPayment, arg)
+}
+
+func (store *SQLStore) MarkOutboundPaymentBatched(ctx context.Context, arg MarkOutboundPaymentBatchedParams) (OutboundPayment, error) {
+	return audited(ctx, store, (*Queries).MarkOutboundPaymentBatched, arg)
+}
+
+func (store *SQLStore) MarkOutboundPaymentReturned(ctx context.Context, arg MarkOutboundPaymentReturnedParams) (OutboundPayment, error) {
+	return audited(ctx, store, (*Queries).MarkOutboundPaymentReturned, arg)
+}
+
+func (store *SQLStore) MarkOutboundPaymentSubmitted(ctx context.Context, arg MarkOutboundPaymentSubmittedParams) (OutboundPayment, error) {
+	return audited(ctx, store, (*Queries).MarkOutboundPaymentSubmitted, arg)
+}
+
+func (store *SQLStore) CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) (Outbox, error) {
+	return audited(ctx, store, (*Queries).CreateOutboxEvent, arg)
+}
+
+func (store *SQLStore) MarkOutboxEventPublished(ctx context.Context, id int64) (Outbox, error) {
+	return audited(ctx, store, (*Queries).MarkOutboxEventPublished, id)
+}
+
+func (store *SQLStore) RecordOutboxEventFailure(ctx context.Context, arg RecordOutboxEventFailureParams) (Outbox, error) {
+	return audited(ctx, store, (*Queries).RecordOutboxEventFailure, arg)
+}
+
+func (store *SQLStore) CreatePayee(ctx context.Context, arg CreatePayeeParams) (Payee, error) {
+	return audited(ctx, store, (*Queries).CreatePayee, arg)
+}
+
+func (store *SQLStore) DeletePayee(ctx context.Context, id int64) error {
+	return auditedExec(ctx, store, (*Queries).DeletePayee, id)
+}
+
+func (store *SQLStore) UpdatePayeeNickname(ctx context.Context, arg UpdatePayeeNicknameParams) (Payee, error) {
+	return audited(ctx, store, (*Queries).UpdatePayeeNickname, arg)
+}
+
+func (store *SQLStore) CreatePaymentBatch(ctx context.Context, arg CreatePaymentBatchParams) (PaymentBatch, error) {
+	return audited(ctx, store, (*Queries).CreatePaymentBatch, arg)
+}
+
+func (store *SQLStore) UpdatePaymentBatchResult(ctx context.Context, arg UpdatePaymentBatchResultParams) (PaymentBatch, error) {
+	return audited(ctx, store, (*Queries).UpdatePaymentBatchResult, arg)
+}
+
+func (store *SQLStore) CreatePaymentRequest(ctx context.Context, arg CreatePaymentRequestParams) (PaymentRequest, error) {
+	return audited(ctx, store, (*Queries).CreatePaymentRequest, arg)
+}
+
+func (store *SQLStore) MarkPaymentRequestAccepted(ctx context.Context, arg MarkPaymentRequestAcceptedParams) (PaymentRequest, error) {
+	return audited(ctx, store, (*Queries).MarkPaymentRequestAccepted, arg)
+}
+
+func (store *SQLStore) UpdatePendingPaymentRequestStatus(ctx context.Context, arg UpdatePendingPaymentRequestStatusParams) (PaymentRequest, error) {
+	return audited(ctx, store, (*Queries).UpdatePendingPaymentRequestStatus, arg)
+}
+
+func (store *SQLStore) CreatePot(ctx context.Context, arg CreatePotParams) (Account, error) {
+	return audited(ctx, store, (*Queries).CreatePot, arg)
+}
+
+func (store *SQLStore) UpdatePot(ctx context.Context, arg UpdatePotParams) (Account, error) {
+	return audited(ctx, store, (*Queries).UpdatePot, arg)
+}
+
+func (store *SQLStore) CreateSepaBatch(ctx context.Context, arg CreateSepaBatchParams) (SepaBatch, error) {
+	return audited(ctx, store, (*Queries).CreateSepaBatch, arg)
+}
+
+func (store *SQLStore) CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error) {
+	return audited(ctx, store, (*Queries).CreateTransfer, arg)
+}
+
+func (store *SQLStore) CreateTransferApproval(ctx context.Context, arg CreateTransferApprovalParams) (TransferApproval, error) {
+	return audited(ctx, store, (*Queries).CreateTransferApproval, arg)
+}
+
+func (store *SQLStore) MarkTransferApprovalApproved(ctx context.Context, arg MarkTransferApprovalApprovedParams) (TransferApproval, error) {
+	return audited(ctx, store, (*Queries).MarkTransferApprovalApproved, arg)
+}
+
+func (store *SQLStore) RejectPendingTransferApproval(ctx context.Context, arg RejectPendingTransferApprovalParams) (TransferApproval, error) {
+	return audited(ctx, store, (*Queries).RejectPendingTransferApproval, arg)
+}
+
+func (store *SQLStore) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+	return audited(ctx, store, (*Queries).CreateUser, arg)
+}
+
+func (store *SQLStore) UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (User, error) {
+	return audited(ctx, store, (*Queries).UpdateUserRole, arg)
+}
+
+func (store *SQLStore) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error) {
+	return audited(ctx, store, (*Queries).CreateWebhookSubscription, arg)
+}
+
+func (store *SQLStore) UpdateWebhookSubscription(ctx context.Context, arg UpdateWebhookSubscriptionParams) (WebhookSubscription, error) {
+	return audited(ctx, store, (*Queries).UpdateWebhookSubscription, arg)
+}
+
+func (store *SQLStore) DeleteWebhookSubscription(ctx context.Context, arg DeleteWebhookSubscriptionParams) error {
+	return auditedExec(ctx, store, (*Queries).DeleteWebhookSubscription, arg)
+}
+
+func (store *SQLStore) ReplayWebhookDelivery(ctx context.Context, id int64) (WebhookDelivery, error) {
+	return audited(ctx, store, (*Queries).ReplayWebhookDelivery, id)
+}
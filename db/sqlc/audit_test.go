@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/Sinothic/simplebank/util"
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditEvents(t *testing.T) {
+	store := NewStore(testDBConnection)
+	account := createRandomAccountWithCurrency(t, util.EUR)
+	audit := AuditContext{
+		ActorID:   account.UserID,
+		Route:     "POST /admin/accounts/:id/freeze",
+		RequestID: faker.UUIDHyphenated(),
+		IP:        "203.0.113.7",
+		UserAgent: "simplebank-test",
+	}
+
+	_, err := store.FreezeAccount(WithAuditContext(context.Background(), audit), FreezeAccountParams{ID: account.ID, Reason: "suspected fraud"})
+	require.NoError(t, err)
+
+	events, err := testQueries.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		RequestID: sql.NullString{String: audit.RequestID, Valid: true},
+		PageLimit: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	event := events[0]
+	require.Equal(t, account.UserID, event.ActorID.Int64)
+	require.Equal(t, "update", event.Action)
+	require.Equal(t, "accounts", event.ResourceType)
+	require.Equal(t, strconv.FormatInt(account.ID, 10), event.ResourceID)
+	require.Equal(t, audit.Route, event.Route)
+	require.Equal(t, audit.IP, event.Ip)
+	require.Equal(t, audit.UserAgent, event.UserAgent)
+
+	var before, after Account
+	require.NoError(t, json.Unmarshal(event.Before.RawMessage, &before))
+	require.NoError(t, json.Unmarshal(event.After.RawMessage, &after))
+	require.False(t, before.FrozenAt.Valid)
+	require.Equal(t, "suspected fraud", after.FrozenReason)
+
+	// the audit events can't be changed once written
+	_, err = testDBConnection.Exec("UPDATE audit_events SET action = 'delete' WHERE id = $1", event.ID)
+	require.Error(t, err)
+	_, err = testDBConnection.Exec("DELETE FROM audit_events WHERE id = $1", event.ID)
+	require.Error(t, err)
+}
+
+func TestAuditEventsOfTransaction(t *testing.T) {
+	store := NewStore(testDBConnection)
+	from := createRandomAccountWithCurrency(t, util.USD)
+	to := createRandomAccountWithCurrency(t, util.USD)
+	audit := AuditContext{ActorID: from.UserID, Route: "POST /transfers", RequestID: faker.UUIDHyphenated()}
+
+	_, err := store.TransferTx(WithAuditContext(context.Background(), audit), TransferTxParams{FromAccountID: from.ID, ToAccountID: to.ID, Amount: 1})
+	require.NoError(t, err)
+
+	// the transfer, its two entries with their hashes and the two balance updates
+	events, err := testQueries.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		RequestID: sql.NullString{String: audit.RequestID, Valid: true},
+		PageLimit: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 7)
+	for _, event := range events {
+		require.Equal(t, from.UserID, event.ActorID.Int64)
+	}
+
+	user, err := testQueries.GetUserByID(context.Background(), from.UserID)
+	require.NoError(t, err)
+	_, err = store.UpdateUserRole(WithAuditContext(context.Background(), audit), UpdateUserRoleParams{ID: user.ID, Role: util.UserRoleSupport})
+	require.NoError(t, err)
+
+	events, err = testQueries.ListAuditEvents(context.Background(), ListAuditEventsParams{
+		ResourceType: sql.NullString{String: "users", Valid: true},
+		ResourceID:   sql.NullString{String: strconv.FormatInt(user.ID, 10), Valid: true},
+		PageLimit:    5,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, events)
+	require.NotContains(t, string(events[0].After.RawMessage), "hashed_password")
+}
@@ -7,36 +7,36 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
 )
 
-const createEntry = `-- name: CreateEntry :one
-INSERT INTO entries (
-    account_id,
-    amount
-) VALUES (
-             $1, $2
-         ) RETURNING id, account_id, amount, created_at
+const getAccountStreamHead = `-- name: GetAccountStreamHead :one
+SELECT a.balance, COALESCE(MAX(e.id), 0)::bigint AS last_entry_id
+FROM accounts a
+LEFT JOIN entries e ON e.account_id = a.id
+WHERE a.id = $1
+GROUP BY a.id
 `
 
-type CreateEntryParams struct {
-	AccountID int64 `json:"account_id"`
-	Amount    int64 `json:"amount"`
+type GetAccountStreamHeadRow struct {
+	Balance     int64 `json:"balance"`
+	LastEntryID int64 `json:"last_entry_id"`
 }
 
-func (q *Queries) CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error) {
-	row := q.db.QueryRowContext(ctx, createEntry, arg.AccountID, arg.Amount)
-	var i Entry
+func (q *Queries) GetAccountStreamHead(ctx context.Context, accountID int64) (GetAccountStreamHeadRow, error) {
+	row := q.db.QueryRowContext(ctx, getAccountStreamHead, accountID)
+	var i GetAccountStreamHeadRow
 	err := row.Scan(
-		&i.ID,
-		&i.AccountID,
-		&i.Amount,
-		&i.CreatedAt,
+		&i.Balance,
+		&i.LastEntryID,
 	)
 	return i, err
 }
 
 const getEntry = `-- name: GetEntry :one
-SELECT id, account_id, amount, created_at FROM entries
+SELECT id, account_id, amount, created_at, transfer_id, description, reference, metadata, prev_hash, hash FROM entries
 WHERE id = $1 LIMIT 1
 `
 
@@ -48,12 +48,103 @@ func (q *Queries) GetEntry(ctx context.Context, id int64) (Entry, error) {
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.TransferID,
+		&i.Description,
+		&i.Reference,
+		&i.Metadata,
+		&i.PrevHash,
+		&i.Hash,
 	)
 	return i, err
 }
 
+const getLastEntryHash = `-- name: GetLastEntryHash :one
+SELECT hash FROM entries
+WHERE account_id = $1
+  AND id < $2
+ORDER BY id DESC
+LIMIT 1
+`
+
+type GetLastEntryHashParams struct {
+	AccountID int64 `json:"account_id"`
+	BeforeID  int64 `json:"before_id"`
+}
+
+func (q *Queries) GetLastEntryHash(ctx context.Context, arg GetLastEntryHashParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, getLastEntryHash, arg.AccountID, arg.BeforeID)
+	var hash string
+	err := row.Scan(&hash)
+	return hash, err
+}
+
+const listAccountEntriesAfter = `-- name: ListAccountEntriesAfter :many
+SELECT e.id, e.account_id, e.amount, e.created_at, e.transfer_id, e.description, e.reference, e.metadata, e.prev_hash, e.hash, (a.balance - SUM(e.amount) OVER (ORDER BY e.id DESC) + e.amount)::bigint AS balance_after
+FROM entries e
+JOIN accounts a ON a.id = e.account_id
+WHERE e.account_id = $1
+  AND e.id > $2
+ORDER BY e.id
+LIMIT $3
+`
+
+type ListAccountEntriesAfterParams struct {
+	AccountID int64 `json:"account_id"`
+	AfterID   int64 `json:"after_id"`
+	PageLimit int32 `json:"page_limit"`
+}
+
+type ListAccountEntriesAfterRow struct {
+	ID           int64           `json:"id"`
+	AccountID    int64           `json:"account_id"`
+	Amount       int64           `json:"amount"`
+	CreatedAt    time.Time       `json:"created_at"`
+	TransferID   sql.NullInt64   `json:"transfer_id"`
+	Description  string          `json:"description"`
+	Reference    string          `json:"reference"`
+	Metadata     json.RawMessage `json:"metadata"`
+	PrevHash     string          `json:"prev_hash"`
+	Hash         string          `json:"hash"`
+	BalanceAfter int64           `json:"balance_after"`
+}
+
+func (q *Queries) ListAccountEntriesAfter(ctx context.Context, arg ListAccountEntriesAfterParams) ([]ListAccountEntriesAfterRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountEntriesAfter, arg.AccountID, arg.AfterID, arg.PageLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAccountEntriesAfterRow{}
+	for rows.Next() {
+		var i ListAccountEntriesAfterRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.TransferID,
+			&i.Description,
+			&i.Reference,
+			&i.Metadata,
+			&i.PrevHash,
+			&i.Hash,
+			&i.BalanceAfter,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listEntries = `-- name: ListEntries :many
-SELECT id, account_id, amount, created_at FROM entries
+SELECT id, account_id, amount, created_at, transfer_id, description, reference, metadata, prev_hash, hash FROM entries
 WHERE account_id = $1
 ORDER BY id
 LIMIT $2
@@ -80,6 +171,12 @@ func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Ent
 			&i.AccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.TransferID,
+			&i.Description,
+			&i.Reference,
+			&i.Metadata,
+			&i.PrevHash,
+			&i.Hash,
 		); err != nil {
 			return nil, err
 		}
@@ -93,3 +190,184 @@ func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Ent
 	}
 	return items, nil
 }
+
+const listEntriesForPeriod = `-- name: ListEntriesForPeriod :many
+SELECT id, account_id, amount, created_at, transfer_id, description, reference, metadata, prev_hash, hash FROM entries
+WHERE account_id = $1
+  AND created_at >= $2
+  AND created_at < $3
+ORDER BY created_at, id
+`
+
+type ListEntriesForPeriodParams struct {
+	AccountID int64     `json:"account_id"`
+	FromTime  time.Time `json:"from_time"`
+	ToTime    time.Time `json:"to_time"`
+}
+
+func (q *Queries) ListEntriesForPeriod(ctx context.Context, arg ListEntriesForPeriodParams) ([]Entry, error) {
+	rows, err := q.db.QueryContext(ctx, listEntriesForPeriod, arg.AccountID, arg.FromTime, arg.ToTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Entry{}
+	for rows.Next() {
+		var i Entry
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.TransferID,
+			&i.Description,
+			&i.Reference,
+			&i.Metadata,
+			&i.PrevHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEntryChain = `-- name: ListEntryChain :many
+SELECT id, account_id, amount, created_at, transfer_id, description, reference, metadata, prev_hash, hash FROM entries
+WHERE ($1::bigint IS NULL OR account_id = $1::bigint)
+  AND (account_id, id) > ($2::bigint, $3::bigint)
+ORDER BY account_id, id
+LIMIT $4
+`
+
+type ListEntryChainParams struct {
+	AccountID      sql.NullInt64 `json:"account_id"`
+	AfterAccountID int64         `json:"after_account_id"`
+	AfterID        int64         `json:"after_id"`
+	PageLimit      int32         `json:"page_limit"`
+}
+
+func (q *Queries) ListEntryChain(ctx context.Context, arg ListEntryChainParams) ([]Entry, error) {
+	rows, err := q.db.QueryContext(ctx, listEntryChain,
+		arg.AccountID,
+		arg.AfterAccountID,
+		arg.AfterID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Entry{}
+	for rows.Next() {
+		var i Entry
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.TransferID,
+			&i.Description,
+			&i.Reference,
+			&i.Metadata,
+			&i.PrevHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchAccountEntries = `-- name: SearchAccountEntries :many
+SELECT id, account_id, amount, created_at, transfer_id, description, reference, metadata, prev_hash, hash FROM entries
+WHERE account_id = $1
+  AND ($2::varchar = '' OR reference = $2::varchar)
+  AND strpos(lower(description), lower($3::varchar)) > 0
+  AND metadata @> $4::jsonb
+ORDER BY id
+LIMIT $5
+OFFSET $6
+`
+
+type SearchAccountEntriesParams struct {
+	AccountID   int64           `json:"account_id"`
+	Reference   string          `json:"reference"`
+	Description string          `json:"description"`
+	Metadata    json.RawMessage `json:"metadata"`
+	PageLimit   int32           `json:"page_limit"`
+	PageOffset  int32           `json:"page_offset"`
+}
+
+func (q *Queries) SearchAccountEntries(ctx context.Context, arg SearchAccountEntriesParams) ([]Entry, error) {
+	rows, err := q.db.QueryContext(ctx, searchAccountEntries,
+		arg.AccountID,
+		arg.Reference,
+		arg.Description,
+		arg.Metadata,
+		arg.PageLimit,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Entry{}
+	for rows.Next() {
+		var i Entry
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.TransferID,
+			&i.Description,
+			&i.Reference,
+			&i.Metadata,
+			&i.PrevHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const sumEntriesSince = `-- name: SumEntriesSince :one
+SELECT COALESCE(SUM(amount), 0)::bigint AS total FROM entries
+WHERE account_id = $1
+  AND created_at >= $2
+`
+
+type SumEntriesSinceParams struct {
+	AccountID int64     `json:"account_id"`
+	Since     time.Time `json:"since"`
+}
+
+func (q *Queries) SumEntriesSince(ctx context.Context, arg SumEntriesSinceParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, sumEntriesSince, arg.AccountID, arg.Since)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
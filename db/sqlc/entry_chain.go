@@ -0,0 +1,183 @@
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// entryChainPageSize is the number of entries VerifyEntryChainTx reads at once
+const entryChainPageSize = 1000
+
+// entryContent is the part of an entry covered by its hash, the fields are encoded in this order
+type entryContent struct {
+	PrevHash    string          `json:"prev_hash"`
+	ID          int64           `json:"id"`
+	AccountID   int64           `json:"account_id"`
+	Amount      int64           `json:"amount"`
+	CreatedAt   string          `json:"created_at"`
+	TransferID  int64           `json:"transfer_id"`
+	Description string          `json:"description"`
+	Reference   string          `json:"reference"`
+	Metadata    json.RawMessage `json:"metadata"`
+}
+
+// EntryHash is the hex encoded sha256 of the content of an entry chained to the hash of
+// the previous entry of its account
+func EntryHash(prevHash string, entry Entry) (string, error) {
+	content, err := json.Marshal(entryContent{
+		PrevHash:    prevHash,
+		ID:          entry.ID,
+		AccountID:   entry.AccountID,
+		Amount:      entry.Amount,
+		CreatedAt:   entry.CreatedAt.UTC().Format(time.RFC3339Nano),
+		TransferID:  entry.TransferID.Int64,
+		Description: entry.Description,
+		Reference:   entry.Reference,
+		Metadata:    entry.Metadata,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// postEntry books an entry and chains it to the previous entry of its account. The account is locked
+// first, so the entries of an account are chained in the order of their ids. The database refuses to commit
+// an entry left without a hash
+func postEntry(ctx context.Context, q *Queries, arg CreateTransferEntryParams) (Entry, error) {
+	if len(arg.Metadata) == 0 {
+		arg.Metadata = json.RawMessage("{}")
+	}
+
+	_, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	entry, err := q.createTransferEntry(ctx, arg)
+	if err != nil {
+		return entry, err
+	}
+
+	prevHash, err := q.GetLastEntryHash(ctx, GetLastEntryHashParams{
+		AccountID: entry.AccountID,
+		BeforeID:  entry.ID,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return entry, err
+	}
+
+	hash, err := EntryHash(prevHash, entry)
+	if err != nil {
+		return entry, err
+	}
+
+	return q.setEntryHash(ctx, SetEntryHashParams{
+		ID:       entry.ID,
+		PrevHash: prevHash,
+		Hash:     hash,
+	})
+}
+
+// VerifyEntryChainTxParams limits the verification to one account, every account is verified when AccountID is 0
+type VerifyEntryChainTxParams struct {
+	AccountID int64 `json:"account_id"`
+}
+
+// BrokenEntryLink is the first entry whose hash doesn't match its content or the previous entry of its account
+type BrokenEntryLink struct {
+	EntryID   int64  `json:"entry_id"`
+	AccountID int64  `json:"account_id"`
+	Reason    string `json:"reason"`
+}
+
+// EntryChainVerification counts the entries checked until the chain broke, Broken is nil when it is intact
+type EntryChainVerification struct {
+	Accounts int64            `json:"accounts"`
+	Entries  int64            `json:"entries"`
+	Broken   *BrokenEntryLink `json:"broken,omitempty"`
+}
+
+// VerifyEntryChainTx walks the entries of every account in order and recomputes their hashes.
+// The entries booked before the chain was introduced have no hash and are skipped,
+// an entry without a hash after the first chained entry of its account breaks the chain
+func (store *SQLStore) VerifyEntryChainTx(ctx context.Context, arg VerifyEntryChainTxParams) (EntryChainVerification, error) {
+	var result EntryChainVerification
+	err := store.execReadTx(ctx, func(q *Queries) error {
+		page := ListEntryChainParams{
+			AccountID: sql.NullInt64{Int64: arg.AccountID, Valid: arg.AccountID != 0},
+			PageLimit: entryChainPageSize,
+		}
+		var chained bool
+		var prevHash string
+
+		for {
+			entries, err := q.ListEntryChain(ctx, page)
+			if err != nil {
+				return err
+			}
+
+			for _, entry := range entries {
+				if entry.AccountID != page.AfterAccountID {
+					result.Accounts++
+					chained = false
+					prevHash = ""
+				}
+				page.AfterAccountID = entry.AccountID
+				page.AfterID = entry.ID
+				result.Entries++
+
+				reason, err := brokenLink(entry, chained, prevHash)
+				if err != nil {
+					return err
+				}
+				if reason != "" {
+					result.Broken = &BrokenEntryLink{EntryID: entry.ID, AccountID: entry.AccountID, Reason: reason}
+					return nil
+				}
+
+				if entry.Hash != "" {
+					chained = true
+					prevHash = entry.Hash
+				}
+			}
+
+			if len(entries) < entryChainPageSize {
+				return nil
+			}
+		}
+	})
+	return result, err
+}
+
+// brokenLink tells why an entry doesn't follow the hash of the previous entry of its account
+func brokenLink(entry Entry, chained bool, prevHash string) (string, error) {
+	if entry.Hash == "" {
+		if chained {
+			return "entry has no hash", nil
+		}
+		return "", nil
+	}
+
+	if entry.PrevHash != prevHash {
+		return fmt.Sprintf("prev_hash %q doesn't match the hash %q of the previous entry", entry.PrevHash, prevHash), nil
+	}
+
+	hash, err := EntryHash(entry.PrevHash, entry)
+	if err != nil {
+		return "", err
+	}
+	if hash != entry.Hash {
+		return fmt.Sprintf("hash %q doesn't match the content of the entry, expected %q", entry.Hash, hash), nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,108 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntryChain(t *testing.T) {
+	store := NewStore(testDBConnection)
+	from := createRandomAccountWithCurrency(t, util.USD)
+	to := createRandomAccountWithCurrency(t, util.USD)
+
+	var results []TransferTxResult
+	for i := 0; i < 3; i++ {
+		result, err := store.TransferTx(context.Background(), TransferTxParams{FromAccountID: from.ID, ToAccountID: to.ID, Amount: 10})
+		require.NoError(t, err)
+		results = append(results, result)
+	}
+
+	prevHash := ""
+	for _, result := range results {
+		entry := result.FromEntry
+		require.Equal(t, prevHash, entry.PrevHash)
+		hash, err := EntryHash(entry.PrevHash, entry)
+		require.NoError(t, err)
+		require.Equal(t, hash, entry.Hash)
+		prevHash = entry.Hash
+
+		stored, err := testQueries.GetEntry(context.Background(), entry.ID)
+		require.NoError(t, err)
+		require.Equal(t, entry, stored)
+	}
+
+	verification, err := store.VerifyEntryChainTx(context.Background(), VerifyEntryChainTxParams{AccountID: from.ID})
+	require.NoError(t, err)
+	require.Nil(t, verification.Broken)
+	require.Equal(t, int64(1), verification.Accounts)
+	require.Equal(t, int64(3), verification.Entries)
+}
+
+func TestEntryChainTampered(t *testing.T) {
+	store := NewStore(testDBConnection)
+	from := createRandomAccountWithCurrency(t, util.EUR)
+	to := createRandomAccountWithCurrency(t, util.EUR)
+
+	var results []TransferTxResult
+	for i := 0; i < 3; i++ {
+		result, err := store.TransferTx(context.Background(), TransferTxParams{FromAccountID: from.ID, ToAccountID: to.ID, Amount: 10})
+		require.NoError(t, err)
+		results = append(results, result)
+	}
+
+	tampered := results[1].ToEntry
+	_, err := testDBConnection.Exec("UPDATE entries SET amount = amount * 100 WHERE id = $1", tampered.ID)
+	require.NoError(t, err)
+
+	verification, err := store.VerifyEntryChainTx(context.Background(), VerifyEntryChainTxParams{AccountID: to.ID})
+	require.NoError(t, err)
+	require.NotNil(t, verification.Broken)
+	require.Equal(t, tampered.ID, verification.Broken.EntryID)
+	require.Equal(t, to.ID, verification.Broken.AccountID)
+	require.Contains(t, verification.Broken.Reason, "content")
+	require.Equal(t, int64(2), verification.Entries)
+
+	// rehashing the edited entry breaks the link to the next one
+	hash, err := EntryHash(tampered.PrevHash, Entry{
+		ID:          tampered.ID,
+		AccountID:   tampered.AccountID,
+		Amount:      tampered.Amount * 100,
+		CreatedAt:   tampered.CreatedAt,
+		TransferID:  tampered.TransferID,
+		Description: tampered.Description,
+		Reference:   tampered.Reference,
+		Metadata:    tampered.Metadata,
+	})
+	require.NoError(t, err)
+	_, err = testDBConnection.Exec("UPDATE entries SET hash = $2 WHERE id = $1", tampered.ID, hash)
+	require.NoError(t, err)
+
+	verification, err = store.VerifyEntryChainTx(context.Background(), VerifyEntryChainTxParams{AccountID: to.ID})
+	require.NoError(t, err)
+	require.NotNil(t, verification.Broken)
+	require.Equal(t, results[2].ToEntry.ID, verification.Broken.EntryID)
+	require.Contains(t, verification.Broken.Reason, "previous entry")
+}
+
+func TestEntryWithoutHash(t *testing.T) {
+	store := NewStore(testDBConnection)
+	from := createRandomAccountWithCurrency(t, util.USD)
+	to := createRandomAccountWithCurrency(t, util.USD)
+
+	_, err := testDBConnection.Exec("INSERT INTO entries (account_id, amount) VALUES ($1, 10)", from.ID)
+	require.ErrorContains(t, err, "has no hash")
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{FromAccountID: from.ID, ToAccountID: to.ID, Amount: 10})
+	require.NoError(t, err)
+
+	_, err = testDBConnection.Exec("UPDATE entries SET hash = '' WHERE id = $1", result.FromEntry.ID)
+	require.ErrorContains(t, err, "has no hash")
+
+	entries, err := testQueries.ListEntries(context.Background(), ListEntriesParams{AccountID: from.ID, Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, result.FromEntry, entries[0])
+}
@@ -7,36 +7,47 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestCreateEntry(t *testing.T) {
+// postTestEntry posts an entry in its own transaction, an entry is hashed before the commit
+func postTestEntry(t *testing.T, arg CreateTransferEntryParams) Entry {
+	store := NewStore(testDBConnection).(*SQLStore)
+
+	var entry Entry
+	err := store.execTx(context.Background(), func(q *Queries) error {
+		var err error
+		entry, err = postEntry(context.Background(), q, arg)
+		return err
+	})
+	require.NoError(t, err)
+	return entry
+}
+
+func TestPostEntry(t *testing.T) {
 	account := createRandomAccount(t)
 
-	arg := CreateEntryParams{
+	arg := CreateTransferEntryParams{
 		AccountID: account.ID,
 		Amount:    10,
 	}
 
-	updatedEntry, err := testQueries.CreateEntry(context.Background(), arg)
-	require.NoError(t, err)
-	require.NotEmpty(t, updatedEntry)
+	postedEntry := postTestEntry(t, arg)
+	require.NotEmpty(t, postedEntry)
+	require.NotEmpty(t, postedEntry.Hash)
 
-	entry, err := testQueries.GetEntry(context.Background(), updatedEntry.ID)
+	entry, err := testQueries.GetEntry(context.Background(), postedEntry.ID)
 	require.NoError(t, err)
 	require.NotEmpty(t, entry)
-	require.Equal(t, updatedEntry, entry)
+	require.Equal(t, postedEntry, entry)
 }
 
 func TestListEntries(t *testing.T) {
 	account := createRandomAccount(t)
 
-	arg := CreateEntryParams{
+	arg := CreateTransferEntryParams{
 		AccountID: account.ID,
 		Amount:    10,
 	}
-	_, err := testQueries.CreateEntry(context.Background(), arg)
-	require.NoError(t, err)
-
-	_, err = testQueries.CreateEntry(context.Background(), arg)
-	require.NoError(t, err)
+	postTestEntry(t, arg)
+	postTestEntry(t, arg)
 
 	entries, err := testQueries.ListEntries(context.Background(), ListEntriesParams{
 		AccountID: account.ID,
@@ -46,3 +57,43 @@ func TestListEntries(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, entries, 2)
 }
+
+func TestListAccountEntriesAfter(t *testing.T) {
+	store := NewStore(testDBConnection)
+	from := createRandomAccount(t)
+	to := createRandomAccountWithCurrency(t, from.Currency)
+
+	head, err := testQueries.GetAccountStreamHead(context.Background(), to.ID)
+	require.NoError(t, err)
+	require.Equal(t, to.Balance, head.Balance)
+	require.Zero(t, head.LastEntryID)
+
+	for _, amount := range []int64{10, 20, 30} {
+		_, err := store.TransferTx(context.Background(), TransferTxParams{FromAccountID: from.ID, ToAccountID: to.ID, Amount: amount})
+		require.NoError(t, err)
+	}
+
+	entries, err := testQueries.ListAccountEntriesAfter(context.Background(), ListAccountEntriesAfterParams{
+		AccountID: to.ID,
+		PageLimit: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, to.Balance+10, entries[0].BalanceAfter)
+	require.Equal(t, to.Balance+30, entries[1].BalanceAfter)
+
+	entries, err = testQueries.ListAccountEntriesAfter(context.Background(), ListAccountEntriesAfterParams{
+		AccountID: to.ID,
+		AfterID:   entries[1].ID,
+		PageLimit: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, int64(30), entries[0].Amount)
+	require.Equal(t, to.Balance+60, entries[0].BalanceAfter)
+
+	head, err = testQueries.GetAccountStreamHead(context.Background(), to.ID)
+	require.NoError(t, err)
+	require.Equal(t, to.Balance+60, head.Balance)
+	require.Equal(t, entries[0].ID, head.LastEntryID)
+}
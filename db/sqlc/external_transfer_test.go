@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Sinothic/simplebank/util"
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositExternalTransferTx(t *testing.T) {
+	store := NewStore(testDBConnection)
+	account := createRandomAccountWithCurrency(t, util.USD)
+
+	started, err := store.StartExternalTransferTx(context.Background(), StartExternalTransferTxParams{
+		AccountID: account.ID,
+		Direction: util.DirectionDeposit,
+		Amount:    40,
+		Rail:      "fake",
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.PaymentStatusPending, started.ExternalTransfer.Status)
+	require.Equal(t, account.Balance, started.Account.Balance)
+	require.Zero(t, started.AccountEntry.ID)
+
+	completed, err := store.CompleteExternalTransferTx(context.Background(), CompleteExternalTransferTxParams{
+		ID:                started.ExternalTransfer.ID,
+		ExternalReference: faker.UUIDDigit(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.PaymentStatusCompleted, completed.ExternalTransfer.Status)
+	require.True(t, completed.ExternalTransfer.ExternalReference.Valid)
+	require.Equal(t, account.Balance+40, completed.Account.Balance)
+	require.Equal(t, int64(40), completed.AccountEntry.Amount)
+	require.Equal(t, int64(-40), completed.ClearingEntry.Amount)
+	require.Equal(t, started.ExternalTransfer.ClearingAccountID, completed.ClearingEntry.AccountID)
+
+	_, err = store.FailExternalTransferTx(context.Background(), FailExternalTransferTxParams{
+		ID:            started.ExternalTransfer.ID,
+		FailureReason: "too late",
+	})
+	require.ErrorIs(t, err, ErrExternalTransferNotPending)
+}
+
+func TestWithdrawalExternalTransferTx(t *testing.T) {
+	store := NewStore(testDBConnection)
+	account := createRandomAccountWithCurrency(t, util.USD)
+
+	_, err := store.StartExternalTransferTx(context.Background(), StartExternalTransferTxParams{
+		AccountID: account.ID,
+		Direction: util.DirectionWithdrawal,
+		Amount:    account.Balance + 1,
+		Rail:      "fake",
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	started, err := store.StartExternalTransferTx(context.Background(), StartExternalTransferTxParams{
+		AccountID: account.ID,
+		Direction: util.DirectionWithdrawal,
+		Amount:    account.Balance,
+		Rail:      "fake",
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.PaymentStatusPending, started.ExternalTransfer.Status)
+	require.Zero(t, started.Account.Balance)
+	require.Equal(t, -account.Balance, started.AccountEntry.Amount)
+
+	failed, err := store.FailExternalTransferTx(context.Background(), FailExternalTransferTxParams{
+		ID:            started.ExternalTransfer.ID,
+		FailureReason: "beneficiary bank unreachable",
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.PaymentStatusFailed, failed.ExternalTransfer.Status)
+	require.Equal(t, "beneficiary bank unreachable", failed.ExternalTransfer.FailureReason)
+	require.Equal(t, account.Balance, failed.Account.Balance)
+	require.Equal(t, account.Balance, failed.AccountEntry.Amount)
+
+	transfers, err := store.ListExternalTransfers(context.Background(), ListExternalTransfersParams{
+		AccountID: account.ID,
+		Limit:     5,
+		Offset:    0,
+	})
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+}
@@ -0,0 +1,234 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Sinothic/simplebank/util"
+)
+
+// ErrInsufficientFunds is returned when an account cannot cover a debit
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// ErrExternalTransferNotPending is returned when settling an external transfer that already settled
+var ErrExternalTransferNotPending = errors.New("external transfer is not pending")
+
+// StartExternalTransferTxParams contains the input parameters of the start external transfer transaction
+type StartExternalTransferTxParams struct {
+	AccountID int64  `json:"account_id"`
+	Direction string `json:"direction"`
+	Amount    int64  `json:"amount"`
+	Rail      string `json:"rail"`
+}
+
+// CompleteExternalTransferTxParams contains the input parameters of the complete external transfer transaction
+type CompleteExternalTransferTxParams struct {
+	ID                int64  `json:"id"`
+	ExternalReference string `json:"external_reference"`
+}
+
+// FailExternalTransferTxParams contains the input parameters of the fail external transfer transaction
+type FailExternalTransferTxParams struct {
+	ID                int64  `json:"id"`
+	ExternalReference string `json:"external_reference"`
+	FailureReason     string `json:"failure_reason"`
+}
+
+// ExternalTransferTxResult is the result of the external transfer transactions,
+// the entries are empty when the transaction did not move any money
+type ExternalTransferTxResult struct {
+	ExternalTransfer ExternalTransfer `json:"external_transfer"`
+	Account          Account          `json:"account"`
+	AccountEntry     Entry            `json:"account_entry"`
+	ClearingEntry    Entry            `json:"clearing_entry"`
+}
+
+// StartExternalTransferTx records a pending deposit or withdrawal against the clearing account
+// of the account currency. Withdrawals are debited right away so the money can't be spent twice
+// while the payment rail processes them
+func (store *SQLStore) StartExternalTransferTx(ctx context.Context, arg StartExternalTransferTxParams) (ExternalTransferTxResult, error) {
+	var result ExternalTransferTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+		result.Account = account
+
+		if arg.Direction == util.DirectionWithdrawal && account.Balance < arg.Amount {
+			return ErrInsufficientFunds
+		}
+
+		clearing, err := q.GetInternalAccount(ctx, GetInternalAccountParams{
+			Kind:     util.AccountKindClearing,
+			Currency: account.Currency,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.ExternalTransfer, err = q.CreateExternalTransfer(ctx, CreateExternalTransferParams{
+			AccountID:         account.ID,
+			ClearingAccountID: clearing.ID,
+			Direction:         arg.Direction,
+			Amount:            arg.Amount,
+			Currency:          account.Currency,
+			Rail:              arg.Rail,
+		})
+		if err != nil {
+			return err
+		}
+
+		if arg.Direction == util.DirectionWithdrawal {
+			result.AccountEntry, result.ClearingEntry, result.Account, _, err = postEntries(ctx, q, account.ID, clearing.ID, arg.Amount)
+			if err != nil {
+				return err
+			}
+		}
+
+		return enqueueExternalTransferPosted(ctx, q, result)
+	})
+	return result, err
+}
+
+// CompleteExternalTransferTx marks a pending external transfer as completed,
+// crediting the account when it is a deposit
+func (store *SQLStore) CompleteExternalTransferTx(ctx context.Context, arg CompleteExternalTransferTxParams) (ExternalTransferTxResult, error) {
+	var result ExternalTransferTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		transfer, err := lockPendingExternalTransfer(ctx, q, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		if transfer.Direction == util.DirectionDeposit {
+			result.ClearingEntry, result.AccountEntry, _, result.Account, err = postEntries(ctx, q, transfer.ClearingAccountID, transfer.AccountID, transfer.Amount)
+			if err != nil {
+				return err
+			}
+		} else {
+			result.Account, err = q.GetAccount(ctx, transfer.AccountID)
+			if err != nil {
+				return err
+			}
+		}
+
+		result.ExternalTransfer, err = q.UpdateExternalTransferStatus(ctx, UpdateExternalTransferStatusParams{
+			ID:                transfer.ID,
+			Status:            util.PaymentStatusCompleted,
+			ExternalReference: nullString(arg.ExternalReference),
+		})
+		if err != nil {
+			return err
+		}
+
+		return enqueueExternalTransferPosted(ctx, q, result)
+	})
+	return result, err
+}
+
+// FailExternalTransferTx marks a pending external transfer as failed,
+// giving the money back to the account when it is a withdrawal
+func (store *SQLStore) FailExternalTransferTx(ctx context.Context, arg FailExternalTransferTxParams) (ExternalTransferTxResult, error) {
+	var result ExternalTransferTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		transfer, err := lockPendingExternalTransfer(ctx, q, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		if transfer.Direction == util.DirectionWithdrawal {
+			result.ClearingEntry, result.AccountEntry, _, result.Account, err = postEntries(ctx, q, transfer.ClearingAccountID, transfer.AccountID, transfer.Amount)
+			if err != nil {
+				return err
+			}
+		} else {
+			result.Account, err = q.GetAccount(ctx, transfer.AccountID)
+			if err != nil {
+				return err
+			}
+		}
+
+		result.ExternalTransfer, err = q.UpdateExternalTransferStatus(ctx, UpdateExternalTransferStatusParams{
+			ID:                transfer.ID,
+			Status:            util.PaymentStatusFailed,
+			ExternalReference: nullString(arg.ExternalReference),
+			FailureReason:     arg.FailureReason,
+		})
+		if err != nil {
+			return err
+		}
+
+		return enqueueExternalTransferPosted(ctx, q, result)
+	})
+	return result, err
+}
+
+// enqueueExternalTransferPosted writes the new status of an external transfer to the outbox
+func enqueueExternalTransferPosted(ctx context.Context, q *Queries, result ExternalTransferTxResult) error {
+	transfer := result.ExternalTransfer
+	return enqueueEvent(ctx, q, EventTypeExternalTransferPosted, 1, AggregateTypeExternalTransfer, transfer.ID, ExternalTransferPostedV1{
+		ExternalTransferID: transfer.ID,
+		AccountID:          transfer.AccountID,
+		Direction:          transfer.Direction,
+		Amount:             transfer.Amount,
+		Currency:           transfer.Currency,
+		Rail:               transfer.Rail,
+		Status:             transfer.Status,
+		ExternalReference:  transfer.ExternalReference.String,
+		FailureReason:      transfer.FailureReason,
+		Balance:            result.Account.Balance,
+		UpdatedAt:          transfer.UpdatedAt,
+	})
+}
+
+func lockPendingExternalTransfer(ctx context.Context, q *Queries, id int64) (ExternalTransfer, error) {
+	transfer, err := q.GetExternalTransferForUpdate(ctx, id)
+	if err != nil {
+		return transfer, err
+	}
+
+	if transfer.Status != util.PaymentStatusPending {
+		return transfer, fmt.Errorf("%w: %d is %s", ErrExternalTransferNotPending, transfer.ID, transfer.Status)
+	}
+	return transfer, nil
+}
+
+// postEntries moves amount from one account to another, recording an entry on each side
+func postEntries(ctx context.Context, q *Queries, fromAccountID, toAccountID, amount int64) (fromEntry Entry, toEntry Entry, fromAccount Account, toAccount Account, err error) {
+	fromEntry, err = postEntry(ctx, q, CreateTransferEntryParams{
+		AccountID: fromAccountID,
+		Amount:    -amount,
+	})
+	if err != nil {
+		return
+	}
+
+	toEntry, err = postEntry(ctx, q, CreateTransferEntryParams{
+		AccountID: toAccountID,
+		Amount:    amount,
+	})
+	if err != nil {
+		return
+	}
+
+	fromAccount, err = q.addAccountBalance(ctx, AddAccountBalanceParams{
+		ID:     fromAccountID,
+		Amount: -amount,
+	})
+	if err != nil {
+		return
+	}
+
+	toAccount, err = q.addAccountBalance(ctx, AddAccountBalanceParams{
+		ID:     toAccountID,
+		Amount: amount,
+	})
+	return
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
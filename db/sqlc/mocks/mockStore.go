@@ -11,7 +11,9 @@ package mocks
 
 import (
 	context "context"
+	json "encoding/json"
 	reflect "reflect"
+	time "time"
 
 	db "github.com/Sinothic/simplebank/db/sqlc"
 	gomock "go.uber.org/mock/gomock"
@@ -41,213 +43,1948 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
-// AddAccountBalance mocks base method.
-func (m *MockStore) AddAccountBalance(ctx context.Context, arg db.AddAccountBalanceParams) (db.Account, error) {
+// AcceptAccountInvitationTx mocks base method.
+func (m *MockStore) AcceptAccountInvitationTx(ctx context.Context, invitationID int64) (db.AcceptAccountInvitationTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddAccountBalance", ctx, arg)
+	ret := m.ctrl.Call(m, "AcceptAccountInvitationTx", ctx, invitationID)
+	ret0, _ := ret[0].(db.AcceptAccountInvitationTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptAccountInvitationTx indicates an expected call of AcceptAccountInvitationTx.
+func (mr *MockStoreMockRecorder) AcceptAccountInvitationTx(ctx, invitationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptAccountInvitationTx", reflect.TypeOf((*MockStore)(nil).AcceptAccountInvitationTx), ctx, invitationID)
+}
+
+// AcceptPaymentRequestTx mocks base method.
+func (m *MockStore) AcceptPaymentRequestTx(ctx context.Context, arg db.AcceptPaymentRequestTxParams) (db.AcceptPaymentRequestTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptPaymentRequestTx", ctx, arg)
+	ret0, _ := ret[0].(db.AcceptPaymentRequestTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptPaymentRequestTx indicates an expected call of AcceptPaymentRequestTx.
+func (mr *MockStoreMockRecorder) AcceptPaymentRequestTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptPaymentRequestTx", reflect.TypeOf((*MockStore)(nil).AcceptPaymentRequestTx), ctx, arg)
+}
+
+// AccountStatementTx mocks base method.
+func (m *MockStore) AccountStatementTx(ctx context.Context, arg db.AccountStatementTxParams) (db.AccountStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountStatementTx", ctx, arg)
+	ret0, _ := ret[0].(db.AccountStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccountStatementTx indicates an expected call of AccountStatementTx.
+func (mr *MockStoreMockRecorder) AccountStatementTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountStatementTx", reflect.TypeOf((*MockStore)(nil).AccountStatementTx), ctx, arg)
+}
+
+// AdjustBalanceTx mocks base method.
+func (m *MockStore) AdjustBalanceTx(ctx context.Context, arg db.AdjustBalanceTxParams) (db.AdjustBalanceTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustBalanceTx", ctx, arg)
+	ret0, _ := ret[0].(db.AdjustBalanceTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustBalanceTx indicates an expected call of AdjustBalanceTx.
+func (mr *MockStoreMockRecorder) AdjustBalanceTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustBalanceTx", reflect.TypeOf((*MockStore)(nil).AdjustBalanceTx), ctx, arg)
+}
+
+// ApproveTransferTx mocks base method.
+func (m *MockStore) ApproveTransferTx(ctx context.Context, arg db.ApproveTransferTxParams) (db.ApproveTransferTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveTransferTx", ctx, arg)
+	ret0, _ := ret[0].(db.ApproveTransferTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveTransferTx indicates an expected call of ApproveTransferTx.
+func (mr *MockStoreMockRecorder) ApproveTransferTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveTransferTx", reflect.TypeOf((*MockStore)(nil).ApproveTransferTx), ctx, arg)
+}
+
+// ClaimDueWebhookDeliveries mocks base method.
+func (m *MockStore) ClaimDueWebhookDeliveries(ctx context.Context, arg db.ClaimDueWebhookDeliveriesParams) ([]db.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueWebhookDeliveries", ctx, arg)
+	ret0, _ := ret[0].([]db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueWebhookDeliveries indicates an expected call of ClaimDueWebhookDeliveries.
+func (mr *MockStoreMockRecorder) ClaimDueWebhookDeliveries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueWebhookDeliveries", reflect.TypeOf((*MockStore)(nil).ClaimDueWebhookDeliveries), ctx, arg)
+}
+
+// CompleteExternalTransferTx mocks base method.
+func (m *MockStore) CompleteExternalTransferTx(ctx context.Context, arg db.CompleteExternalTransferTxParams) (db.ExternalTransferTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteExternalTransferTx", ctx, arg)
+	ret0, _ := ret[0].(db.ExternalTransferTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteExternalTransferTx indicates an expected call of CompleteExternalTransferTx.
+func (mr *MockStoreMockRecorder) CompleteExternalTransferTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteExternalTransferTx", reflect.TypeOf((*MockStore)(nil).CompleteExternalTransferTx), ctx, arg)
+}
+
+// CountAchFilesCreatedSince mocks base method.
+func (m *MockStore) CountAchFilesCreatedSince(ctx context.Context, createdSince time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAchFilesCreatedSince", ctx, createdSince)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAchFilesCreatedSince indicates an expected call of CountAchFilesCreatedSince.
+func (mr *MockStoreMockRecorder) CountAchFilesCreatedSince(ctx, createdSince any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAchFilesCreatedSince", reflect.TypeOf((*MockStore)(nil).CountAchFilesCreatedSince), ctx, createdSince)
+}
+
+// CreateAccount mocks base method.
+func (m *MockStore) CreateAccount(ctx context.Context, arg db.CreateAccountParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccount", ctx, arg)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccount indicates an expected call of CreateAccount.
+func (mr *MockStoreMockRecorder) CreateAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockStore)(nil).CreateAccount), ctx, arg)
+}
+
+// CreateAccountHolder mocks base method.
+func (m *MockStore) CreateAccountHolder(ctx context.Context, arg db.CreateAccountHolderParams) (db.AccountHolder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccountHolder", ctx, arg)
+	ret0, _ := ret[0].(db.AccountHolder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccountHolder indicates an expected call of CreateAccountHolder.
+func (mr *MockStoreMockRecorder) CreateAccountHolder(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountHolder", reflect.TypeOf((*MockStore)(nil).CreateAccountHolder), ctx, arg)
+}
+
+// CreateAccountInvitation mocks base method.
+func (m *MockStore) CreateAccountInvitation(ctx context.Context, arg db.CreateAccountInvitationParams) (db.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccountInvitation", ctx, arg)
+	ret0, _ := ret[0].(db.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccountInvitation indicates an expected call of CreateAccountInvitation.
+func (mr *MockStoreMockRecorder) CreateAccountInvitation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountInvitation", reflect.TypeOf((*MockStore)(nil).CreateAccountInvitation), ctx, arg)
+}
+
+// CreateAccountTx mocks base method.
+func (m *MockStore) CreateAccountTx(ctx context.Context, arg db.CreateAccountTxParams) (db.CreateAccountTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccountTx", ctx, arg)
+	ret0, _ := ret[0].(db.CreateAccountTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccountTx indicates an expected call of CreateAccountTx.
+func (mr *MockStoreMockRecorder) CreateAccountTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountTx", reflect.TypeOf((*MockStore)(nil).CreateAccountTx), ctx, arg)
+}
+
+// CreateAchFile mocks base method.
+func (m *MockStore) CreateAchFile(ctx context.Context, arg db.CreateAchFileParams) (db.AchFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAchFile", ctx, arg)
+	ret0, _ := ret[0].(db.AchFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAchFile indicates an expected call of CreateAchFile.
+func (mr *MockStoreMockRecorder) CreateAchFile(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAchFile", reflect.TypeOf((*MockStore)(nil).CreateAchFile), ctx, arg)
+}
+
+// CreateAdminAuditLog mocks base method.
+func (m *MockStore) CreateAdminAuditLog(ctx context.Context, arg db.CreateAdminAuditLogParams) (db.AdminAuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAdminAuditLog", ctx, arg)
+	ret0, _ := ret[0].(db.AdminAuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAdminAuditLog indicates an expected call of CreateAdminAuditLog.
+func (mr *MockStoreMockRecorder) CreateAdminAuditLog(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdminAuditLog", reflect.TypeOf((*MockStore)(nil).CreateAdminAuditLog), ctx, arg)
+}
+
+// CreateBalanceAdjustment mocks base method.
+func (m *MockStore) CreateBalanceAdjustment(ctx context.Context, arg db.CreateBalanceAdjustmentParams) (db.BalanceAdjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBalanceAdjustment", ctx, arg)
+	ret0, _ := ret[0].(db.BalanceAdjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBalanceAdjustment indicates an expected call of CreateBalanceAdjustment.
+func (mr *MockStoreMockRecorder) CreateBalanceAdjustment(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBalanceAdjustment", reflect.TypeOf((*MockStore)(nil).CreateBalanceAdjustment), ctx, arg)
+}
+
+// CreateBeneficiary mocks base method.
+func (m *MockStore) CreateBeneficiary(ctx context.Context, arg db.CreateBeneficiaryParams) (db.Beneficiary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBeneficiary", ctx, arg)
+	ret0, _ := ret[0].(db.Beneficiary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBeneficiary indicates an expected call of CreateBeneficiary.
+func (mr *MockStoreMockRecorder) CreateBeneficiary(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBeneficiary", reflect.TypeOf((*MockStore)(nil).CreateBeneficiary), ctx, arg)
+}
+
+// CreateExternalTransfer mocks base method.
+func (m *MockStore) CreateExternalTransfer(ctx context.Context, arg db.CreateExternalTransferParams) (db.ExternalTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExternalTransfer", ctx, arg)
+	ret0, _ := ret[0].(db.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExternalTransfer indicates an expected call of CreateExternalTransfer.
+func (mr *MockStoreMockRecorder) CreateExternalTransfer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExternalTransfer", reflect.TypeOf((*MockStore)(nil).CreateExternalTransfer), ctx, arg)
+}
+
+// CreateOrganisation mocks base method.
+func (m *MockStore) CreateOrganisation(ctx context.Context, arg db.CreateOrganisationParams) (db.Organisation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganisation", ctx, arg)
+	ret0, _ := ret[0].(db.Organisation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganisation indicates an expected call of CreateOrganisation.
+func (mr *MockStoreMockRecorder) CreateOrganisation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganisation", reflect.TypeOf((*MockStore)(nil).CreateOrganisation), ctx, arg)
+}
+
+// CreateOrganisationAccount mocks base method.
+func (m *MockStore) CreateOrganisationAccount(ctx context.Context, arg db.CreateOrganisationAccountParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganisationAccount", ctx, arg)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganisationAccount indicates an expected call of CreateOrganisationAccount.
+func (mr *MockStoreMockRecorder) CreateOrganisationAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganisationAccount", reflect.TypeOf((*MockStore)(nil).CreateOrganisationAccount), ctx, arg)
+}
+
+// CreateOrganisationMember mocks base method.
+func (m *MockStore) CreateOrganisationMember(ctx context.Context, arg db.CreateOrganisationMemberParams) (db.OrganisationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganisationMember", ctx, arg)
+	ret0, _ := ret[0].(db.OrganisationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganisationMember indicates an expected call of CreateOrganisationMember.
+func (mr *MockStoreMockRecorder) CreateOrganisationMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganisationMember", reflect.TypeOf((*MockStore)(nil).CreateOrganisationMember), ctx, arg)
+}
+
+// CreateOrganisationTx mocks base method.
+func (m *MockStore) CreateOrganisationTx(ctx context.Context, arg db.CreateOrganisationTxParams) (db.CreateOrganisationTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganisationTx", ctx, arg)
+	ret0, _ := ret[0].(db.CreateOrganisationTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganisationTx indicates an expected call of CreateOrganisationTx.
+func (mr *MockStoreMockRecorder) CreateOrganisationTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganisationTx", reflect.TypeOf((*MockStore)(nil).CreateOrganisationTx), ctx, arg)
+}
+
+// CreateOutboundPayment mocks base method.
+func (m *MockStore) CreateOutboundPayment(ctx context.Context, arg db.CreateOutboundPaymentParams) (db.OutboundPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboundPayment", ctx, arg)
+	ret0, _ := ret[0].(db.OutboundPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOutboundPayment indicates an expected call of CreateOutboundPayment.
+func (mr *MockStoreMockRecorder) CreateOutboundPayment(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboundPayment", reflect.TypeOf((*MockStore)(nil).CreateOutboundPayment), ctx, arg)
+}
+
+// CreateOutboundPaymentTx mocks base method.
+func (m *MockStore) CreateOutboundPaymentTx(ctx context.Context, arg db.CreateOutboundPaymentTxParams) (db.OutboundPaymentTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboundPaymentTx", ctx, arg)
+	ret0, _ := ret[0].(db.OutboundPaymentTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOutboundPaymentTx indicates an expected call of CreateOutboundPaymentTx.
+func (mr *MockStoreMockRecorder) CreateOutboundPaymentTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboundPaymentTx", reflect.TypeOf((*MockStore)(nil).CreateOutboundPaymentTx), ctx, arg)
+}
+
+// CreateOutboxEvent mocks base method.
+func (m *MockStore) CreateOutboxEvent(ctx context.Context, arg db.CreateOutboxEventParams) (db.Outbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEvent", ctx, arg)
+	ret0, _ := ret[0].(db.Outbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOutboxEvent indicates an expected call of CreateOutboxEvent.
+func (mr *MockStoreMockRecorder) CreateOutboxEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEvent", reflect.TypeOf((*MockStore)(nil).CreateOutboxEvent), ctx, arg)
+}
+
+// CreatePayee mocks base method.
+func (m *MockStore) CreatePayee(ctx context.Context, arg db.CreatePayeeParams) (db.Payee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayee", ctx, arg)
+	ret0, _ := ret[0].(db.Payee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayee indicates an expected call of CreatePayee.
+func (mr *MockStoreMockRecorder) CreatePayee(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayee", reflect.TypeOf((*MockStore)(nil).CreatePayee), ctx, arg)
+}
+
+// CreatePaymentBatch mocks base method.
+func (m *MockStore) CreatePaymentBatch(ctx context.Context, arg db.CreatePaymentBatchParams) (db.PaymentBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentBatch", ctx, arg)
+	ret0, _ := ret[0].(db.PaymentBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentBatch indicates an expected call of CreatePaymentBatch.
+func (mr *MockStoreMockRecorder) CreatePaymentBatch(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentBatch", reflect.TypeOf((*MockStore)(nil).CreatePaymentBatch), ctx, arg)
+}
+
+// CreatePaymentRequest mocks base method.
+func (m *MockStore) CreatePaymentRequest(ctx context.Context, arg db.CreatePaymentRequestParams) (db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentRequest", ctx, arg)
+	ret0, _ := ret[0].(db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentRequest indicates an expected call of CreatePaymentRequest.
+func (mr *MockStoreMockRecorder) CreatePaymentRequest(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentRequest", reflect.TypeOf((*MockStore)(nil).CreatePaymentRequest), ctx, arg)
+}
+
+// CreatePot mocks base method.
+func (m *MockStore) CreatePot(ctx context.Context, arg db.CreatePotParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePot", ctx, arg)
 	ret0, _ := ret[0].(db.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddAccountBalance indicates an expected call of AddAccountBalance.
-func (mr *MockStoreMockRecorder) AddAccountBalance(ctx, arg any) *gomock.Call {
+// CreatePot indicates an expected call of CreatePot.
+func (mr *MockStoreMockRecorder) CreatePot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePot", reflect.TypeOf((*MockStore)(nil).CreatePot), ctx, arg)
+}
+
+// CreateSepaBatch mocks base method.
+func (m *MockStore) CreateSepaBatch(ctx context.Context, arg db.CreateSepaBatchParams) (db.SepaBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSepaBatch", ctx, arg)
+	ret0, _ := ret[0].(db.SepaBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSepaBatch indicates an expected call of CreateSepaBatch.
+func (mr *MockStoreMockRecorder) CreateSepaBatch(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSepaBatch", reflect.TypeOf((*MockStore)(nil).CreateSepaBatch), ctx, arg)
+}
+
+// CreateTransfer mocks base method.
+func (m *MockStore) CreateTransfer(ctx context.Context, arg db.CreateTransferParams) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransfer", ctx, arg)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransfer indicates an expected call of CreateTransfer.
+func (mr *MockStoreMockRecorder) CreateTransfer(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockStore)(nil).CreateTransfer), ctx, arg)
+}
+
+// CreateTransferApproval mocks base method.
+func (m *MockStore) CreateTransferApproval(ctx context.Context, arg db.CreateTransferApprovalParams) (db.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransferApproval", ctx, arg)
+	ret0, _ := ret[0].(db.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransferApproval indicates an expected call of CreateTransferApproval.
+func (mr *MockStoreMockRecorder) CreateTransferApproval(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransferApproval", reflect.TypeOf((*MockStore)(nil).CreateTransferApproval), ctx, arg)
+}
+
+// CreateUser mocks base method.
+func (m *MockStore) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockStoreMockRecorder) CreateUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), ctx, arg)
+}
+
+// CreateWebhookDeliveries mocks base method.
+func (m *MockStore) CreateWebhookDeliveries(ctx context.Context, arg db.CreateWebhookDeliveriesParams) ([]db.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookDeliveries", ctx, arg)
+	ret0, _ := ret[0].([]db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookDeliveries indicates an expected call of CreateWebhookDeliveries.
+func (mr *MockStoreMockRecorder) CreateWebhookDeliveries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookDeliveries", reflect.TypeOf((*MockStore)(nil).CreateWebhookDeliveries), ctx, arg)
+}
+
+// CreateWebhookSubscription mocks base method.
+func (m *MockStore) CreateWebhookSubscription(ctx context.Context, arg db.CreateWebhookSubscriptionParams) (db.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookSubscription", ctx, arg)
+	ret0, _ := ret[0].(db.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookSubscription indicates an expected call of CreateWebhookSubscription.
+func (mr *MockStoreMockRecorder) CreateWebhookSubscription(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookSubscription", reflect.TypeOf((*MockStore)(nil).CreateWebhookSubscription), ctx, arg)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockStore) DeleteAccount(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockStoreMockRecorder) DeleteAccount(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStore)(nil).DeleteAccount), ctx, id)
+}
+
+// DeleteAccountHolder mocks base method.
+func (m *MockStore) DeleteAccountHolder(ctx context.Context, arg db.DeleteAccountHolderParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccountHolder", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccountHolder indicates an expected call of DeleteAccountHolder.
+func (mr *MockStoreMockRecorder) DeleteAccountHolder(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountHolder", reflect.TypeOf((*MockStore)(nil).DeleteAccountHolder), ctx, arg)
+}
+
+// DeleteOrganisationMember mocks base method.
+func (m *MockStore) DeleteOrganisationMember(ctx context.Context, arg db.DeleteOrganisationMemberParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganisationMember", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrganisationMember indicates an expected call of DeleteOrganisationMember.
+func (mr *MockStoreMockRecorder) DeleteOrganisationMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganisationMember", reflect.TypeOf((*MockStore)(nil).DeleteOrganisationMember), ctx, arg)
+}
+
+// DeletePayee mocks base method.
+func (m *MockStore) DeletePayee(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePayee", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePayee indicates an expected call of DeletePayee.
+func (mr *MockStoreMockRecorder) DeletePayee(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePayee", reflect.TypeOf((*MockStore)(nil).DeletePayee), ctx, id)
+}
+
+// DeleteWebhookSubscription mocks base method.
+func (m *MockStore) DeleteWebhookSubscription(ctx context.Context, arg db.DeleteWebhookSubscriptionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookSubscription", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhookSubscription indicates an expected call of DeleteWebhookSubscription.
+func (mr *MockStoreMockRecorder) DeleteWebhookSubscription(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookSubscription", reflect.TypeOf((*MockStore)(nil).DeleteWebhookSubscription), ctx, arg)
+}
+
+// FailExternalTransferTx mocks base method.
+func (m *MockStore) FailExternalTransferTx(ctx context.Context, arg db.FailExternalTransferTxParams) (db.ExternalTransferTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailExternalTransferTx", ctx, arg)
+	ret0, _ := ret[0].(db.ExternalTransferTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailExternalTransferTx indicates an expected call of FailExternalTransferTx.
+func (mr *MockStoreMockRecorder) FailExternalTransferTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailExternalTransferTx", reflect.TypeOf((*MockStore)(nil).FailExternalTransferTx), ctx, arg)
+}
+
+// FreezeAccount mocks base method.
+func (m *MockStore) FreezeAccount(ctx context.Context, arg db.FreezeAccountParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreezeAccount", ctx, arg)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreezeAccount indicates an expected call of FreezeAccount.
+func (mr *MockStoreMockRecorder) FreezeAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeAccount", reflect.TypeOf((*MockStore)(nil).FreezeAccount), ctx, arg)
+}
+
+// GetAccount mocks base method.
+func (m *MockStore) GetAccount(ctx context.Context, id int64) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", ctx, id)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockStoreMockRecorder) GetAccount(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStore)(nil).GetAccount), ctx, id)
+}
+
+// GetAccountForUpdate mocks base method.
+func (m *MockStore) GetAccountForUpdate(ctx context.Context, id int64) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountForUpdate indicates an expected call of GetAccountForUpdate.
+func (mr *MockStoreMockRecorder) GetAccountForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountForUpdate", reflect.TypeOf((*MockStore)(nil).GetAccountForUpdate), ctx, id)
+}
+
+// GetAccountHolder mocks base method.
+func (m *MockStore) GetAccountHolder(ctx context.Context, arg db.GetAccountHolderParams) (db.AccountHolder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountHolder", ctx, arg)
+	ret0, _ := ret[0].(db.AccountHolder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountHolder indicates an expected call of GetAccountHolder.
+func (mr *MockStoreMockRecorder) GetAccountHolder(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountHolder", reflect.TypeOf((*MockStore)(nil).GetAccountHolder), ctx, arg)
+}
+
+// GetAccountInvitation mocks base method.
+func (m *MockStore) GetAccountInvitation(ctx context.Context, id int64) (db.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountInvitation", ctx, id)
+	ret0, _ := ret[0].(db.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountInvitation indicates an expected call of GetAccountInvitation.
+func (mr *MockStoreMockRecorder) GetAccountInvitation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountInvitation", reflect.TypeOf((*MockStore)(nil).GetAccountInvitation), ctx, id)
+}
+
+// GetAccountInvitationForUpdate mocks base method.
+func (m *MockStore) GetAccountInvitationForUpdate(ctx context.Context, id int64) (db.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountInvitationForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountInvitationForUpdate indicates an expected call of GetAccountInvitationForUpdate.
+func (mr *MockStoreMockRecorder) GetAccountInvitationForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountInvitationForUpdate", reflect.TypeOf((*MockStore)(nil).GetAccountInvitationForUpdate), ctx, id)
+}
+
+// GetAccountStreamHead mocks base method.
+func (m *MockStore) GetAccountStreamHead(ctx context.Context, accountID int64) (db.GetAccountStreamHeadRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountStreamHead", ctx, accountID)
+	ret0, _ := ret[0].(db.GetAccountStreamHeadRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountStreamHead indicates an expected call of GetAccountStreamHead.
+func (mr *MockStoreMockRecorder) GetAccountStreamHead(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountStreamHead", reflect.TypeOf((*MockStore)(nil).GetAccountStreamHead), ctx, accountID)
+}
+
+// GetBeneficiary mocks base method.
+func (m *MockStore) GetBeneficiary(ctx context.Context, id int64) (db.Beneficiary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBeneficiary", ctx, id)
+	ret0, _ := ret[0].(db.Beneficiary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBeneficiary indicates an expected call of GetBeneficiary.
+func (mr *MockStoreMockRecorder) GetBeneficiary(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBeneficiary", reflect.TypeOf((*MockStore)(nil).GetBeneficiary), ctx, id)
+}
+
+// GetEntry mocks base method.
+func (m *MockStore) GetEntry(ctx context.Context, id int64) (db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntry", ctx, id)
+	ret0, _ := ret[0].(db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntry indicates an expected call of GetEntry.
+func (mr *MockStoreMockRecorder) GetEntry(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockStore)(nil).GetEntry), ctx, id)
+}
+
+// GetExternalTransfer mocks base method.
+func (m *MockStore) GetExternalTransfer(ctx context.Context, id int64) (db.ExternalTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalTransfer", ctx, id)
+	ret0, _ := ret[0].(db.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExternalTransfer indicates an expected call of GetExternalTransfer.
+func (mr *MockStoreMockRecorder) GetExternalTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalTransfer", reflect.TypeOf((*MockStore)(nil).GetExternalTransfer), ctx, id)
+}
+
+// GetExternalTransferForUpdate mocks base method.
+func (m *MockStore) GetExternalTransferForUpdate(ctx context.Context, id int64) (db.ExternalTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalTransferForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExternalTransferForUpdate indicates an expected call of GetExternalTransferForUpdate.
+func (mr *MockStoreMockRecorder) GetExternalTransferForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalTransferForUpdate", reflect.TypeOf((*MockStore)(nil).GetExternalTransferForUpdate), ctx, id)
+}
+
+// GetInternalAccount mocks base method.
+func (m *MockStore) GetInternalAccount(ctx context.Context, arg db.GetInternalAccountParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInternalAccount", ctx, arg)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInternalAccount indicates an expected call of GetInternalAccount.
+func (mr *MockStoreMockRecorder) GetInternalAccount(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInternalAccount", reflect.TypeOf((*MockStore)(nil).GetInternalAccount), ctx, arg)
+}
+
+// GetLastEntryHash mocks base method.
+func (m *MockStore) GetLastEntryHash(ctx context.Context, arg db.GetLastEntryHashParams) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastEntryHash", ctx, arg)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastEntryHash indicates an expected call of GetLastEntryHash.
+func (mr *MockStoreMockRecorder) GetLastEntryHash(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastEntryHash", reflect.TypeOf((*MockStore)(nil).GetLastEntryHash), ctx, arg)
+}
+
+// GetNewestPayeeOfDestination mocks base method.
+func (m *MockStore) GetNewestPayeeOfDestination(ctx context.Context, arg db.GetNewestPayeeOfDestinationParams) (db.Payee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNewestPayeeOfDestination", ctx, arg)
+	ret0, _ := ret[0].(db.Payee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNewestPayeeOfDestination indicates an expected call of GetNewestPayeeOfDestination.
+func (mr *MockStoreMockRecorder) GetNewestPayeeOfDestination(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNewestPayeeOfDestination", reflect.TypeOf((*MockStore)(nil).GetNewestPayeeOfDestination), ctx, arg)
+}
+
+// GetOrganisation mocks base method.
+func (m *MockStore) GetOrganisation(ctx context.Context, id int64) (db.Organisation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganisation", ctx, id)
+	ret0, _ := ret[0].(db.Organisation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganisation indicates an expected call of GetOrganisation.
+func (mr *MockStoreMockRecorder) GetOrganisation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganisation", reflect.TypeOf((*MockStore)(nil).GetOrganisation), ctx, id)
+}
+
+// GetOrganisationMember mocks base method.
+func (m *MockStore) GetOrganisationMember(ctx context.Context, arg db.GetOrganisationMemberParams) (db.OrganisationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganisationMember", ctx, arg)
+	ret0, _ := ret[0].(db.OrganisationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganisationMember indicates an expected call of GetOrganisationMember.
+func (mr *MockStoreMockRecorder) GetOrganisationMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganisationMember", reflect.TypeOf((*MockStore)(nil).GetOrganisationMember), ctx, arg)
+}
+
+// GetOutboundPayment mocks base method.
+func (m *MockStore) GetOutboundPayment(ctx context.Context, id int64) (db.OutboundPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutboundPayment", ctx, id)
+	ret0, _ := ret[0].(db.OutboundPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutboundPayment indicates an expected call of GetOutboundPayment.
+func (mr *MockStoreMockRecorder) GetOutboundPayment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutboundPayment", reflect.TypeOf((*MockStore)(nil).GetOutboundPayment), ctx, id)
+}
+
+// GetOutboundPaymentByTraceNumberForUpdate mocks base method.
+func (m *MockStore) GetOutboundPaymentByTraceNumberForUpdate(ctx context.Context, arg db.GetOutboundPaymentByTraceNumberForUpdateParams) (db.OutboundPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutboundPaymentByTraceNumberForUpdate", ctx, arg)
+	ret0, _ := ret[0].(db.OutboundPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutboundPaymentByTraceNumberForUpdate indicates an expected call of GetOutboundPaymentByTraceNumberForUpdate.
+func (mr *MockStoreMockRecorder) GetOutboundPaymentByTraceNumberForUpdate(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutboundPaymentByTraceNumberForUpdate", reflect.TypeOf((*MockStore)(nil).GetOutboundPaymentByTraceNumberForUpdate), ctx, arg)
+}
+
+// GetOutboxEvent mocks base method.
+func (m *MockStore) GetOutboxEvent(ctx context.Context, id int64) (db.Outbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutboxEvent", ctx, id)
+	ret0, _ := ret[0].(db.Outbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutboxEvent indicates an expected call of GetOutboxEvent.
+func (mr *MockStoreMockRecorder) GetOutboxEvent(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutboxEvent", reflect.TypeOf((*MockStore)(nil).GetOutboxEvent), ctx, id)
+}
+
+// GetPayee mocks base method.
+func (m *MockStore) GetPayee(ctx context.Context, id int64) (db.Payee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayee", ctx, id)
+	ret0, _ := ret[0].(db.Payee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayee indicates an expected call of GetPayee.
+func (mr *MockStoreMockRecorder) GetPayee(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayee", reflect.TypeOf((*MockStore)(nil).GetPayee), ctx, id)
+}
+
+// GetPaymentBatch mocks base method.
+func (m *MockStore) GetPaymentBatch(ctx context.Context, arg db.GetPaymentBatchParams) (db.PaymentBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentBatch", ctx, arg)
+	ret0, _ := ret[0].(db.PaymentBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentBatch indicates an expected call of GetPaymentBatch.
+func (mr *MockStoreMockRecorder) GetPaymentBatch(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentBatch", reflect.TypeOf((*MockStore)(nil).GetPaymentBatch), ctx, arg)
+}
+
+// GetPaymentRequest mocks base method.
+func (m *MockStore) GetPaymentRequest(ctx context.Context, id int64) (db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentRequest", ctx, id)
+	ret0, _ := ret[0].(db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentRequest indicates an expected call of GetPaymentRequest.
+func (mr *MockStoreMockRecorder) GetPaymentRequest(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentRequest", reflect.TypeOf((*MockStore)(nil).GetPaymentRequest), ctx, id)
+}
+
+// GetPaymentRequestForUpdate mocks base method.
+func (m *MockStore) GetPaymentRequestForUpdate(ctx context.Context, id int64) (db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentRequestForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentRequestForUpdate indicates an expected call of GetPaymentRequestForUpdate.
+func (mr *MockStoreMockRecorder) GetPaymentRequestForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentRequestForUpdate", reflect.TypeOf((*MockStore)(nil).GetPaymentRequestForUpdate), ctx, id)
+}
+
+// GetTransfer mocks base method.
+func (m *MockStore) GetTransfer(ctx context.Context, id int64) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfer", ctx, id)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfer indicates an expected call of GetTransfer.
+func (mr *MockStoreMockRecorder) GetTransfer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockStore)(nil).GetTransfer), ctx, id)
+}
+
+// GetTransferApproval mocks base method.
+func (m *MockStore) GetTransferApproval(ctx context.Context, id int64) (db.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferApproval", ctx, id)
+	ret0, _ := ret[0].(db.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferApproval indicates an expected call of GetTransferApproval.
+func (mr *MockStoreMockRecorder) GetTransferApproval(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferApproval", reflect.TypeOf((*MockStore)(nil).GetTransferApproval), ctx, id)
+}
+
+// GetTransferApprovalForUpdate mocks base method.
+func (m *MockStore) GetTransferApprovalForUpdate(ctx context.Context, id int64) (db.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferApprovalForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferApprovalForUpdate indicates an expected call of GetTransferApprovalForUpdate.
+func (mr *MockStoreMockRecorder) GetTransferApprovalForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferApprovalForUpdate", reflect.TypeOf((*MockStore)(nil).GetTransferApprovalForUpdate), ctx, id)
+}
+
+// GetUser mocks base method.
+func (m *MockStore) GetUser(ctx context.Context, username string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, username)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(ctx, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), ctx, username)
+}
+
+// GetUserByID mocks base method.
+func (m *MockStore) GetUserByID(ctx context.Context, id int64) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockStoreMockRecorder) GetUserByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockStore)(nil).GetUserByID), ctx, id)
+}
+
+// GetWebhookDelivery mocks base method.
+func (m *MockStore) GetWebhookDelivery(ctx context.Context, arg db.GetWebhookDeliveryParams) (db.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDelivery", ctx, arg)
+	ret0, _ := ret[0].(db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDelivery indicates an expected call of GetWebhookDelivery.
+func (mr *MockStoreMockRecorder) GetWebhookDelivery(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDelivery", reflect.TypeOf((*MockStore)(nil).GetWebhookDelivery), ctx, arg)
+}
+
+// GetWebhookDeliveryForUpdate mocks base method.
+func (m *MockStore) GetWebhookDeliveryForUpdate(ctx context.Context, id int64) (db.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDeliveryForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDeliveryForUpdate indicates an expected call of GetWebhookDeliveryForUpdate.
+func (mr *MockStoreMockRecorder) GetWebhookDeliveryForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveryForUpdate", reflect.TypeOf((*MockStore)(nil).GetWebhookDeliveryForUpdate), ctx, id)
+}
+
+// GetWebhookSubscription mocks base method.
+func (m *MockStore) GetWebhookSubscription(ctx context.Context, arg db.GetWebhookSubscriptionParams) (db.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookSubscription", ctx, arg)
+	ret0, _ := ret[0].(db.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookSubscription indicates an expected call of GetWebhookSubscription.
+func (mr *MockStoreMockRecorder) GetWebhookSubscription(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSubscription", reflect.TypeOf((*MockStore)(nil).GetWebhookSubscription), ctx, arg)
+}
+
+// GetWebhookSubscriptionByID mocks base method.
+func (m *MockStore) GetWebhookSubscriptionByID(ctx context.Context, id int64) (db.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookSubscriptionByID", ctx, id)
+	ret0, _ := ret[0].(db.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookSubscriptionByID indicates an expected call of GetWebhookSubscriptionByID.
+func (mr *MockStoreMockRecorder) GetWebhookSubscriptionByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSubscriptionByID", reflect.TypeOf((*MockStore)(nil).GetWebhookSubscriptionByID), ctx, id)
+}
+
+// ListAccountEntriesAfter mocks base method.
+func (m *MockStore) ListAccountEntriesAfter(ctx context.Context, arg db.ListAccountEntriesAfterParams) ([]db.ListAccountEntriesAfterRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountEntriesAfter", ctx, arg)
+	ret0, _ := ret[0].([]db.ListAccountEntriesAfterRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountEntriesAfter indicates an expected call of ListAccountEntriesAfter.
+func (mr *MockStoreMockRecorder) ListAccountEntriesAfter(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountEntriesAfter", reflect.TypeOf((*MockStore)(nil).ListAccountEntriesAfter), ctx, arg)
+}
+
+// ListAccountHolders mocks base method.
+func (m *MockStore) ListAccountHolders(ctx context.Context, accountID int64) ([]db.AccountHolder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountHolders", ctx, accountID)
+	ret0, _ := ret[0].([]db.AccountHolder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountHolders indicates an expected call of ListAccountHolders.
+func (mr *MockStoreMockRecorder) ListAccountHolders(ctx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountHolders", reflect.TypeOf((*MockStore)(nil).ListAccountHolders), ctx, accountID)
+}
+
+// ListAccounts mocks base method.
+func (m *MockStore) ListAccounts(ctx context.Context, arg db.ListAccountsParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccounts", ctx, arg)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccounts indicates an expected call of ListAccounts.
+func (mr *MockStoreMockRecorder) ListAccounts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockStore)(nil).ListAccounts), ctx, arg)
+}
+
+// ListAccountsWithRoles mocks base method.
+func (m *MockStore) ListAccountsWithRoles(ctx context.Context, arg db.ListAccountsWithRolesParams) ([]db.ListAccountsWithRolesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountsWithRoles", ctx, arg)
+	ret0, _ := ret[0].([]db.ListAccountsWithRolesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsWithRoles indicates an expected call of ListAccountsWithRoles.
+func (mr *MockStoreMockRecorder) ListAccountsWithRoles(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsWithRoles", reflect.TypeOf((*MockStore)(nil).ListAccountsWithRoles), ctx, arg)
+}
+
+// ListAdminAuditLogs mocks base method.
+func (m *MockStore) ListAdminAuditLogs(ctx context.Context, arg db.ListAdminAuditLogsParams) ([]db.AdminAuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdminAuditLogs", ctx, arg)
+	ret0, _ := ret[0].([]db.AdminAuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAdminAuditLogs indicates an expected call of ListAdminAuditLogs.
+func (mr *MockStoreMockRecorder) ListAdminAuditLogs(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdminAuditLogs", reflect.TypeOf((*MockStore)(nil).ListAdminAuditLogs), ctx, arg)
+}
+
+// ListAggregateOutboxEvents mocks base method.
+func (m *MockStore) ListAggregateOutboxEvents(ctx context.Context, arg db.ListAggregateOutboxEventsParams) ([]db.Outbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAggregateOutboxEvents", ctx, arg)
+	ret0, _ := ret[0].([]db.Outbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAggregateOutboxEvents indicates an expected call of ListAggregateOutboxEvents.
+func (mr *MockStoreMockRecorder) ListAggregateOutboxEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAggregateOutboxEvents", reflect.TypeOf((*MockStore)(nil).ListAggregateOutboxEvents), ctx, arg)
+}
+
+// ListAuditEvents mocks base method.
+func (m *MockStore) ListAuditEvents(ctx context.Context, arg db.ListAuditEventsParams) ([]db.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEvents", ctx, arg)
+	ret0, _ := ret[0].([]db.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockStoreMockRecorder) ListAuditEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockStore)(nil).ListAuditEvents), ctx, arg)
+}
+
+// ListBalanceAdjustments mocks base method.
+func (m *MockStore) ListBalanceAdjustments(ctx context.Context, arg db.ListBalanceAdjustmentsParams) ([]db.BalanceAdjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBalanceAdjustments", ctx, arg)
+	ret0, _ := ret[0].([]db.BalanceAdjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBalanceAdjustments indicates an expected call of ListBalanceAdjustments.
+func (mr *MockStoreMockRecorder) ListBalanceAdjustments(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBalanceAdjustments", reflect.TypeOf((*MockStore)(nil).ListBalanceAdjustments), ctx, arg)
+}
+
+// ListBeneficiaries mocks base method.
+func (m *MockStore) ListBeneficiaries(ctx context.Context, arg db.ListBeneficiariesParams) ([]db.Beneficiary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBeneficiaries", ctx, arg)
+	ret0, _ := ret[0].([]db.Beneficiary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBeneficiaries indicates an expected call of ListBeneficiaries.
+func (mr *MockStoreMockRecorder) ListBeneficiaries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBeneficiaries", reflect.TypeOf((*MockStore)(nil).ListBeneficiaries), ctx, arg)
+}
+
+// ListEntries mocks base method.
+func (m *MockStore) ListEntries(ctx context.Context, arg db.ListEntriesParams) ([]db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", ctx, arg)
+	ret0, _ := ret[0].([]db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockStoreMockRecorder) ListEntries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockStore)(nil).ListEntries), ctx, arg)
+}
+
+// ListEntriesForPeriod mocks base method.
+func (m *MockStore) ListEntriesForPeriod(ctx context.Context, arg db.ListEntriesForPeriodParams) ([]db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntriesForPeriod", ctx, arg)
+	ret0, _ := ret[0].([]db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntriesForPeriod indicates an expected call of ListEntriesForPeriod.
+func (mr *MockStoreMockRecorder) ListEntriesForPeriod(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntriesForPeriod", reflect.TypeOf((*MockStore)(nil).ListEntriesForPeriod), ctx, arg)
+}
+
+// ListEntryChain mocks base method.
+func (m *MockStore) ListEntryChain(ctx context.Context, arg db.ListEntryChainParams) ([]db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntryChain", ctx, arg)
+	ret0, _ := ret[0].([]db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntryChain indicates an expected call of ListEntryChain.
+func (mr *MockStoreMockRecorder) ListEntryChain(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntryChain", reflect.TypeOf((*MockStore)(nil).ListEntryChain), ctx, arg)
+}
+
+// ListExternalTransfers mocks base method.
+func (m *MockStore) ListExternalTransfers(ctx context.Context, arg db.ListExternalTransfersParams) ([]db.ExternalTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExternalTransfers", ctx, arg)
+	ret0, _ := ret[0].([]db.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExternalTransfers indicates an expected call of ListExternalTransfers.
+func (mr *MockStoreMockRecorder) ListExternalTransfers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExternalTransfers", reflect.TypeOf((*MockStore)(nil).ListExternalTransfers), ctx, arg)
+}
+
+// ListHeldAccounts mocks base method.
+func (m *MockStore) ListHeldAccounts(ctx context.Context, arg db.ListHeldAccountsParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHeldAccounts", ctx, arg)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHeldAccounts indicates an expected call of ListHeldAccounts.
+func (mr *MockStoreMockRecorder) ListHeldAccounts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHeldAccounts", reflect.TypeOf((*MockStore)(nil).ListHeldAccounts), ctx, arg)
+}
+
+// ListHeldAccountsByUsers mocks base method.
+func (m *MockStore) ListHeldAccountsByUsers(ctx context.Context, arg db.ListHeldAccountsByUsersParams) ([]db.ListHeldAccountsByUsersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHeldAccountsByUsers", ctx, arg)
+	ret0, _ := ret[0].([]db.ListHeldAccountsByUsersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHeldAccountsByUsers indicates an expected call of ListHeldAccountsByUsers.
+func (mr *MockStoreMockRecorder) ListHeldAccountsByUsers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHeldAccountsByUsers", reflect.TypeOf((*MockStore)(nil).ListHeldAccountsByUsers), ctx, arg)
+}
+
+// ListIncomingPaymentRequests mocks base method.
+func (m *MockStore) ListIncomingPaymentRequests(ctx context.Context, arg db.ListIncomingPaymentRequestsParams) ([]db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIncomingPaymentRequests", ctx, arg)
+	ret0, _ := ret[0].([]db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIncomingPaymentRequests indicates an expected call of ListIncomingPaymentRequests.
+func (mr *MockStoreMockRecorder) ListIncomingPaymentRequests(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIncomingPaymentRequests", reflect.TypeOf((*MockStore)(nil).ListIncomingPaymentRequests), ctx, arg)
+}
+
+// ListMemberOrganisations mocks base method.
+func (m *MockStore) ListMemberOrganisations(ctx context.Context, userID int64) ([]db.Organisation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMemberOrganisations", ctx, userID)
+	ret0, _ := ret[0].([]db.Organisation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMemberOrganisations indicates an expected call of ListMemberOrganisations.
+func (mr *MockStoreMockRecorder) ListMemberOrganisations(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberOrganisations", reflect.TypeOf((*MockStore)(nil).ListMemberOrganisations), ctx, userID)
+}
+
+// ListOrganisationAccounts mocks base method.
+func (m *MockStore) ListOrganisationAccounts(ctx context.Context, organisationID int64) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganisationAccounts", ctx, organisationID)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganisationAccounts indicates an expected call of ListOrganisationAccounts.
+func (mr *MockStoreMockRecorder) ListOrganisationAccounts(ctx, organisationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganisationAccounts", reflect.TypeOf((*MockStore)(nil).ListOrganisationAccounts), ctx, organisationID)
+}
+
+// ListOrganisationMembers mocks base method.
+func (m *MockStore) ListOrganisationMembers(ctx context.Context, organisationID int64) ([]db.OrganisationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganisationMembers", ctx, organisationID)
+	ret0, _ := ret[0].([]db.OrganisationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganisationMembers indicates an expected call of ListOrganisationMembers.
+func (mr *MockStoreMockRecorder) ListOrganisationMembers(ctx, organisationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganisationMembers", reflect.TypeOf((*MockStore)(nil).ListOrganisationMembers), ctx, organisationID)
+}
+
+// ListOutboundPayments mocks base method.
+func (m *MockStore) ListOutboundPayments(ctx context.Context, arg db.ListOutboundPaymentsParams) ([]db.OutboundPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOutboundPayments", ctx, arg)
+	ret0, _ := ret[0].([]db.OutboundPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOutboundPayments indicates an expected call of ListOutboundPayments.
+func (mr *MockStoreMockRecorder) ListOutboundPayments(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutboundPayments", reflect.TypeOf((*MockStore)(nil).ListOutboundPayments), ctx, arg)
+}
+
+// ListOutgoingPaymentRequests mocks base method.
+func (m *MockStore) ListOutgoingPaymentRequests(ctx context.Context, arg db.ListOutgoingPaymentRequestsParams) ([]db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOutgoingPaymentRequests", ctx, arg)
+	ret0, _ := ret[0].([]db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOutgoingPaymentRequests indicates an expected call of ListOutgoingPaymentRequests.
+func (mr *MockStoreMockRecorder) ListOutgoingPaymentRequests(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutgoingPaymentRequests", reflect.TypeOf((*MockStore)(nil).ListOutgoingPaymentRequests), ctx, arg)
+}
+
+// ListPayees mocks base method.
+func (m *MockStore) ListPayees(ctx context.Context, arg db.ListPayeesParams) ([]db.Payee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPayees", ctx, arg)
+	ret0, _ := ret[0].([]db.Payee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPayees indicates an expected call of ListPayees.
+func (mr *MockStoreMockRecorder) ListPayees(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPayees", reflect.TypeOf((*MockStore)(nil).ListPayees), ctx, arg)
+}
+
+// ListPendingAccountInvitations mocks base method.
+func (m *MockStore) ListPendingAccountInvitations(ctx context.Context, inviteeID int64) ([]db.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingAccountInvitations", ctx, inviteeID)
+	ret0, _ := ret[0].([]db.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingAccountInvitations indicates an expected call of ListPendingAccountInvitations.
+func (mr *MockStoreMockRecorder) ListPendingAccountInvitations(ctx, inviteeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingAccountInvitations", reflect.TypeOf((*MockStore)(nil).ListPendingAccountInvitations), ctx, inviteeID)
+}
+
+// ListPendingOutboundPaymentsForUpdate mocks base method.
+func (m *MockStore) ListPendingOutboundPaymentsForUpdate(ctx context.Context, rail string) ([]db.OutboundPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingOutboundPaymentsForUpdate", ctx, rail)
+	ret0, _ := ret[0].([]db.OutboundPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingOutboundPaymentsForUpdate indicates an expected call of ListPendingOutboundPaymentsForUpdate.
+func (mr *MockStoreMockRecorder) ListPendingOutboundPaymentsForUpdate(ctx, rail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingOutboundPaymentsForUpdate", reflect.TypeOf((*MockStore)(nil).ListPendingOutboundPaymentsForUpdate), ctx, rail)
+}
+
+// ListPendingOutboxEventsForUpdate mocks base method.
+func (m *MockStore) ListPendingOutboxEventsForUpdate(ctx context.Context, limit int32) ([]db.Outbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingOutboxEventsForUpdate", ctx, limit)
+	ret0, _ := ret[0].([]db.Outbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingOutboxEventsForUpdate indicates an expected call of ListPendingOutboxEventsForUpdate.
+func (mr *MockStoreMockRecorder) ListPendingOutboxEventsForUpdate(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingOutboxEventsForUpdate", reflect.TypeOf((*MockStore)(nil).ListPendingOutboxEventsForUpdate), ctx, limit)
+}
+
+// ListPendingTransferApprovals mocks base method.
+func (m *MockStore) ListPendingTransferApprovals(ctx context.Context, organisationID int64) ([]db.TransferApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingTransferApprovals", ctx, organisationID)
+	ret0, _ := ret[0].([]db.TransferApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingTransferApprovals indicates an expected call of ListPendingTransferApprovals.
+func (mr *MockStoreMockRecorder) ListPendingTransferApprovals(ctx, organisationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingTransferApprovals", reflect.TypeOf((*MockStore)(nil).ListPendingTransferApprovals), ctx, organisationID)
+}
+
+// ListPots mocks base method.
+func (m *MockStore) ListPots(ctx context.Context, parentID int64) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPots", ctx, parentID)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPots indicates an expected call of ListPots.
+func (mr *MockStoreMockRecorder) ListPots(ctx, parentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPots", reflect.TypeOf((*MockStore)(nil).ListPots), ctx, parentID)
+}
+
+// ListRecentTransfersByAccounts mocks base method.
+func (m *MockStore) ListRecentTransfersByAccounts(ctx context.Context, arg db.ListRecentTransfersByAccountsParams) ([]db.ListRecentTransfersByAccountsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecentTransfersByAccounts", ctx, arg)
+	ret0, _ := ret[0].([]db.ListRecentTransfersByAccountsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecentTransfersByAccounts indicates an expected call of ListRecentTransfersByAccounts.
+func (mr *MockStoreMockRecorder) ListRecentTransfersByAccounts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecentTransfersByAccounts", reflect.TypeOf((*MockStore)(nil).ListRecentTransfersByAccounts), ctx, arg)
+}
+
+// ListTransfers mocks base method.
+func (m *MockStore) ListTransfers(ctx context.Context, arg db.ListTransfersParams) ([]db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransfers", ctx, arg)
+	ret0, _ := ret[0].([]db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransfers indicates an expected call of ListTransfers.
+func (mr *MockStoreMockRecorder) ListTransfers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockStore)(nil).ListTransfers), ctx, arg)
+}
+
+// ListUsersByIDs mocks base method.
+func (m *MockStore) ListUsersByIDs(ctx context.Context, ids json.RawMessage) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsersByIDs", ctx, ids)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsersByIDs indicates an expected call of ListUsersByIDs.
+func (mr *MockStoreMockRecorder) ListUsersByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersByIDs", reflect.TypeOf((*MockStore)(nil).ListUsersByIDs), ctx, ids)
+}
+
+// ListWebhookDeliveries mocks base method.
+func (m *MockStore) ListWebhookDeliveries(ctx context.Context, arg db.ListWebhookDeliveriesParams) ([]db.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", ctx, arg)
+	ret0, _ := ret[0].([]db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockStoreMockRecorder) ListWebhookDeliveries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockStore)(nil).ListWebhookDeliveries), ctx, arg)
+}
+
+// ListWebhookSubscriptions mocks base method.
+func (m *MockStore) ListWebhookSubscriptions(ctx context.Context, userID int64) ([]db.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookSubscriptions", ctx, userID)
+	ret0, _ := ret[0].([]db.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookSubscriptions indicates an expected call of ListWebhookSubscriptions.
+func (mr *MockStoreMockRecorder) ListWebhookSubscriptions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookSubscriptions", reflect.TypeOf((*MockStore)(nil).ListWebhookSubscriptions), ctx, userID)
+}
+
+// MarkOutboundPaymentBatched mocks base method.
+func (m *MockStore) MarkOutboundPaymentBatched(ctx context.Context, arg db.MarkOutboundPaymentBatchedParams) (db.OutboundPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboundPaymentBatched", ctx, arg)
+	ret0, _ := ret[0].(db.OutboundPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkOutboundPaymentBatched indicates an expected call of MarkOutboundPaymentBatched.
+func (mr *MockStoreMockRecorder) MarkOutboundPaymentBatched(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboundPaymentBatched", reflect.TypeOf((*MockStore)(nil).MarkOutboundPaymentBatched), ctx, arg)
+}
+
+// MarkOutboundPaymentReturned mocks base method.
+func (m *MockStore) MarkOutboundPaymentReturned(ctx context.Context, arg db.MarkOutboundPaymentReturnedParams) (db.OutboundPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboundPaymentReturned", ctx, arg)
+	ret0, _ := ret[0].(db.OutboundPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkOutboundPaymentReturned indicates an expected call of MarkOutboundPaymentReturned.
+func (mr *MockStoreMockRecorder) MarkOutboundPaymentReturned(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboundPaymentReturned", reflect.TypeOf((*MockStore)(nil).MarkOutboundPaymentReturned), ctx, arg)
+}
+
+// MarkOutboundPaymentSubmitted mocks base method.
+func (m *MockStore) MarkOutboundPaymentSubmitted(ctx context.Context, arg db.MarkOutboundPaymentSubmittedParams) (db.OutboundPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboundPaymentSubmitted", ctx, arg)
+	ret0, _ := ret[0].(db.OutboundPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkOutboundPaymentSubmitted indicates an expected call of MarkOutboundPaymentSubmitted.
+func (mr *MockStoreMockRecorder) MarkOutboundPaymentSubmitted(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountBalance", reflect.TypeOf((*MockStore)(nil).AddAccountBalance), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboundPaymentSubmitted", reflect.TypeOf((*MockStore)(nil).MarkOutboundPaymentSubmitted), ctx, arg)
 }
 
-// CreateAccount mocks base method.
-func (m *MockStore) CreateAccount(ctx context.Context, arg db.CreateAccountParams) (db.Account, error) {
+// MarkOutboxEventPublished mocks base method.
+func (m *MockStore) MarkOutboxEventPublished(ctx context.Context, id int64) (db.Outbox, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAccount", ctx, arg)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "MarkOutboxEventPublished", ctx, id)
+	ret0, _ := ret[0].(db.Outbox)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateAccount indicates an expected call of CreateAccount.
-func (mr *MockStoreMockRecorder) CreateAccount(ctx, arg any) *gomock.Call {
+// MarkOutboxEventPublished indicates an expected call of MarkOutboxEventPublished.
+func (mr *MockStoreMockRecorder) MarkOutboxEventPublished(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockStore)(nil).CreateAccount), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEventPublished", reflect.TypeOf((*MockStore)(nil).MarkOutboxEventPublished), ctx, id)
 }
 
-// CreateEntry mocks base method.
-func (m *MockStore) CreateEntry(ctx context.Context, arg db.CreateEntryParams) (db.Entry, error) {
+// MarkPaymentRequestAccepted mocks base method.
+func (m *MockStore) MarkPaymentRequestAccepted(ctx context.Context, arg db.MarkPaymentRequestAcceptedParams) (db.PaymentRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEntry", ctx, arg)
-	ret0, _ := ret[0].(db.Entry)
+	ret := m.ctrl.Call(m, "MarkPaymentRequestAccepted", ctx, arg)
+	ret0, _ := ret[0].(db.PaymentRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateEntry indicates an expected call of CreateEntry.
-func (mr *MockStoreMockRecorder) CreateEntry(ctx, arg any) *gomock.Call {
+// MarkPaymentRequestAccepted indicates an expected call of MarkPaymentRequestAccepted.
+func (mr *MockStoreMockRecorder) MarkPaymentRequestAccepted(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockStore)(nil).CreateEntry), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPaymentRequestAccepted", reflect.TypeOf((*MockStore)(nil).MarkPaymentRequestAccepted), ctx, arg)
 }
 
-// CreateTransfer mocks base method.
-func (m *MockStore) CreateTransfer(ctx context.Context, arg db.CreateTransferParams) (db.Transfer, error) {
+// MarkTransferApprovalApproved mocks base method.
+func (m *MockStore) MarkTransferApprovalApproved(ctx context.Context, arg db.MarkTransferApprovalApprovedParams) (db.TransferApproval, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTransfer", ctx, arg)
-	ret0, _ := ret[0].(db.Transfer)
+	ret := m.ctrl.Call(m, "MarkTransferApprovalApproved", ctx, arg)
+	ret0, _ := ret[0].(db.TransferApproval)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTransfer indicates an expected call of CreateTransfer.
-func (mr *MockStoreMockRecorder) CreateTransfer(ctx, arg any) *gomock.Call {
+// MarkTransferApprovalApproved indicates an expected call of MarkTransferApprovalApproved.
+func (mr *MockStoreMockRecorder) MarkTransferApprovalApproved(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockStore)(nil).CreateTransfer), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkTransferApprovalApproved", reflect.TypeOf((*MockStore)(nil).MarkTransferApprovalApproved), ctx, arg)
 }
 
-// CreateUser mocks base method.
-func (m *MockStore) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+// MovePotTx mocks base method.
+func (m *MockStore) MovePotTx(ctx context.Context, arg db.MovePotTxParams) (db.TransferTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, arg)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "MovePotTx", ctx, arg)
+	ret0, _ := ret[0].(db.TransferTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockStoreMockRecorder) CreateUser(ctx, arg any) *gomock.Call {
+// MovePotTx indicates an expected call of MovePotTx.
+func (mr *MockStoreMockRecorder) MovePotTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePotTx", reflect.TypeOf((*MockStore)(nil).MovePotTx), ctx, arg)
 }
 
-// DeleteAccount mocks base method.
-func (m *MockStore) DeleteAccount(ctx context.Context, id int64) error {
+// RecordOutboxEventFailure mocks base method.
+func (m *MockStore) RecordOutboxEventFailure(ctx context.Context, arg db.RecordOutboxEventFailureParams) (db.Outbox, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAccount", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RecordOutboxEventFailure", ctx, arg)
+	ret0, _ := ret[0].(db.Outbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteAccount indicates an expected call of DeleteAccount.
-func (mr *MockStoreMockRecorder) DeleteAccount(ctx, id any) *gomock.Call {
+// RecordOutboxEventFailure indicates an expected call of RecordOutboxEventFailure.
+func (mr *MockStoreMockRecorder) RecordOutboxEventFailure(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStore)(nil).DeleteAccount), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordOutboxEventFailure", reflect.TypeOf((*MockStore)(nil).RecordOutboxEventFailure), ctx, arg)
 }
 
-// GetAccount mocks base method.
-func (m *MockStore) GetAccount(ctx context.Context, id int64) (db.Account, error) {
+// RecordWebhookAttemptTx mocks base method.
+func (m *MockStore) RecordWebhookAttemptTx(ctx context.Context, arg db.RecordWebhookAttemptTxParams) (db.RecordWebhookAttemptTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccount", ctx, id)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "RecordWebhookAttemptTx", ctx, arg)
+	ret0, _ := ret[0].(db.RecordWebhookAttemptTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccount indicates an expected call of GetAccount.
-func (mr *MockStoreMockRecorder) GetAccount(ctx, id any) *gomock.Call {
+// RecordWebhookAttemptTx indicates an expected call of RecordWebhookAttemptTx.
+func (mr *MockStoreMockRecorder) RecordWebhookAttemptTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStore)(nil).GetAccount), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWebhookAttemptTx", reflect.TypeOf((*MockStore)(nil).RecordWebhookAttemptTx), ctx, arg)
 }
 
-// GetAccountForUpdate mocks base method.
-func (m *MockStore) GetAccountForUpdate(ctx context.Context, id int64) (db.Account, error) {
+// RecordWebhookDeliveryAttempt mocks base method.
+func (m *MockStore) RecordWebhookDeliveryAttempt(ctx context.Context, arg db.RecordWebhookDeliveryAttemptParams) (db.WebhookDelivery, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountForUpdate", ctx, id)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "RecordWebhookDeliveryAttempt", ctx, arg)
+	ret0, _ := ret[0].(db.WebhookDelivery)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountForUpdate indicates an expected call of GetAccountForUpdate.
-func (mr *MockStoreMockRecorder) GetAccountForUpdate(ctx, id any) *gomock.Call {
+// RecordWebhookDeliveryAttempt indicates an expected call of RecordWebhookDeliveryAttempt.
+func (mr *MockStoreMockRecorder) RecordWebhookDeliveryAttempt(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountForUpdate", reflect.TypeOf((*MockStore)(nil).GetAccountForUpdate), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWebhookDeliveryAttempt", reflect.TypeOf((*MockStore)(nil).RecordWebhookDeliveryAttempt), ctx, arg)
 }
 
-// GetEntry mocks base method.
-func (m *MockStore) GetEntry(ctx context.Context, id int64) (db.Entry, error) {
+// RecordWebhookSubscriptionFailure mocks base method.
+func (m *MockStore) RecordWebhookSubscriptionFailure(ctx context.Context, arg db.RecordWebhookSubscriptionFailureParams) (db.WebhookSubscription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEntry", ctx, id)
-	ret0, _ := ret[0].(db.Entry)
+	ret := m.ctrl.Call(m, "RecordWebhookSubscriptionFailure", ctx, arg)
+	ret0, _ := ret[0].(db.WebhookSubscription)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEntry indicates an expected call of GetEntry.
-func (mr *MockStoreMockRecorder) GetEntry(ctx, id any) *gomock.Call {
+// RecordWebhookSubscriptionFailure indicates an expected call of RecordWebhookSubscriptionFailure.
+func (mr *MockStoreMockRecorder) RecordWebhookSubscriptionFailure(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockStore)(nil).GetEntry), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWebhookSubscriptionFailure", reflect.TypeOf((*MockStore)(nil).RecordWebhookSubscriptionFailure), ctx, arg)
 }
 
-// GetTransfer mocks base method.
-func (m *MockStore) GetTransfer(ctx context.Context, id int64) (db.Transfer, error) {
+// RecordWebhookSubscriptionSuccess mocks base method.
+func (m *MockStore) RecordWebhookSubscriptionSuccess(ctx context.Context, id int64) (db.WebhookSubscription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransfer", ctx, id)
-	ret0, _ := ret[0].(db.Transfer)
+	ret := m.ctrl.Call(m, "RecordWebhookSubscriptionSuccess", ctx, id)
+	ret0, _ := ret[0].(db.WebhookSubscription)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTransfer indicates an expected call of GetTransfer.
-func (mr *MockStoreMockRecorder) GetTransfer(ctx, id any) *gomock.Call {
+// RecordWebhookSubscriptionSuccess indicates an expected call of RecordWebhookSubscriptionSuccess.
+func (mr *MockStoreMockRecorder) RecordWebhookSubscriptionSuccess(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockStore)(nil).GetTransfer), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWebhookSubscriptionSuccess", reflect.TypeOf((*MockStore)(nil).RecordWebhookSubscriptionSuccess), ctx, id)
 }
 
-// GetUser mocks base method.
-func (m *MockStore) GetUser(ctx context.Context, username string) (db.User, error) {
+// RejectPendingTransferApproval mocks base method.
+func (m *MockStore) RejectPendingTransferApproval(ctx context.Context, arg db.RejectPendingTransferApprovalParams) (db.TransferApproval, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", ctx, username)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "RejectPendingTransferApproval", ctx, arg)
+	ret0, _ := ret[0].(db.TransferApproval)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockStoreMockRecorder) GetUser(ctx, username any) *gomock.Call {
+// RejectPendingTransferApproval indicates an expected call of RejectPendingTransferApproval.
+func (mr *MockStoreMockRecorder) RejectPendingTransferApproval(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), ctx, username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectPendingTransferApproval", reflect.TypeOf((*MockStore)(nil).RejectPendingTransferApproval), ctx, arg)
 }
 
-// ListAccounts mocks base method.
-func (m *MockStore) ListAccounts(ctx context.Context, arg db.ListAccountsParams) ([]db.Account, error) {
+// RelayOutboxTx mocks base method.
+func (m *MockStore) RelayOutboxTx(ctx context.Context, arg db.RelayOutboxTxParams) (db.RelayOutboxTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAccounts", ctx, arg)
-	ret0, _ := ret[0].([]db.Account)
+	ret := m.ctrl.Call(m, "RelayOutboxTx", ctx, arg)
+	ret0, _ := ret[0].(db.RelayOutboxTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAccounts indicates an expected call of ListAccounts.
-func (mr *MockStoreMockRecorder) ListAccounts(ctx, arg any) *gomock.Call {
+// RelayOutboxTx indicates an expected call of RelayOutboxTx.
+func (mr *MockStoreMockRecorder) RelayOutboxTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockStore)(nil).ListAccounts), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayOutboxTx", reflect.TypeOf((*MockStore)(nil).RelayOutboxTx), ctx, arg)
 }
 
-// ListEntries mocks base method.
-func (m *MockStore) ListEntries(ctx context.Context, arg db.ListEntriesParams) ([]db.Entry, error) {
+// ReplayWebhookDelivery mocks base method.
+func (m *MockStore) ReplayWebhookDelivery(ctx context.Context, id int64) (db.WebhookDelivery, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEntries", ctx, arg)
+	ret := m.ctrl.Call(m, "ReplayWebhookDelivery", ctx, id)
+	ret0, _ := ret[0].(db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayWebhookDelivery indicates an expected call of ReplayWebhookDelivery.
+func (mr *MockStoreMockRecorder) ReplayWebhookDelivery(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayWebhookDelivery", reflect.TypeOf((*MockStore)(nil).ReplayWebhookDelivery), ctx, id)
+}
+
+// ReturnOutboundPaymentTx mocks base method.
+func (m *MockStore) ReturnOutboundPaymentTx(ctx context.Context, arg db.ReturnOutboundPaymentTxParams) (db.OutboundPaymentTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReturnOutboundPaymentTx", ctx, arg)
+	ret0, _ := ret[0].(db.OutboundPaymentTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReturnOutboundPaymentTx indicates an expected call of ReturnOutboundPaymentTx.
+func (mr *MockStoreMockRecorder) ReturnOutboundPaymentTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnOutboundPaymentTx", reflect.TypeOf((*MockStore)(nil).ReturnOutboundPaymentTx), ctx, arg)
+}
+
+// SearchAccountEntries mocks base method.
+func (m *MockStore) SearchAccountEntries(ctx context.Context, arg db.SearchAccountEntriesParams) ([]db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAccountEntries", ctx, arg)
 	ret0, _ := ret[0].([]db.Entry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEntries indicates an expected call of ListEntries.
-func (mr *MockStoreMockRecorder) ListEntries(ctx, arg any) *gomock.Call {
+// SearchAccountEntries indicates an expected call of SearchAccountEntries.
+func (mr *MockStoreMockRecorder) SearchAccountEntries(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockStore)(nil).ListEntries), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccountEntries", reflect.TypeOf((*MockStore)(nil).SearchAccountEntries), ctx, arg)
 }
 
-// ListTransfers mocks base method.
-func (m *MockStore) ListTransfers(ctx context.Context, arg db.ListTransfersParams) ([]db.Transfer, error) {
+// SearchAccountTransfers mocks base method.
+func (m *MockStore) SearchAccountTransfers(ctx context.Context, arg db.SearchAccountTransfersParams) ([]db.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTransfers", ctx, arg)
+	ret := m.ctrl.Call(m, "SearchAccountTransfers", ctx, arg)
 	ret0, _ := ret[0].([]db.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTransfers indicates an expected call of ListTransfers.
-func (mr *MockStoreMockRecorder) ListTransfers(ctx, arg any) *gomock.Call {
+// SearchAccountTransfers indicates an expected call of SearchAccountTransfers.
+func (mr *MockStoreMockRecorder) SearchAccountTransfers(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockStore)(nil).ListTransfers), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccountTransfers", reflect.TypeOf((*MockStore)(nil).SearchAccountTransfers), ctx, arg)
+}
+
+// SearchAccounts mocks base method.
+func (m *MockStore) SearchAccounts(ctx context.Context, arg db.SearchAccountsParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAccounts", ctx, arg)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAccounts indicates an expected call of SearchAccounts.
+func (mr *MockStoreMockRecorder) SearchAccounts(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccounts", reflect.TypeOf((*MockStore)(nil).SearchAccounts), ctx, arg)
+}
+
+// SearchUsers mocks base method.
+func (m *MockStore) SearchUsers(ctx context.Context, arg db.SearchUsersParams) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsers", ctx, arg)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUsers indicates an expected call of SearchUsers.
+func (mr *MockStoreMockRecorder) SearchUsers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockStore)(nil).SearchUsers), ctx, arg)
+}
+
+// SetAuditContext mocks base method.
+func (m *MockStore) SetAuditContext(ctx context.Context, arg db.SetAuditContextParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAuditContext", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAuditContext indicates an expected call of SetAuditContext.
+func (mr *MockStoreMockRecorder) SetAuditContext(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAuditContext", reflect.TypeOf((*MockStore)(nil).SetAuditContext), ctx, arg)
+}
+
+// StartExternalTransferTx mocks base method.
+func (m *MockStore) StartExternalTransferTx(ctx context.Context, arg db.StartExternalTransferTxParams) (db.ExternalTransferTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartExternalTransferTx", ctx, arg)
+	ret0, _ := ret[0].(db.ExternalTransferTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartExternalTransferTx indicates an expected call of StartExternalTransferTx.
+func (mr *MockStoreMockRecorder) StartExternalTransferTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartExternalTransferTx", reflect.TypeOf((*MockStore)(nil).StartExternalTransferTx), ctx, arg)
+}
+
+// SubmitOutboundPaymentsTx mocks base method.
+func (m *MockStore) SubmitOutboundPaymentsTx(ctx context.Context, arg db.SubmitOutboundPaymentsTxParams) (db.SubmitOutboundPaymentsTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitOutboundPaymentsTx", ctx, arg)
+	ret0, _ := ret[0].(db.SubmitOutboundPaymentsTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitOutboundPaymentsTx indicates an expected call of SubmitOutboundPaymentsTx.
+func (mr *MockStoreMockRecorder) SubmitOutboundPaymentsTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitOutboundPaymentsTx", reflect.TypeOf((*MockStore)(nil).SubmitOutboundPaymentsTx), ctx, arg)
+}
+
+// SubmitSEPAPaymentsTx mocks base method.
+func (m *MockStore) SubmitSEPAPaymentsTx(ctx context.Context, arg db.SubmitSEPAPaymentsTxParams) (db.SubmitSEPAPaymentsTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitSEPAPaymentsTx", ctx, arg)
+	ret0, _ := ret[0].(db.SubmitSEPAPaymentsTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitSEPAPaymentsTx indicates an expected call of SubmitSEPAPaymentsTx.
+func (mr *MockStoreMockRecorder) SubmitSEPAPaymentsTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitSEPAPaymentsTx", reflect.TypeOf((*MockStore)(nil).SubmitSEPAPaymentsTx), ctx, arg)
+}
+
+// SumEntriesSince mocks base method.
+func (m *MockStore) SumEntriesSince(ctx context.Context, arg db.SumEntriesSinceParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumEntriesSince", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumEntriesSince indicates an expected call of SumEntriesSince.
+func (mr *MockStoreMockRecorder) SumEntriesSince(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumEntriesSince", reflect.TypeOf((*MockStore)(nil).SumEntriesSince), ctx, arg)
+}
+
+// SumPotBalances mocks base method.
+func (m *MockStore) SumPotBalances(ctx context.Context, parentID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumPotBalances", ctx, parentID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumPotBalances indicates an expected call of SumPotBalances.
+func (mr *MockStoreMockRecorder) SumPotBalances(ctx, parentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumPotBalances", reflect.TypeOf((*MockStore)(nil).SumPotBalances), ctx, parentID)
 }
 
 // TransferTx mocks base method.
@@ -265,17 +2002,167 @@ func (mr *MockStoreMockRecorder) TransferTx(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferTx", reflect.TypeOf((*MockStore)(nil).TransferTx), ctx, arg)
 }
 
-// UpdateAccount mocks base method.
-func (m *MockStore) UpdateAccount(ctx context.Context, arg db.UpdateAccountParams) (db.Account, error) {
+// UnfreezeAccount mocks base method.
+func (m *MockStore) UnfreezeAccount(ctx context.Context, id int64) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfreezeAccount", ctx, id)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfreezeAccount indicates an expected call of UnfreezeAccount.
+func (mr *MockStoreMockRecorder) UnfreezeAccount(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfreezeAccount", reflect.TypeOf((*MockStore)(nil).UnfreezeAccount), ctx, id)
+}
+
+// UpdateExternalTransferStatus mocks base method.
+func (m *MockStore) UpdateExternalTransferStatus(ctx context.Context, arg db.UpdateExternalTransferStatusParams) (db.ExternalTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExternalTransferStatus", ctx, arg)
+	ret0, _ := ret[0].(db.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateExternalTransferStatus indicates an expected call of UpdateExternalTransferStatus.
+func (mr *MockStoreMockRecorder) UpdateExternalTransferStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExternalTransferStatus", reflect.TypeOf((*MockStore)(nil).UpdateExternalTransferStatus), ctx, arg)
+}
+
+// UpdateOrganisationMember mocks base method.
+func (m *MockStore) UpdateOrganisationMember(ctx context.Context, arg db.UpdateOrganisationMemberParams) (db.OrganisationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrganisationMember", ctx, arg)
+	ret0, _ := ret[0].(db.OrganisationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrganisationMember indicates an expected call of UpdateOrganisationMember.
+func (mr *MockStoreMockRecorder) UpdateOrganisationMember(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganisationMember", reflect.TypeOf((*MockStore)(nil).UpdateOrganisationMember), ctx, arg)
+}
+
+// UpdatePayeeNickname mocks base method.
+func (m *MockStore) UpdatePayeeNickname(ctx context.Context, arg db.UpdatePayeeNicknameParams) (db.Payee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePayeeNickname", ctx, arg)
+	ret0, _ := ret[0].(db.Payee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePayeeNickname indicates an expected call of UpdatePayeeNickname.
+func (mr *MockStoreMockRecorder) UpdatePayeeNickname(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayeeNickname", reflect.TypeOf((*MockStore)(nil).UpdatePayeeNickname), ctx, arg)
+}
+
+// UpdatePaymentBatchResult mocks base method.
+func (m *MockStore) UpdatePaymentBatchResult(ctx context.Context, arg db.UpdatePaymentBatchResultParams) (db.PaymentBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaymentBatchResult", ctx, arg)
+	ret0, _ := ret[0].(db.PaymentBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePaymentBatchResult indicates an expected call of UpdatePaymentBatchResult.
+func (mr *MockStoreMockRecorder) UpdatePaymentBatchResult(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentBatchResult", reflect.TypeOf((*MockStore)(nil).UpdatePaymentBatchResult), ctx, arg)
+}
+
+// UpdatePendingAccountInvitationStatus mocks base method.
+func (m *MockStore) UpdatePendingAccountInvitationStatus(ctx context.Context, arg db.UpdatePendingAccountInvitationStatusParams) (db.AccountInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePendingAccountInvitationStatus", ctx, arg)
+	ret0, _ := ret[0].(db.AccountInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePendingAccountInvitationStatus indicates an expected call of UpdatePendingAccountInvitationStatus.
+func (mr *MockStoreMockRecorder) UpdatePendingAccountInvitationStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePendingAccountInvitationStatus", reflect.TypeOf((*MockStore)(nil).UpdatePendingAccountInvitationStatus), ctx, arg)
+}
+
+// UpdatePendingPaymentRequestStatus mocks base method.
+func (m *MockStore) UpdatePendingPaymentRequestStatus(ctx context.Context, arg db.UpdatePendingPaymentRequestStatusParams) (db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePendingPaymentRequestStatus", ctx, arg)
+	ret0, _ := ret[0].(db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePendingPaymentRequestStatus indicates an expected call of UpdatePendingPaymentRequestStatus.
+func (mr *MockStoreMockRecorder) UpdatePendingPaymentRequestStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePendingPaymentRequestStatus", reflect.TypeOf((*MockStore)(nil).UpdatePendingPaymentRequestStatus), ctx, arg)
+}
+
+// UpdatePot mocks base method.
+func (m *MockStore) UpdatePot(ctx context.Context, arg db.UpdatePotParams) (db.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAccount", ctx, arg)
+	ret := m.ctrl.Call(m, "UpdatePot", ctx, arg)
 	ret0, _ := ret[0].(db.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateAccount indicates an expected call of UpdateAccount.
-func (mr *MockStoreMockRecorder) UpdateAccount(ctx, arg any) *gomock.Call {
+// UpdatePot indicates an expected call of UpdatePot.
+func (mr *MockStoreMockRecorder) UpdatePot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePot", reflect.TypeOf((*MockStore)(nil).UpdatePot), ctx, arg)
+}
+
+// UpdateUserRole mocks base method.
+func (m *MockStore) UpdateUserRole(ctx context.Context, arg db.UpdateUserRoleParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRole", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserRole indicates an expected call of UpdateUserRole.
+func (mr *MockStoreMockRecorder) UpdateUserRole(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRole", reflect.TypeOf((*MockStore)(nil).UpdateUserRole), ctx, arg)
+}
+
+// UpdateWebhookSubscription mocks base method.
+func (m *MockStore) UpdateWebhookSubscription(ctx context.Context, arg db.UpdateWebhookSubscriptionParams) (db.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookSubscription", ctx, arg)
+	ret0, _ := ret[0].(db.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhookSubscription indicates an expected call of UpdateWebhookSubscription.
+func (mr *MockStoreMockRecorder) UpdateWebhookSubscription(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookSubscription", reflect.TypeOf((*MockStore)(nil).UpdateWebhookSubscription), ctx, arg)
+}
+
+// VerifyEntryChainTx mocks base method.
+func (m *MockStore) VerifyEntryChainTx(ctx context.Context, arg db.VerifyEntryChainTxParams) (db.EntryChainVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEntryChainTx", ctx, arg)
+	ret0, _ := ret[0].(db.EntryChainVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEntryChainTx indicates an expected call of VerifyEntryChainTx.
+func (mr *MockStoreMockRecorder) VerifyEntryChainTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockStore)(nil).UpdateAccount), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEntryChainTx", reflect.TypeOf((*MockStore)(nil).VerifyEntryChainTx), ctx, arg)
 }
@@ -5,7 +5,11 @@
 package db
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
+
+	"github.com/sqlc-dev/pqtype"
 )
 
 type Account struct {
@@ -14,6 +18,113 @@ type Account struct {
 	Balance   int64     `json:"balance"`
 	Currency  string    `json:"currency"`
 	CreatedAt time.Time `json:"created_at"`
+	// customer, pot, or the purpose of an internal bank account (suspense, ...)
+	Kind string `json:"kind"`
+	// the customer account a pot belongs to, pots are held by the holders of their parent
+	ParentID sql.NullInt64 `json:"parent_id"`
+	// name of a pot, unique among the pots of an account
+	Name string `json:"name"`
+	// optional amount the customer saves up for in a pot
+	GoalAmount sql.NullInt64 `json:"goal_amount"`
+	TargetDate sql.NullTime  `json:"target_date"`
+	// organisation owning the account, its members are authorized through their role instead of account_holders
+	OrganisationID sql.NullInt64 `json:"organisation_id"`
+	// no money can be moved out of a frozen account until it is unfrozen
+	FrozenAt     sql.NullTime `json:"frozen_at"`
+	FrozenReason string       `json:"frozen_reason"`
+}
+
+type AccountHolder struct {
+	AccountID int64 `json:"account_id"`
+	UserID    int64 `json:"user_id"`
+	// owner, co_owner or viewer, the owner is accounts.user_id
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type AccountInvitation struct {
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+	InviterID int64 `json:"inviter_id"`
+	InviteeID int64 `json:"invitee_id"`
+	// co_owner or viewer
+	Role string `json:"role"`
+	// pending, accepted, declined or revoked
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type AchFile struct {
+	ID          int64     `json:"id"`
+	FileName    string    `json:"file_name"`
+	EntryCount  int64     `json:"entry_count"`
+	TotalAmount int64     `json:"total_amount"`
+	CreatedAt   time.Time `json:"created_at"`
+	// A to Z then 0 to 9, tells apart the files sent the same day
+	FileIDModifier string `json:"file_id_modifier"`
+}
+
+type AdminAuditLog struct {
+	ID      int64 `json:"id"`
+	ActorID int64 `json:"actor_id"`
+	// method and route of the back office endpoint, e.g. POST /admin/accounts/:id/freeze
+	Action string `json:"action"`
+	// requested path with its query string
+	Path       string    `json:"path"`
+	StatusCode int32     `json:"status_code"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type AuditEvent struct {
+	ID int64 `json:"id"`
+	// authenticated user of the request, null for the changes made by the bank itself
+	ActorID sql.NullInt64 `json:"actor_id"`
+	// insert, update or delete
+	Action string `json:"action"`
+	// table of the changed row
+	ResourceType string `json:"resource_type"`
+	// primary key of the changed row, the columns of a composite key are joined with a colon
+	ResourceID string                `json:"resource_id"`
+	Before     pqtype.NullRawMessage `json:"before"`
+	After      pqtype.NullRawMessage `json:"after"`
+	// method and route of the request, e.g. POST /transfers
+	Route     string    `json:"route"`
+	RequestID string    `json:"request_id"`
+	Ip        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type BalanceAdjustment struct {
+	ID                int64 `json:"id"`
+	AccountID         int64 `json:"account_id"`
+	SuspenseAccountID int64 `json:"suspense_account_id"`
+	AccountEntryID    int64 `json:"account_entry_id"`
+	SuspenseEntryID   int64 `json:"suspense_entry_id"`
+	// credited to the account when positive, debited when negative
+	Amount        int64     `json:"amount"`
+	ReasonCode    string    `json:"reason_code"`
+	Justification string    `json:"justification"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type Beneficiary struct {
+	ID     int64  `json:"id"`
+	UserID int64  `json:"user_id"`
+	Name   string `json:"name"`
+	// ABA routing transit number, 9 digits with check digit
+	RoutingNumber string `json:"routing_number"`
+	AccountNumber string `json:"account_number"`
+	// checking or savings
+	AccountType string    `json:"account_type"`
+	CreatedAt   time.Time `json:"created_at"`
+	// USD beneficiaries are paid by ACH, EUR beneficiaries by SEPA credit transfer
+	Currency string `json:"currency"`
+	// set for EUR beneficiaries
+	Iban string `json:"iban"`
+	// set for EUR beneficiaries
+	Bic string `json:"bic"`
 }
 
 type Entry struct {
@@ -22,6 +133,146 @@ type Entry struct {
 	// can be negative or positive
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	// transfer that posted the entry, if any
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	// copied from the transfer that posted the entry
+	Description string `json:"description"`
+	// copied from the transfer that posted the entry
+	Reference string `json:"reference"`
+	// copied from the transfer that posted the entry
+	Metadata json.RawMessage `json:"metadata"`
+	// hash of the previous entry of the account, empty for the first chained entry
+	PrevHash string `json:"prev_hash"`
+	// sha256 of the content of the entry and prev_hash, empty for the entries booked before the chain
+	Hash string `json:"hash"`
+}
+
+type ExternalTransfer struct {
+	ID                int64 `json:"id"`
+	AccountID         int64 `json:"account_id"`
+	ClearingAccountID int64 `json:"clearing_account_id"`
+	// deposit or withdrawal
+	Direction string `json:"direction"`
+	// must be positive
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+	Rail     string `json:"rail"`
+	// id assigned by the payment rail
+	ExternalReference sql.NullString `json:"external_reference"`
+	// pending, completed or failed
+	Status        string    `json:"status"`
+	FailureReason string    `json:"failure_reason"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type Organisation struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	CreatedBy int64     `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type OrganisationMember struct {
+	OrganisationID int64 `json:"organisation_id"`
+	UserID         int64 `json:"user_id"`
+	// admin, initiator, approver or viewer
+	Role string `json:"role"`
+	// largest amount the member can move in one payment, no limit when null
+	TransferLimit sql.NullInt64 `json:"transfer_limit"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+type OutboundPayment struct {
+	ID                int64 `json:"id"`
+	AccountID         int64 `json:"account_id"`
+	BeneficiaryID     int64 `json:"beneficiary_id"`
+	ClearingAccountID int64 `json:"clearing_account_id"`
+	// must be positive
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+	// pending, submitted or returned
+	Status    string        `json:"status"`
+	AchFileID sql.NullInt64 `json:"ach_file_id"`
+	// NACHA entry trace number, set when the payment is written to a file
+	TraceNumber sql.NullString `json:"trace_number"`
+	// NACHA return reason code, R01 to R85
+	ReturnCode string    `json:"return_code"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	// ach or sepa
+	Rail        string        `json:"rail"`
+	SepaBatchID sql.NullInt64 `json:"sepa_batch_id"`
+}
+
+type Outbox struct {
+	ID int64 `json:"id"`
+	// AccountCreated, TransferPosted, BalanceAdjusted, ...
+	EventType string `json:"event_type"`
+	// version of the payload schema of the event type
+	EventVersion  int32           `json:"event_version"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	Payload       json.RawMessage `json:"payload"`
+	CreatedAt     time.Time       `json:"created_at"`
+	// set once the relay handed the event to the publisher
+	PublishedAt sql.NullTime `json:"published_at"`
+	// failed publications of the event
+	Attempts  int32  `json:"attempts"`
+	LastError string `json:"last_error"`
+}
+
+type Payee struct {
+	ID       int64  `json:"id"`
+	UserID   int64  `json:"user_id"`
+	Nickname string `json:"nickname"`
+	// set when the payee is an account of the bank
+	AccountID sql.NullInt64 `json:"account_id"`
+	// set when the payee is an external bank account
+	BeneficiaryID sql.NullInt64 `json:"beneficiary_id"`
+	// the target account was found and its holder matched
+	Verified  bool      `json:"verified"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type PaymentBatch struct {
+	ID     int64 `json:"id"`
+	UserID int64 `json:"user_id"`
+	// pain.001 GrpHdr/MsgId, unique per user to reject duplicated files
+	MessageID            string    `json:"message_id"`
+	NumberOfTransactions int64     `json:"number_of_transactions"`
+	AcceptedTransactions int64     `json:"accepted_transactions"`
+	RejectedTransactions int64     `json:"rejected_transactions"`
+	CreatedAt            time.Time `json:"created_at"`
+}
+
+type PaymentRequest struct {
+	ID          int64 `json:"id"`
+	RequesterID int64 `json:"requester_id"`
+	// the account credited when the request is accepted
+	RequesterAccountID int64  `json:"requester_account_id"`
+	PayerID            int64  `json:"payer_id"`
+	Amount             int64  `json:"amount"`
+	Currency           string `json:"currency"`
+	Memo               string `json:"memo"`
+	// pending, accepted, declined, cancelled or expired
+	Status string `json:"status"`
+	// set when the request is accepted
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	ExpiresAt  time.Time     `json:"expires_at"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
+}
+
+type SepaBatch struct {
+	ID int64 `json:"id"`
+	// pain.001 GrpHdr/MsgId of the batch
+	MessageID   string    `json:"message_id"`
+	FileName    string    `json:"file_name"`
+	EntryCount  int64     `json:"entry_count"`
+	TotalAmount int64     `json:"total_amount"`
+	CreatedAt   time.Time `json:"created_at"`
 }
 
 type Transfer struct {
@@ -31,6 +282,31 @@ type Transfer struct {
 	// must be positive
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	// what the transfer was for, shown to customers
+	Description string `json:"description"`
+	// reference of the integrator, used to correlate the transfer
+	Reference string `json:"reference"`
+	// flat map of string values set by the integrator
+	Metadata json.RawMessage `json:"metadata"`
+}
+
+type TransferApproval struct {
+	ID             int64           `json:"id"`
+	OrganisationID int64           `json:"organisation_id"`
+	FromAccountID  int64           `json:"from_account_id"`
+	ToAccountID    int64           `json:"to_account_id"`
+	Amount         int64           `json:"amount"`
+	Description    string          `json:"description"`
+	Reference      string          `json:"reference"`
+	Metadata       json.RawMessage `json:"metadata"`
+	InitiatorID    int64           `json:"initiator_id"`
+	// pending, approved or rejected
+	Status string `json:"status"`
+	// the member who approved or rejected the transfer, never its initiator
+	ApproverID sql.NullInt64 `json:"approver_id"`
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
 }
 
 type User struct {
@@ -41,4 +317,39 @@ type User struct {
 	Email             string    `json:"email"`
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 	CreatedAt         time.Time `json:"created_at"`
+	// customer, or support and admin for the staff using the back office
+	Role string `json:"role"`
+}
+
+type WebhookDelivery struct {
+	ID             int64 `json:"id"`
+	SubscriptionID int64 `json:"subscription_id"`
+	// outbox event delivered
+	EventID   int64           `json:"event_id"`
+	EventType string          `json:"event_type"`
+	Payload   json.RawMessage `json:"payload"`
+	// pending, succeeded or failed once the attempts are exhausted
+	Status        string    `json:"status"`
+	Attempts      int32     `json:"attempts"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	// HTTP status of the last attempt, 0 when the receiver could not be reached
+	LastStatusCode int32        `json:"last_status_code"`
+	LastError      string       `json:"last_error"`
+	DeliveredAt    sql.NullTime `json:"delivered_at"`
+	CreatedAt      time.Time    `json:"created_at"`
+}
+
+type WebhookSubscription struct {
+	ID     int64  `json:"id"`
+	UserID int64  `json:"user_id"`
+	Url    string `json:"url"`
+	// JSON array of the event types delivered to the url
+	EventTypes json.RawMessage `json:"event_types"`
+	// signs the deliveries with HMAC-SHA256
+	Secret string `json:"secret"`
+	Status string `json:"status"`
+	// failed attempts since the last successful delivery, the subscription is disabled past a limit
+	ConsecutiveFailures int32        `json:"consecutive_failures"`
+	DisabledAt          sql.NullTime `json:"disabled_at"`
+	CreatedAt           time.Time    `json:"created_at"`
 }
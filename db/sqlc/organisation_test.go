@@ -0,0 +1,116 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/Sinothic/simplebank/util"
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomOrganisation(t *testing.T) CreateOrganisationTxResult {
+	store := NewStore(testDBConnection)
+	user := createRandomAccountWithCurrency(t, util.USD).UserID
+
+	result, err := store.CreateOrganisationTx(context.Background(), CreateOrganisationTxParams{UserID: user, Name: faker.Name()})
+	require.NoError(t, err)
+	require.Equal(t, user, result.Organisation.CreatedBy)
+	require.Equal(t, user, result.Member.UserID)
+	require.Equal(t, util.OrganisationRoleAdmin, result.Member.Role)
+	require.False(t, result.Member.TransferLimit.Valid)
+
+	return result
+}
+
+func TestCreateOrganisationAccount(t *testing.T) {
+	organisation := createRandomOrganisation(t)
+	admin := organisation.Member.UserID
+
+	// the account of the organisation doesn't count against the USD account of its admin
+	account, err := testQueries.CreateOrganisationAccount(context.Background(), CreateOrganisationAccountParams{
+		UserID:         admin,
+		Currency:       util.USD,
+		OrganisationID: organisation.Organisation.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, organisation.Organisation.ID, account.OrganisationID.Int64)
+
+	_, err = testQueries.CreateOrganisationAccount(context.Background(), CreateOrganisationAccountParams{
+		UserID:         admin,
+		Currency:       util.USD,
+		OrganisationID: organisation.Organisation.ID,
+	})
+	require.Error(t, err)
+
+	accounts, err := testQueries.ListOrganisationAccounts(context.Background(), organisation.Organisation.ID)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+
+	organisations, err := testQueries.ListMemberOrganisations(context.Background(), admin)
+	require.NoError(t, err)
+	require.Len(t, organisations, 1)
+}
+
+func TestApproveTransferTx(t *testing.T) {
+	store := NewStore(testDBConnection)
+	organisation := createRandomOrganisation(t)
+	initiator := createRandomAccountWithCurrency(t, util.EUR).UserID
+
+	_, err := testQueries.CreateOrganisationMember(context.Background(), CreateOrganisationMemberParams{
+		OrganisationID: organisation.Organisation.ID,
+		UserID:         initiator,
+		Role:           util.OrganisationRoleInitiator,
+	})
+	require.NoError(t, err)
+
+	from, err := testQueries.CreateOrganisationAccount(context.Background(), CreateOrganisationAccountParams{
+		UserID:         organisation.Member.UserID,
+		Currency:       util.EUR,
+		OrganisationID: organisation.Organisation.ID,
+	})
+	require.NoError(t, err)
+	from, err = testQueries.addAccountBalance(context.Background(), AddAccountBalanceParams{ID: from.ID, Amount: 1000})
+	require.NoError(t, err)
+	to := createRandomAccountWithCurrency(t, util.EUR)
+
+	hold := func(amount int64) TransferApproval {
+		approval, err := testQueries.CreateTransferApproval(context.Background(), CreateTransferApprovalParams{
+			OrganisationID: organisation.Organisation.ID,
+			FromAccountID:  from.ID,
+			ToAccountID:    to.ID,
+			Amount:         amount,
+			Description:    "Supplier invoice",
+			Metadata:       json.RawMessage(`{}`),
+			InitiatorID:    initiator,
+		})
+		require.NoError(t, err)
+		require.Equal(t, util.TransferApprovalStatusPending, approval.Status)
+		return approval
+	}
+
+	approval := hold(600)
+	tooLarge := hold(600)
+
+	pending, err := testQueries.ListPendingTransferApprovals(context.Background(), organisation.Organisation.ID)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+
+	result, err := store.ApproveTransferTx(context.Background(), ApproveTransferTxParams{ID: approval.ID, ApproverID: organisation.Member.UserID})
+	require.NoError(t, err)
+	require.Equal(t, util.TransferApprovalStatusApproved, result.TransferApproval.Status)
+	require.Equal(t, result.Transfer.ID, result.TransferApproval.TransferID.Int64)
+	require.Equal(t, "Supplier invoice", result.Transfer.Description)
+	require.Equal(t, int64(400), result.FromAccount.Balance)
+
+	_, err = store.ApproveTransferTx(context.Background(), ApproveTransferTxParams{ID: approval.ID, ApproverID: organisation.Member.UserID})
+	require.ErrorIs(t, err, ErrTransferApprovalNotPending)
+
+	_, err = store.ApproveTransferTx(context.Background(), ApproveTransferTxParams{ID: tooLarge.ID, ApproverID: organisation.Member.UserID})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	rejected, err := testQueries.RejectPendingTransferApproval(context.Background(), RejectPendingTransferApprovalParams{ID: tooLarge.ID, ApproverID: organisation.Member.UserID})
+	require.NoError(t, err)
+	require.Equal(t, util.TransferApprovalStatusRejected, rejected.Status)
+}
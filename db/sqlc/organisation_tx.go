@@ -0,0 +1,109 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Sinothic/simplebank/util"
+)
+
+// Different types of error returned by the transfer approval transactions
+var (
+	ErrTransferApprovalNotPending = errors.New("transfer approval is not pending")
+	ErrAccountFrozen              = errors.New("account is frozen")
+)
+
+// CreateOrganisationTxParams contains the input parameters of the create organisation transaction
+type CreateOrganisationTxParams struct {
+	UserID int64  `json:"user_id"`
+	Name   string `json:"name"`
+}
+
+// CreateOrganisationTxResult is the result of the create organisation transaction
+type CreateOrganisationTxResult struct {
+	Organisation Organisation       `json:"organisation"`
+	Member       OrganisationMember `json:"member"`
+}
+
+// CreateOrganisationTx creates an organisation with the user as its first admin
+func (store *SQLStore) CreateOrganisationTx(ctx context.Context, arg CreateOrganisationTxParams) (CreateOrganisationTxResult, error) {
+	var result CreateOrganisationTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result.Organisation, err = q.CreateOrganisation(ctx, CreateOrganisationParams{
+			Name:      arg.Name,
+			CreatedBy: arg.UserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Member, err = q.CreateOrganisationMember(ctx, CreateOrganisationMemberParams{
+			OrganisationID: result.Organisation.ID,
+			UserID:         arg.UserID,
+			Role:           util.OrganisationRoleAdmin,
+		})
+		return err
+	})
+	return result, err
+}
+
+// ApproveTransferTxParams contains the input parameters of the approve transfer transaction
+type ApproveTransferTxParams struct {
+	ID         int64 `json:"id"`
+	ApproverID int64 `json:"approver_id"`
+}
+
+// ApproveTransferTxResult is the result of the approve transfer transaction
+type ApproveTransferTxResult struct {
+	TransferApproval TransferApproval `json:"transfer_approval"`
+	TransferTxResult
+}
+
+// ApproveTransferTx makes a transfer held for approval and records who approved it
+func (store *SQLStore) ApproveTransferTx(ctx context.Context, arg ApproveTransferTxParams) (ApproveTransferTxResult, error) {
+	var result ApproveTransferTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		approval, err := q.GetTransferApprovalForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		if approval.Status != util.TransferApprovalStatusPending {
+			return ErrTransferApprovalNotPending
+		}
+
+		from, err := q.GetAccountForUpdate(ctx, approval.FromAccountID)
+		if err != nil {
+			return err
+		}
+
+		if from.FrozenAt.Valid {
+			return ErrAccountFrozen
+		}
+
+		if from.Balance < approval.Amount {
+			return ErrInsufficientFunds
+		}
+
+		result.TransferTxResult, err = transfer(ctx, q, TransferTxParams{
+			FromAccountID: approval.FromAccountID,
+			ToAccountID:   approval.ToAccountID,
+			Amount:        approval.Amount,
+			Description:   approval.Description,
+			Reference:     approval.Reference,
+			Metadata:      approval.Metadata,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.TransferApproval, err = q.MarkTransferApprovalApproved(ctx, MarkTransferApprovalApprovedParams{
+			ID:         approval.ID,
+			ApproverID: arg.ApproverID,
+			TransferID: result.Transfer.ID,
+		})
+		return err
+	})
+	return result, err
+}
@@ -0,0 +1,211 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Sinothic/simplebank/util"
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomBeneficiary(t *testing.T, userID int64) Beneficiary {
+	args := CreateBeneficiaryParams{
+		UserID:        userID,
+		Name:          faker.Name(),
+		RoutingNumber: "021000021",
+		AccountNumber: "123456789",
+		AccountType:   util.BankAccountTypeChecking,
+		Currency:      util.USD,
+	}
+
+	beneficiary, err := testQueries.CreateBeneficiary(context.Background(), args)
+	require.NoError(t, err)
+	require.NotZero(t, beneficiary.ID)
+	require.Equal(t, args.UserID, beneficiary.UserID)
+	require.Equal(t, args.RoutingNumber, beneficiary.RoutingNumber)
+	require.Equal(t, args.AccountNumber, beneficiary.AccountNumber)
+
+	return beneficiary
+}
+
+func createRandomSEPABeneficiary(t *testing.T, userID int64) Beneficiary {
+	beneficiary, err := testQueries.CreateBeneficiary(context.Background(), CreateBeneficiaryParams{
+		UserID:   userID,
+		Name:     faker.Name(),
+		Currency: util.EUR,
+		Iban:     "DE89370400440532013000",
+		Bic:      "COBADEFFXXX",
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.EUR, beneficiary.Currency)
+
+	return beneficiary
+}
+
+func TestListBeneficiaries(t *testing.T) {
+	account := createRandomAccount(t)
+	for i := 0; i < 3; i++ {
+		createRandomBeneficiary(t, account.UserID)
+	}
+
+	beneficiaries, err := testQueries.ListBeneficiaries(context.Background(), ListBeneficiariesParams{
+		UserID: account.UserID,
+		Limit:  5,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, beneficiaries, 3)
+}
+
+func TestOutboundPaymentTx(t *testing.T) {
+	store := NewStore(testDBConnection)
+	account := createRandomAccountWithCurrency(t, util.USD)
+	beneficiary := createRandomBeneficiary(t, account.UserID)
+
+	_, err := store.CreateOutboundPaymentTx(context.Background(), CreateOutboundPaymentTxParams{
+		UserID:        account.UserID,
+		AccountID:     account.ID,
+		BeneficiaryID: beneficiary.ID,
+		Amount:        account.Balance + 1,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	other := createRandomAccountWithCurrency(t, util.USD)
+	_, err = store.CreateOutboundPaymentTx(context.Background(), CreateOutboundPaymentTxParams{
+		UserID:        other.UserID,
+		AccountID:     other.ID,
+		BeneficiaryID: beneficiary.ID,
+		Amount:        1,
+	})
+	require.ErrorIs(t, err, ErrBeneficiaryNotOwned)
+
+	created, err := store.CreateOutboundPaymentTx(context.Background(), CreateOutboundPaymentTxParams{
+		UserID:        account.UserID,
+		AccountID:     account.ID,
+		BeneficiaryID: beneficiary.ID,
+		Amount:        1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.OutboundPaymentStatusPending, created.OutboundPayment.Status)
+	require.Equal(t, util.OutboundRailACH, created.OutboundPayment.Rail)
+	require.Equal(t, account.Balance-1, created.Account.Balance)
+	require.Equal(t, int64(-1), created.AccountEntry.Amount)
+	require.Equal(t, int64(1), created.ClearingEntry.Amount)
+
+	day := time.Now().Truncate(24 * time.Hour)
+	sentToday, err := testQueries.CountAchFilesCreatedSince(context.Background(), day)
+	require.NoError(t, err)
+
+	var written []OutboundPaymentEntry
+	submitted, err := store.SubmitOutboundPaymentsTx(context.Background(), SubmitOutboundPaymentsTxParams{
+		FileName:           "ach-test.txt",
+		ODFIIdentification: "011000015",
+		Day:                day,
+		WriteFile: func(file AchFile, entries []OutboundPaymentEntry) error {
+			written = entries
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.NotZero(t, submitted.AchFile.ID)
+	require.Equal(t, submitted.Entries, written)
+	if sentToday < int64(len(fileIDModifiers)) {
+		require.Equal(t, fileIDModifiers[sentToday:sentToday+1], submitted.AchFile.FileIDModifier)
+	}
+	for i, entry := range submitted.Entries {
+		require.Equal(t, traceNumber("011000015", int64(i+1)), entry.Payment.TraceNumber.String)
+	}
+
+	var payment OutboundPayment
+	for _, entry := range submitted.Entries {
+		require.Equal(t, util.OutboundPaymentStatusSubmitted, entry.Payment.Status)
+		if entry.Payment.ID == created.OutboundPayment.ID {
+			payment = entry.Payment
+			require.Equal(t, beneficiary, entry.Beneficiary)
+		}
+	}
+	require.True(t, payment.TraceNumber.Valid)
+	require.Len(t, payment.TraceNumber.String, 15)
+
+	returned, err := store.ReturnOutboundPaymentTx(context.Background(), ReturnOutboundPaymentTxParams{
+		ID:          payment.ID,
+		TraceNumber: payment.TraceNumber.String,
+		ReturnCode:  "R01",
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.OutboundPaymentStatusReturned, returned.OutboundPayment.Status)
+	require.Equal(t, "R01", returned.OutboundPayment.ReturnCode)
+	require.Equal(t, account.Balance, returned.Account.Balance)
+
+	_, err = store.ReturnOutboundPaymentTx(context.Background(), ReturnOutboundPaymentTxParams{
+		ID:          payment.ID,
+		TraceNumber: payment.TraceNumber.String,
+		ReturnCode:  "R01",
+	})
+	require.ErrorIs(t, err, ErrOutboundPaymentNotSubmitted)
+}
+
+func TestSEPAPaymentTx(t *testing.T) {
+	store := NewStore(testDBConnection)
+	account := createRandomAccountWithCurrency(t, util.EUR)
+	beneficiary := createRandomSEPABeneficiary(t, account.UserID)
+
+	usdBeneficiary := createRandomBeneficiary(t, account.UserID)
+	_, err := store.CreateOutboundPaymentTx(context.Background(), CreateOutboundPaymentTxParams{
+		UserID:        account.UserID,
+		AccountID:     account.ID,
+		BeneficiaryID: usdBeneficiary.ID,
+		Amount:        1,
+	})
+	require.ErrorIs(t, err, ErrBeneficiaryCurrencyMismatch)
+
+	outsideSEPA, err := testQueries.CreateBeneficiary(context.Background(), CreateBeneficiaryParams{
+		UserID:   account.UserID,
+		Name:     faker.Name(),
+		Currency: util.EUR,
+		Iban:     "BR1800360305000010009795493C1",
+		Bic:      "BRASBRRJ",
+	})
+	require.NoError(t, err)
+	_, err = store.CreateOutboundPaymentTx(context.Background(), CreateOutboundPaymentTxParams{
+		UserID:        account.UserID,
+		AccountID:     account.ID,
+		BeneficiaryID: outsideSEPA.ID,
+		Amount:        1,
+	})
+	require.ErrorIs(t, err, ErrBeneficiaryOutsideSEPA)
+
+	created, err := store.CreateOutboundPaymentTx(context.Background(), CreateOutboundPaymentTxParams{
+		UserID:        account.UserID,
+		AccountID:     account.ID,
+		BeneficiaryID: beneficiary.ID,
+		Amount:        1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.OutboundRailSEPA, created.OutboundPayment.Rail)
+	require.Equal(t, account.Balance-1, created.Account.Balance)
+
+	messageID := "SEPA-" + faker.UUIDDigit()
+	submitted, err := store.SubmitSEPAPaymentsTx(context.Background(), SubmitSEPAPaymentsTxParams{
+		MessageID: messageID,
+		FileName:  messageID + ".xml",
+		WriteFile: func(batch SepaBatch, entries []OutboundPaymentEntry) error {
+			for _, entry := range entries {
+				require.Equal(t, util.OutboundRailSEPA, entry.Payment.Rail)
+			}
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, messageID, submitted.SepaBatch.MessageID)
+
+	found := false
+	for _, entry := range submitted.Entries {
+		require.Equal(t, util.OutboundPaymentStatusSubmitted, entry.Payment.Status)
+		require.Equal(t, submitted.SepaBatch.ID, entry.Payment.SepaBatchID.Int64)
+		found = found || entry.Payment.ID == created.OutboundPayment.ID
+	}
+	require.True(t, found)
+}
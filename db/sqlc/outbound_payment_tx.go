@@ -0,0 +1,343 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Sinothic/simplebank/util"
+)
+
+// ErrBeneficiaryNotOwned is returned when paying a beneficiary saved by another user
+var ErrBeneficiaryNotOwned = errors.New("beneficiary does not belong to the paying user")
+
+// ErrBeneficiaryCurrencyMismatch is returned when the beneficiary is not paid in the currency of the account
+var ErrBeneficiaryCurrencyMismatch = errors.New("beneficiary currency doesn't match the account currency")
+
+// ErrBeneficiaryOutsideSEPA is returned when paying by SEPA credit transfer an IBAN of a country outside of SEPA
+var ErrBeneficiaryOutsideSEPA = errors.New("beneficiary IBAN is not in a SEPA country")
+
+// ErrTooManyAchFiles is returned when every file id modifier of the day is already used
+var ErrTooManyAchFiles = errors.New("every file id modifier of the day is already used")
+
+// ErrOutboundPaymentNotSubmitted is returned when returning a payment that was never sent or already returned
+var ErrOutboundPaymentNotSubmitted = errors.New("outbound payment is not submitted")
+
+// CreateOutboundPaymentTxParams contains the input parameters of the create outbound payment transaction.
+// UserID is the holder of the account making the payment, the beneficiary must be one of theirs
+type CreateOutboundPaymentTxParams struct {
+	UserID        int64 `json:"user_id"`
+	AccountID     int64 `json:"account_id"`
+	BeneficiaryID int64 `json:"beneficiary_id"`
+	Amount        int64 `json:"amount"`
+}
+
+// ReturnOutboundPaymentTxParams contains the input parameters of the return outbound payment transaction.
+// Trace numbers restart with every file, the returned payment is the one with both the id and the trace number
+type ReturnOutboundPaymentTxParams struct {
+	ID          int64  `json:"id"`
+	TraceNumber string `json:"trace_number"`
+	ReturnCode  string `json:"return_code"`
+}
+
+// OutboundPaymentTxResult is the result of the outbound payment transactions
+type OutboundPaymentTxResult struct {
+	OutboundPayment OutboundPayment `json:"outbound_payment"`
+	Account         Account         `json:"account"`
+	AccountEntry    Entry           `json:"account_entry"`
+	ClearingEntry   Entry           `json:"clearing_entry"`
+}
+
+// OutboundPaymentEntry is a pending outbound payment with the beneficiary it pays
+type OutboundPaymentEntry struct {
+	Payment     OutboundPayment `json:"payment"`
+	Beneficiary Beneficiary     `json:"beneficiary"`
+}
+
+// SubmitOutboundPaymentsTxParams contains the input parameters of the submit outbound payments transaction.
+// Day is the start of the day the file is created on, the files created since then each get their own file id
+// modifier. WriteFile receives the file and the payments with their trace number set and must write the file
+// before the transaction commits, the payments stay pending when it fails
+type SubmitOutboundPaymentsTxParams struct {
+	FileName           string
+	ODFIIdentification string
+	Day                time.Time
+	WriteFile          func(file AchFile, entries []OutboundPaymentEntry) error
+}
+
+// SubmitSEPAPaymentsTxParams contains the input parameters of the submit SEPA payments transaction.
+// WriteFile must write the pain.001 batch before the transaction commits, the payments stay pending when it fails
+type SubmitSEPAPaymentsTxParams struct {
+	MessageID string
+	FileName  string
+	WriteFile func(batch SepaBatch, entries []OutboundPaymentEntry) error
+}
+
+// SubmitSEPAPaymentsTxResult is the result of the submit SEPA payments transaction,
+// SepaBatch is empty when there was nothing to submit
+type SubmitSEPAPaymentsTxResult struct {
+	SepaBatch SepaBatch              `json:"sepa_batch"`
+	Entries   []OutboundPaymentEntry `json:"entries"`
+}
+
+// SubmitOutboundPaymentsTxResult is the result of the submit outbound payments transaction,
+// AchFile is empty when there was nothing to submit
+type SubmitOutboundPaymentsTxResult struct {
+	AchFile AchFile                `json:"ach_file"`
+	Entries []OutboundPaymentEntry `json:"entries"`
+}
+
+// CreateOutboundPaymentTx debits an account into the clearing account of the rail paying its currency
+// and records a pending outbound payment to one of the beneficiaries of the paying user
+func (store *SQLStore) CreateOutboundPaymentTx(ctx context.Context, arg CreateOutboundPaymentTxParams) (OutboundPaymentTxResult, error) {
+	var result OutboundPaymentTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+		result.Account = account
+
+		beneficiary, err := q.GetBeneficiary(ctx, arg.BeneficiaryID)
+		if err != nil {
+			return err
+		}
+
+		if beneficiary.UserID != arg.UserID {
+			return ErrBeneficiaryNotOwned
+		}
+
+		rail, clearingKind, ok := util.OutboundRail(account.Currency)
+		if !ok || beneficiary.Currency != account.Currency {
+			return ErrBeneficiaryCurrencyMismatch
+		}
+
+		if rail == util.OutboundRailSEPA && !util.IsSEPAIBAN(beneficiary.Iban) {
+			return ErrBeneficiaryOutsideSEPA
+		}
+
+		if account.Balance < arg.Amount {
+			return ErrInsufficientFunds
+		}
+
+		clearing, err := q.GetInternalAccount(ctx, GetInternalAccountParams{
+			Kind:     clearingKind,
+			Currency: account.Currency,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.OutboundPayment, err = q.CreateOutboundPayment(ctx, CreateOutboundPaymentParams{
+			AccountID:         account.ID,
+			BeneficiaryID:     beneficiary.ID,
+			ClearingAccountID: clearing.ID,
+			Amount:            arg.Amount,
+			Currency:          account.Currency,
+			Rail:              rail,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.AccountEntry, result.ClearingEntry, result.Account, _, err = postEntries(ctx, q, account.ID, clearing.ID, arg.Amount)
+		if err != nil {
+			return err
+		}
+
+		payment := result.OutboundPayment
+		return enqueueEvent(ctx, q, EventTypeOutboundPaymentCreated, 1, AggregateTypeOutboundPayment, payment.ID, OutboundPaymentCreatedV1{
+			OutboundPaymentID: payment.ID,
+			AccountID:         payment.AccountID,
+			BeneficiaryID:     payment.BeneficiaryID,
+			Amount:            payment.Amount,
+			Currency:          payment.Currency,
+			Rail:              payment.Rail,
+			Balance:           result.Account.Balance,
+			CreatedAt:         payment.CreatedAt,
+		})
+	})
+	return result, err
+}
+
+// fileIDModifiers are the file id modifiers of the ACH files sent the same day, in order
+const fileIDModifiers = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// SubmitOutboundPaymentsTx records the ACH file carrying every pending ACH payment with the next file id modifier
+// of the day, numbers the payments in the file and marks them as submitted
+func (store *SQLStore) SubmitOutboundPaymentsTx(ctx context.Context, arg SubmitOutboundPaymentsTxParams) (SubmitOutboundPaymentsTxResult, error) {
+	var result SubmitOutboundPaymentsTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		entries, total, err := listPendingOutboundPayments(ctx, q, util.OutboundRailACH)
+		if err != nil || len(entries) == 0 {
+			return err
+		}
+
+		sentToday, err := q.CountAchFilesCreatedSince(ctx, arg.Day)
+		if err != nil {
+			return err
+		}
+		if sentToday >= int64(len(fileIDModifiers)) {
+			return ErrTooManyAchFiles
+		}
+
+		result.AchFile, err = q.CreateAchFile(ctx, CreateAchFileParams{
+			FileName:       arg.FileName,
+			FileIDModifier: fileIDModifiers[sentToday : sentToday+1],
+			EntryCount:     int64(len(entries)),
+			TotalAmount:    total,
+		})
+		if err != nil {
+			return err
+		}
+
+		for i, entry := range entries {
+			entries[i].Payment, err = q.MarkOutboundPaymentSubmitted(ctx, MarkOutboundPaymentSubmittedParams{
+				ID:          entry.Payment.ID,
+				AchFileID:   result.AchFile.ID,
+				TraceNumber: traceNumber(arg.ODFIIdentification, int64(i+1)),
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := enqueueOutboundPaymentSubmitted(ctx, q, entries[i].Payment); err != nil {
+				return err
+			}
+		}
+		result.Entries = entries
+
+		return arg.WriteFile(result.AchFile, entries)
+	})
+	if err != nil {
+		return SubmitOutboundPaymentsTxResult{}, err
+	}
+	return result, nil
+}
+
+// SubmitSEPAPaymentsTx records a pain.001 batch carrying every pending SEPA payment and marks them as submitted
+func (store *SQLStore) SubmitSEPAPaymentsTx(ctx context.Context, arg SubmitSEPAPaymentsTxParams) (SubmitSEPAPaymentsTxResult, error) {
+	var result SubmitSEPAPaymentsTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		entries, total, err := listPendingOutboundPayments(ctx, q, util.OutboundRailSEPA)
+		if err != nil || len(entries) == 0 {
+			return err
+		}
+
+		result.SepaBatch, err = q.CreateSepaBatch(ctx, CreateSepaBatchParams{
+			MessageID:   arg.MessageID,
+			FileName:    arg.FileName,
+			EntryCount:  int64(len(entries)),
+			TotalAmount: total,
+		})
+		if err != nil {
+			return err
+		}
+
+		for i, entry := range entries {
+			entries[i].Payment, err = q.MarkOutboundPaymentBatched(ctx, MarkOutboundPaymentBatchedParams{
+				ID:          entry.Payment.ID,
+				SepaBatchID: result.SepaBatch.ID,
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := enqueueOutboundPaymentSubmitted(ctx, q, entries[i].Payment); err != nil {
+				return err
+			}
+		}
+		result.Entries = entries
+
+		return arg.WriteFile(result.SepaBatch, entries)
+	})
+	if err != nil {
+		return SubmitSEPAPaymentsTxResult{}, err
+	}
+	return result, nil
+}
+
+// ReturnOutboundPaymentTx gives the money of a submitted outbound payment back to the account
+// after the receiving bank returned or rejected it
+func (store *SQLStore) ReturnOutboundPaymentTx(ctx context.Context, arg ReturnOutboundPaymentTxParams) (OutboundPaymentTxResult, error) {
+	var result OutboundPaymentTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		payment, err := q.GetOutboundPaymentByTraceNumberForUpdate(ctx, GetOutboundPaymentByTraceNumberForUpdateParams{
+			ID:          arg.ID,
+			TraceNumber: arg.TraceNumber,
+		})
+		if err != nil {
+			return err
+		}
+
+		if payment.Status != util.OutboundPaymentStatusSubmitted {
+			return fmt.Errorf("%w: %d is %s", ErrOutboundPaymentNotSubmitted, payment.ID, payment.Status)
+		}
+
+		result.ClearingEntry, result.AccountEntry, _, result.Account, err = postEntries(ctx, q, payment.ClearingAccountID, payment.AccountID, payment.Amount)
+		if err != nil {
+			return err
+		}
+
+		result.OutboundPayment, err = q.MarkOutboundPaymentReturned(ctx, MarkOutboundPaymentReturnedParams{
+			ID:         payment.ID,
+			ReturnCode: arg.ReturnCode,
+		})
+		if err != nil {
+			return err
+		}
+
+		returned := result.OutboundPayment
+		return enqueueEvent(ctx, q, EventTypeOutboundPaymentReturned, 1, AggregateTypeOutboundPayment, returned.ID, OutboundPaymentReturnedV1{
+			OutboundPaymentID: returned.ID,
+			AccountID:         returned.AccountID,
+			Amount:            returned.Amount,
+			Currency:          returned.Currency,
+			Rail:              returned.Rail,
+			ReturnCode:        returned.ReturnCode,
+			Balance:           result.Account.Balance,
+			ReturnedAt:        returned.UpdatedAt,
+		})
+	})
+	return result, err
+}
+
+// enqueueOutboundPaymentSubmitted writes to the outbox that a payment was handed to its rail
+func enqueueOutboundPaymentSubmitted(ctx context.Context, q *Queries, payment OutboundPayment) error {
+	return enqueueEvent(ctx, q, EventTypeOutboundPaymentSubmitted, 1, AggregateTypeOutboundPayment, payment.ID, OutboundPaymentSubmittedV1{
+		OutboundPaymentID: payment.ID,
+		AccountID:         payment.AccountID,
+		Amount:            payment.Amount,
+		Currency:          payment.Currency,
+		Rail:              payment.Rail,
+		TraceNumber:       payment.TraceNumber.String,
+		SubmittedAt:       payment.UpdatedAt,
+	})
+}
+
+// listPendingOutboundPayments locks the pending payments of a rail and loads their beneficiaries
+func listPendingOutboundPayments(ctx context.Context, q *Queries, rail string) ([]OutboundPaymentEntry, int64, error) {
+	payments, err := q.ListPendingOutboundPaymentsForUpdate(ctx, rail)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	entries := make([]OutboundPaymentEntry, 0, len(payments))
+	for _, payment := range payments {
+		beneficiary, err := q.GetBeneficiary(ctx, payment.BeneficiaryID)
+		if err != nil {
+			return nil, 0, err
+		}
+		total += payment.Amount
+		entries = append(entries, OutboundPaymentEntry{Payment: payment, Beneficiary: beneficiary})
+	}
+	return entries, total, nil
+}
+
+// traceNumber builds the 15 digit NACHA trace number of an entry from the first 8 digits
+// of the originating bank routing number and the position of the entry in its file
+func traceNumber(odfiIdentification string, sequence int64) string {
+	return fmt.Sprintf("%.8s%07d", odfiIdentification, sequence)
+}
@@ -0,0 +1,185 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// Domain events written to the outbox in the transaction that causes them. The payload of an event type
+// only changes in a backward compatible way, a breaking change gets a new version and a new payload struct
+const (
+	EventTypeAccountCreated           = "AccountCreated"
+	EventTypeTransferPosted           = "TransferPosted"
+	EventTypeBalanceAdjusted          = "BalanceAdjusted"
+	EventTypeExternalTransferPosted   = "ExternalTransferPosted"
+	EventTypeOutboundPaymentCreated   = "OutboundPaymentCreated"
+	EventTypeOutboundPaymentSubmitted = "OutboundPaymentSubmitted"
+	EventTypeOutboundPaymentReturned  = "OutboundPaymentReturned"
+)
+
+// Aggregates the domain events are about
+const (
+	AggregateTypeAccount          = "account"
+	AggregateTypeTransfer         = "transfer"
+	AggregateTypeExternalTransfer = "external_transfer"
+	AggregateTypeOutboundPayment  = "outbound_payment"
+)
+
+// AccountCreatedV1 is the payload of version 1 of AccountCreated
+type AccountCreatedV1 struct {
+	AccountID int64     `json:"account_id"`
+	UserID    int64     `json:"user_id"`
+	Currency  string    `json:"currency"`
+	Kind      string    `json:"kind"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TransferPostedV1 is the payload of version 1 of TransferPosted, the balances are the ones
+// of the accounts right after the transfer
+type TransferPostedV1 struct {
+	TransferID    int64           `json:"transfer_id"`
+	FromAccountID int64           `json:"from_account_id"`
+	ToAccountID   int64           `json:"to_account_id"`
+	Amount        int64           `json:"amount"`
+	Currency      string          `json:"currency"`
+	Description   string          `json:"description"`
+	Reference     string          `json:"reference"`
+	Metadata      json.RawMessage `json:"metadata"`
+	FromBalance   int64           `json:"from_balance"`
+	ToBalance     int64           `json:"to_balance"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// BalanceAdjustedV1 is the payload of version 1 of BalanceAdjusted
+type BalanceAdjustedV1 struct {
+	AdjustmentID int64     `json:"adjustment_id"`
+	AccountID    int64     `json:"account_id"`
+	Amount       int64     `json:"amount"`
+	ReasonCode   string    `json:"reason_code"`
+	Balance      int64     `json:"balance"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// ExternalTransferPostedV1 is the payload of version 1 of ExternalTransferPosted, written when a deposit or
+// a withdrawal starts and again when it completes or fails. Balance is the one of the account right after
+type ExternalTransferPostedV1 struct {
+	ExternalTransferID int64     `json:"external_transfer_id"`
+	AccountID          int64     `json:"account_id"`
+	Direction          string    `json:"direction"`
+	Amount             int64     `json:"amount"`
+	Currency           string    `json:"currency"`
+	Rail               string    `json:"rail"`
+	Status             string    `json:"status"`
+	ExternalReference  string    `json:"external_reference"`
+	FailureReason      string    `json:"failure_reason"`
+	Balance            int64     `json:"balance"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+// OutboundPaymentCreatedV1 is the payload of version 1 of OutboundPaymentCreated, the account is debited
+// when the payment is created and Balance is the one of the account right after
+type OutboundPaymentCreatedV1 struct {
+	OutboundPaymentID int64     `json:"outbound_payment_id"`
+	AccountID         int64     `json:"account_id"`
+	BeneficiaryID     int64     `json:"beneficiary_id"`
+	Amount            int64     `json:"amount"`
+	Currency          string    `json:"currency"`
+	Rail              string    `json:"rail"`
+	Balance           int64     `json:"balance"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// OutboundPaymentSubmittedV1 is the payload of version 1 of OutboundPaymentSubmitted, written when the payment
+// is handed to its rail in an ACH file or a SEPA batch
+type OutboundPaymentSubmittedV1 struct {
+	OutboundPaymentID int64     `json:"outbound_payment_id"`
+	AccountID         int64     `json:"account_id"`
+	Amount            int64     `json:"amount"`
+	Currency          string    `json:"currency"`
+	Rail              string    `json:"rail"`
+	TraceNumber       string    `json:"trace_number,omitempty"`
+	SubmittedAt       time.Time `json:"submitted_at"`
+}
+
+// OutboundPaymentReturnedV1 is the payload of version 1 of OutboundPaymentReturned, the money is given back
+// to the account and Balance is the one of the account right after
+type OutboundPaymentReturnedV1 struct {
+	OutboundPaymentID int64     `json:"outbound_payment_id"`
+	AccountID         int64     `json:"account_id"`
+	Amount            int64     `json:"amount"`
+	Currency          string    `json:"currency"`
+	Rail              string    `json:"rail"`
+	ReturnCode        string    `json:"return_code"`
+	Balance           int64     `json:"balance"`
+	ReturnedAt        time.Time `json:"returned_at"`
+}
+
+// enqueueEvent writes a domain event to the outbox inside the transaction of q,
+// so the event is published if and only if the transaction commits
+func enqueueEvent(ctx context.Context, q *Queries, eventType string, version int32, aggregateType string, aggregateID int64, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreateOutboxEvent(ctx, CreateOutboxEventParams{
+		EventType:     eventType,
+		EventVersion:  version,
+		AggregateType: aggregateType,
+		AggregateID:   strconv.FormatInt(aggregateID, 10),
+		Payload:       data,
+	})
+	return err
+}
+
+// RelayOutboxTxParams contains the input parameters of the relay outbox transaction.
+// Publish must hand the event to the broker before the transaction commits
+type RelayOutboxTxParams struct {
+	Limit   int32
+	Publish func(event Outbox) error
+}
+
+// RelayOutboxTxResult is the result of the relay outbox transaction, Failed is the event that
+// could not be published and stopped the relay, if any
+type RelayOutboxTxResult struct {
+	Published []Outbox `json:"published"`
+	Failed    *Outbox  `json:"failed,omitempty"`
+}
+
+// RelayOutboxTx publishes the oldest pending events in order and marks them as published. It stops at
+// the first event the publisher rejects and records the failure, so the event is retried first next time.
+// An event published right before the transaction fails to commit is published again: delivery is at least once.
+// Pending events locked by another relay are skipped
+func (store *SQLStore) RelayOutboxTx(ctx context.Context, arg RelayOutboxTxParams) (RelayOutboxTxResult, error) {
+	var result RelayOutboxTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		events, err := q.ListPendingOutboxEventsForUpdate(ctx, arg.Limit)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if publishErr := arg.Publish(event); publishErr != nil {
+				failed, err := q.RecordOutboxEventFailure(ctx, RecordOutboxEventFailureParams{
+					ID:        event.ID,
+					LastError: publishErr.Error(),
+				})
+				if err != nil {
+					return err
+				}
+				result.Failed = &failed
+				return nil
+			}
+
+			published, err := q.MarkOutboxEventPublished(ctx, event.ID)
+			if err != nil {
+				return err
+			}
+			result.Published = append(result.Published, published)
+		}
+		return nil
+	})
+	return result, err
+}
@@ -0,0 +1,242 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferTxOutboxEvent(t *testing.T) {
+	store := NewStore(testDBConnection)
+	from := createRandomAccountWithCurrency(t, util.USD)
+	to := createRandomAccountWithCurrency(t, util.USD)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        10,
+		Reference:     "INV-42",
+	})
+	require.NoError(t, err)
+
+	events, err := testQueries.ListAggregateOutboxEvents(context.Background(), ListAggregateOutboxEventsParams{
+		AggregateType: AggregateTypeTransfer,
+		AggregateID:   strconv.FormatInt(result.Transfer.ID, 10),
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, EventTypeTransferPosted, events[0].EventType)
+	require.Equal(t, int32(1), events[0].EventVersion)
+	require.False(t, events[0].PublishedAt.Valid)
+
+	var payload TransferPostedV1
+	require.NoError(t, json.Unmarshal(events[0].Payload, &payload))
+	require.Equal(t, result.Transfer.ID, payload.TransferID)
+	require.Equal(t, from.ID, payload.FromAccountID)
+	require.Equal(t, to.ID, payload.ToAccountID)
+	require.Equal(t, int64(10), payload.Amount)
+	require.Equal(t, util.USD, payload.Currency)
+	require.Equal(t, "INV-42", payload.Reference)
+	require.Equal(t, result.FromAccount.Balance, payload.FromBalance)
+	require.Equal(t, result.ToAccount.Balance, payload.ToBalance)
+}
+
+func TestCreateAccountTxOutboxEvent(t *testing.T) {
+	store := NewStore(testDBConnection)
+	user := createRandomAccountWithCurrency(t, util.USD).UserID
+
+	result, err := store.CreateAccountTx(context.Background(), CreateAccountTxParams{UserID: user, Currency: util.EUR})
+	require.NoError(t, err)
+
+	events, err := testQueries.ListAggregateOutboxEvents(context.Background(), ListAggregateOutboxEventsParams{
+		AggregateType: AggregateTypeAccount,
+		AggregateID:   strconv.FormatInt(result.Account.ID, 10),
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, EventTypeAccountCreated, events[0].EventType)
+
+	var payload AccountCreatedV1
+	require.NoError(t, json.Unmarshal(events[0].Payload, &payload))
+	require.Equal(t, result.Account.ID, payload.AccountID)
+	require.Equal(t, user, payload.UserID)
+	require.Equal(t, util.EUR, payload.Currency)
+}
+
+func TestExternalTransferTxOutboxEvents(t *testing.T) {
+	store := NewStore(testDBConnection)
+	account := createRandomAccountWithCurrency(t, util.USD)
+
+	started, err := store.StartExternalTransferTx(context.Background(), StartExternalTransferTxParams{
+		AccountID: account.ID,
+		Direction: util.DirectionWithdrawal,
+		Amount:    10,
+		Rail:      "fake",
+	})
+	require.NoError(t, err)
+
+	failed, err := store.FailExternalTransferTx(context.Background(), FailExternalTransferTxParams{
+		ID:                started.ExternalTransfer.ID,
+		ExternalReference: "rail-42",
+		FailureReason:     "account closed",
+	})
+	require.NoError(t, err)
+
+	events, err := testQueries.ListAggregateOutboxEvents(context.Background(), ListAggregateOutboxEventsParams{
+		AggregateType: AggregateTypeExternalTransfer,
+		AggregateID:   strconv.FormatInt(started.ExternalTransfer.ID, 10),
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	expected := []struct {
+		status  string
+		balance int64
+	}{
+		{status: util.PaymentStatusPending, balance: started.Account.Balance},
+		{status: util.PaymentStatusFailed, balance: failed.Account.Balance},
+	}
+	for i, event := range events {
+		require.Equal(t, EventTypeExternalTransferPosted, event.EventType)
+		require.Equal(t, int32(1), event.EventVersion)
+
+		var payload ExternalTransferPostedV1
+		require.NoError(t, json.Unmarshal(event.Payload, &payload))
+		require.Equal(t, started.ExternalTransfer.ID, payload.ExternalTransferID)
+		require.Equal(t, account.ID, payload.AccountID)
+		require.Equal(t, util.DirectionWithdrawal, payload.Direction)
+		require.Equal(t, int64(10), payload.Amount)
+		require.Equal(t, expected[i].status, payload.Status)
+		require.Equal(t, expected[i].balance, payload.Balance)
+	}
+	require.Equal(t, account.Balance-10, started.Account.Balance)
+	require.Equal(t, account.Balance, failed.Account.Balance)
+
+	var payload ExternalTransferPostedV1
+	require.NoError(t, json.Unmarshal(events[1].Payload, &payload))
+	require.Equal(t, "rail-42", payload.ExternalReference)
+	require.Equal(t, "account closed", payload.FailureReason)
+}
+
+func TestOutboundPaymentTxOutboxEvents(t *testing.T) {
+	store := NewStore(testDBConnection)
+	account := createRandomAccountWithCurrency(t, util.USD)
+	beneficiary := createRandomBeneficiary(t, account.UserID)
+
+	created, err := store.CreateOutboundPaymentTx(context.Background(), CreateOutboundPaymentTxParams{
+		UserID:        account.UserID,
+		AccountID:     account.ID,
+		BeneficiaryID: beneficiary.ID,
+		Amount:        5,
+	})
+	require.NoError(t, err)
+
+	_, err = store.SubmitOutboundPaymentsTx(context.Background(), SubmitOutboundPaymentsTxParams{
+		FileName:           "ach-outbox-test.txt",
+		ODFIIdentification: "011000015",
+		Day:                time.Now().Truncate(24 * time.Hour),
+		WriteFile:          func(file AchFile, entries []OutboundPaymentEntry) error { return nil },
+	})
+	require.NoError(t, err)
+
+	payment, err := testQueries.GetOutboundPayment(context.Background(), created.OutboundPayment.ID)
+	require.NoError(t, err)
+	require.True(t, payment.TraceNumber.Valid)
+
+	returned, err := store.ReturnOutboundPaymentTx(context.Background(), ReturnOutboundPaymentTxParams{
+		ID:          payment.ID,
+		TraceNumber: payment.TraceNumber.String,
+		ReturnCode:  "R01",
+	})
+	require.NoError(t, err)
+
+	events, err := testQueries.ListAggregateOutboxEvents(context.Background(), ListAggregateOutboxEventsParams{
+		AggregateType: AggregateTypeOutboundPayment,
+		AggregateID:   strconv.FormatInt(payment.ID, 10),
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Equal(t, EventTypeOutboundPaymentCreated, events[0].EventType)
+	require.Equal(t, EventTypeOutboundPaymentSubmitted, events[1].EventType)
+	require.Equal(t, EventTypeOutboundPaymentReturned, events[2].EventType)
+
+	var createdPayload OutboundPaymentCreatedV1
+	require.NoError(t, json.Unmarshal(events[0].Payload, &createdPayload))
+	require.Equal(t, payment.ID, createdPayload.OutboundPaymentID)
+	require.Equal(t, account.ID, createdPayload.AccountID)
+	require.Equal(t, beneficiary.ID, createdPayload.BeneficiaryID)
+	require.Equal(t, util.OutboundRailACH, createdPayload.Rail)
+	require.Equal(t, account.Balance-5, createdPayload.Balance)
+
+	var submittedPayload OutboundPaymentSubmittedV1
+	require.NoError(t, json.Unmarshal(events[1].Payload, &submittedPayload))
+	require.Equal(t, payment.TraceNumber.String, submittedPayload.TraceNumber)
+
+	var returnedPayload OutboundPaymentReturnedV1
+	require.NoError(t, json.Unmarshal(events[2].Payload, &returnedPayload))
+	require.Equal(t, "R01", returnedPayload.ReturnCode)
+	require.Equal(t, returned.Account.Balance, returnedPayload.Balance)
+	require.Equal(t, account.Balance, returnedPayload.Balance)
+}
+
+// relayEverything publishes the events left pending by the other tests
+func relayEverything(t *testing.T, store Store) {
+	for {
+		result, err := store.RelayOutboxTx(context.Background(), RelayOutboxTxParams{
+			Limit:   100,
+			Publish: func(event Outbox) error { return nil },
+		})
+		require.NoError(t, err)
+		if len(result.Published) < 100 {
+			return
+		}
+	}
+}
+
+func TestRelayOutboxTx(t *testing.T) {
+	store := NewStore(testDBConnection)
+	relayEverything(t, store)
+
+	from := createRandomAccountWithCurrency(t, util.CAD)
+	to := createRandomAccountWithCurrency(t, util.CAD)
+	transfer, err := store.TransferTx(context.Background(), TransferTxParams{FromAccountID: from.ID, ToAccountID: to.ID, Amount: 1})
+	require.NoError(t, err)
+
+	// the broker is down, the event stays pending with the failure recorded
+	result, err := store.RelayOutboxTx(context.Background(), RelayOutboxTxParams{
+		Limit:   10,
+		Publish: func(event Outbox) error { return errors.New("broker down") },
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.Published)
+	require.NotNil(t, result.Failed)
+	require.Equal(t, EventTypeTransferPosted, result.Failed.EventType)
+	require.Equal(t, strconv.FormatInt(transfer.Transfer.ID, 10), result.Failed.AggregateID)
+	require.Equal(t, int32(1), result.Failed.Attempts)
+	require.Equal(t, "broker down", result.Failed.LastError)
+	require.False(t, result.Failed.PublishedAt.Valid)
+
+	var published []Outbox
+	result, err = store.RelayOutboxTx(context.Background(), RelayOutboxTxParams{
+		Limit: 10,
+		Publish: func(event Outbox) error {
+			published = append(published, event)
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Nil(t, result.Failed)
+	require.Len(t, result.Published, 1)
+	require.Len(t, published, 1)
+	require.True(t, result.Published[0].PublishedAt.Valid)
+
+	event, err := testQueries.GetOutboxEvent(context.Background(), published[0].ID)
+	require.NoError(t, err)
+	require.True(t, event.PublishedAt.Valid)
+}
@@ -0,0 +1,90 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/go-faker/faker/v4"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomPayee(t *testing.T, userID int64) Payee {
+	account := createRandomAccount(t)
+	args := CreatePayeeParams{
+		UserID:    userID,
+		Nickname:  faker.Username(),
+		AccountID: sql.NullInt64{Int64: account.ID, Valid: true},
+		Verified:  true,
+	}
+
+	payee, err := testQueries.CreatePayee(context.Background(), args)
+	require.NoError(t, err)
+	require.NotZero(t, payee.ID)
+	require.Equal(t, args.UserID, payee.UserID)
+	require.Equal(t, args.Nickname, payee.Nickname)
+	require.Equal(t, args.AccountID, payee.AccountID)
+	require.False(t, payee.BeneficiaryID.Valid)
+	require.True(t, payee.Verified)
+	require.NotZero(t, payee.CreatedAt)
+
+	return payee
+}
+
+func TestCreatePayee(t *testing.T) {
+	account := createRandomAccount(t)
+	payee := createRandomPayee(t, account.UserID)
+
+	_, err := testQueries.CreatePayee(context.Background(), CreatePayeeParams{
+		UserID:    account.UserID,
+		Nickname:  payee.Nickname,
+		AccountID: payee.AccountID,
+	})
+	require.Error(t, err)
+	require.Equal(t, "unique_violation", err.(*pq.Error).Code.Name())
+
+	beneficiary := createRandomBeneficiary(t, account.UserID)
+	_, err = testQueries.CreatePayee(context.Background(), CreatePayeeParams{
+		UserID:        account.UserID,
+		Nickname:      faker.Username(),
+		AccountID:     payee.AccountID,
+		BeneficiaryID: sql.NullInt64{Int64: beneficiary.ID, Valid: true},
+	})
+	require.Error(t, err)
+	require.Equal(t, "check_violation", err.(*pq.Error).Code.Name())
+}
+
+func TestUpdateAndDeletePayee(t *testing.T) {
+	account := createRandomAccount(t)
+	payee := createRandomPayee(t, account.UserID)
+
+	updated, err := testQueries.UpdatePayeeNickname(context.Background(), UpdatePayeeNicknameParams{
+		ID:       payee.ID,
+		Nickname: "renamed",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "renamed", updated.Nickname)
+	require.Equal(t, payee.AccountID, updated.AccountID)
+
+	err = testQueries.DeletePayee(context.Background(), payee.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetPayee(context.Background(), payee.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestListPayees(t *testing.T) {
+	account := createRandomAccount(t)
+	for i := 0; i < 3; i++ {
+		createRandomPayee(t, account.UserID)
+	}
+
+	payees, err := testQueries.ListPayees(context.Background(), ListPayeesParams{
+		UserID: account.UserID,
+		Limit:  5,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, payees, 3)
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentBatch(t *testing.T) {
+	account := createRandomAccount(t)
+
+	args := CreatePaymentBatchParams{
+		UserID:               account.UserID,
+		MessageID:            faker.UUIDDigit(),
+		NumberOfTransactions: 3,
+	}
+
+	batch, err := testQueries.CreatePaymentBatch(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, args.UserID, batch.UserID)
+	require.Equal(t, args.MessageID, batch.MessageID)
+	require.Equal(t, args.NumberOfTransactions, batch.NumberOfTransactions)
+	require.Zero(t, batch.AcceptedTransactions)
+	require.Zero(t, batch.RejectedTransactions)
+
+	// the same message can't be imported twice by the same user
+	_, err = testQueries.CreatePaymentBatch(context.Background(), args)
+	require.Error(t, err)
+
+	dbBatch, err := testQueries.GetPaymentBatch(context.Background(), GetPaymentBatchParams{
+		UserID:    args.UserID,
+		MessageID: args.MessageID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, batch.ID, dbBatch.ID)
+
+	updated, err := testQueries.UpdatePaymentBatchResult(context.Background(), UpdatePaymentBatchResultParams{
+		ID:                   batch.ID,
+		AcceptedTransactions: 2,
+		RejectedTransactions: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), updated.AcceptedTransactions)
+	require.Equal(t, int64(1), updated.RejectedTransactions)
+}
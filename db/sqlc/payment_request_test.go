@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomPaymentRequest(t *testing.T, requester, payer Account, amount int64, expiresAt time.Time) PaymentRequest {
+	args := CreatePaymentRequestParams{
+		RequesterID:        requester.UserID,
+		RequesterAccountID: requester.ID,
+		PayerID:            payer.UserID,
+		Amount:             amount,
+		Currency:           requester.Currency,
+		Memo:               "dinner",
+		ExpiresAt:          expiresAt,
+	}
+
+	request, err := testQueries.CreatePaymentRequest(context.Background(), args)
+	require.NoError(t, err)
+	require.NotZero(t, request.ID)
+	require.Equal(t, args.PayerID, request.PayerID)
+	require.Equal(t, args.Amount, request.Amount)
+	require.Equal(t, util.PaymentRequestStatusPending, request.Status)
+	require.False(t, request.TransferID.Valid)
+
+	return request
+}
+
+func TestAcceptPaymentRequestTx(t *testing.T) {
+	store := NewStore(testDBConnection)
+	requester := createRandomAccountWithCurrency(t, util.USD)
+	payer := createRandomAccountWithCurrency(t, util.USD)
+	request := createRandomPaymentRequest(t, requester, payer, payer.Balance, time.Now().Add(time.Hour))
+
+	result, err := store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		ID:        request.ID,
+		AccountID: payer.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.PaymentRequestStatusAccepted, result.PaymentRequest.Status)
+	require.Equal(t, result.Transfer.ID, result.PaymentRequest.TransferID.Int64)
+	require.Equal(t, int64(0), result.FromAccount.Balance)
+	require.Equal(t, requester.Balance+payer.Balance, result.ToAccount.Balance)
+
+	_, err = store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		ID:        request.ID,
+		AccountID: payer.ID,
+	})
+	require.ErrorIs(t, err, ErrPaymentRequestNotPending)
+}
+
+func TestAcceptPaymentRequestTxRejected(t *testing.T) {
+	store := NewStore(testDBConnection)
+	requester := createRandomAccountWithCurrency(t, util.USD)
+	payer := createRandomAccountWithCurrency(t, util.USD)
+	eurPayer := createRandomAccountWithCurrency(t, util.EUR)
+
+	request := createRandomPaymentRequest(t, requester, payer, payer.Balance+1, time.Now().Add(time.Hour))
+	_, err := store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{ID: request.ID, AccountID: payer.ID})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	_, err = store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{ID: request.ID, AccountID: eurPayer.ID})
+	require.ErrorIs(t, err, ErrPaymentRequestCurrencyMismatch)
+
+	expired := createRandomPaymentRequest(t, requester, payer, 1, time.Now().Add(-time.Minute))
+	result, err := store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{ID: expired.ID, AccountID: payer.ID})
+	require.ErrorIs(t, err, ErrPaymentRequestExpired)
+	require.Equal(t, util.PaymentRequestStatusExpired, result.PaymentRequest.Status)
+
+	stored, err := testQueries.GetPaymentRequest(context.Background(), expired.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.PaymentRequestStatusExpired, stored.Status)
+}
+
+func TestUpdatePendingPaymentRequestStatus(t *testing.T) {
+	requester := createRandomAccountWithCurrency(t, util.USD)
+	payer := createRandomAccountWithCurrency(t, util.USD)
+	request := createRandomPaymentRequest(t, requester, payer, 10, time.Now().Add(time.Hour))
+
+	declined, err := testQueries.UpdatePendingPaymentRequestStatus(context.Background(), UpdatePendingPaymentRequestStatusParams{
+		ID:     request.ID,
+		Status: util.PaymentRequestStatusDeclined,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.PaymentRequestStatusDeclined, declined.Status)
+
+	_, err = testQueries.UpdatePendingPaymentRequestStatus(context.Background(), UpdatePendingPaymentRequestStatusParams{
+		ID:     request.ID,
+		Status: util.PaymentRequestStatusCancelled,
+	})
+	require.Error(t, err)
+
+	requests, err := testQueries.ListIncomingPaymentRequests(context.Background(), ListIncomingPaymentRequestsParams{
+		PayerID: payer.UserID,
+		Limit:   5,
+	})
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+}
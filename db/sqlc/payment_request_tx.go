@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Sinothic/simplebank/util"
+)
+
+// ErrPaymentRequestNotPending is returned when answering a payment request that was already answered
+var ErrPaymentRequestNotPending = errors.New("payment request is not pending")
+
+// ErrPaymentRequestExpired is returned when accepting a payment request after its expiry
+var ErrPaymentRequestExpired = errors.New("payment request has expired")
+
+// ErrPaymentRequestCurrencyMismatch is returned when the payer account is not in the currency of the request
+var ErrPaymentRequestCurrencyMismatch = errors.New("account currency doesn't match the payment request currency")
+
+// AcceptPaymentRequestTxParams contains the input parameters of the accept payment request transaction
+type AcceptPaymentRequestTxParams struct {
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+}
+
+// AcceptPaymentRequestTxResult is the result of the accept payment request transaction
+type AcceptPaymentRequestTxResult struct {
+	PaymentRequest PaymentRequest `json:"payment_request"`
+	TransferTxResult
+}
+
+// AcceptPaymentRequestTx pays a pending payment request from an account of the payer to the requester account.
+// A request found expired is marked as such and ErrPaymentRequestExpired is returned
+func (store *SQLStore) AcceptPaymentRequestTx(ctx context.Context, arg AcceptPaymentRequestTxParams) (AcceptPaymentRequestTxResult, error) {
+	var result AcceptPaymentRequestTxResult
+	expired := false
+	err := store.execTx(ctx, func(q *Queries) error {
+		request, err := q.GetPaymentRequestForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		if request.Status != util.PaymentRequestStatusPending {
+			return ErrPaymentRequestNotPending
+		}
+
+		if !time.Now().Before(request.ExpiresAt) {
+			expired = true
+			result.PaymentRequest, err = q.UpdatePendingPaymentRequestStatus(ctx, UpdatePendingPaymentRequestStatusParams{
+				ID:     request.ID,
+				Status: util.PaymentRequestStatusExpired,
+			})
+			return err
+		}
+
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if account.Currency != request.Currency {
+			return ErrPaymentRequestCurrencyMismatch
+		}
+
+		if account.Balance < request.Amount {
+			return ErrInsufficientFunds
+		}
+
+		result.TransferTxResult, err = transfer(ctx, q, TransferTxParams{
+			FromAccountID: account.ID,
+			ToAccountID:   request.RequesterAccountID,
+			Amount:        request.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.PaymentRequest, err = q.MarkPaymentRequestAccepted(ctx, MarkPaymentRequestAcceptedParams{
+			ID:         request.ID,
+			TransferID: result.Transfer.ID,
+		})
+		return err
+	})
+	if err != nil {
+		return AcceptPaymentRequestTxResult{}, err
+	}
+	if expired {
+		return result, ErrPaymentRequestExpired
+	}
+	return result, nil
+}
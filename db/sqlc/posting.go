@@ -0,0 +1,123 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+)
+
+// The queries moving a balance, booking an entry or chaining it are kept off the Querier, so the Store can't
+// change a balance without its entry, book an entry without moving the balance or rewrite the hash of an entry.
+// They are only run by the postings of the transactions: TransferTx, AdjustBalanceTx and the external transfers
+
+const addAccountBalance = `
+UPDATE accounts
+set balance = balance + $1
+WHERE id = $2
+RETURNING id, user_id, balance, currency, created_at, kind, parent_id, name, goal_amount, target_date, organisation_id, frozen_at, frozen_reason
+`
+
+type AddAccountBalanceParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) addAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, addAccountBalance, arg.Amount, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.Kind,
+		&i.ParentID,
+		&i.Name,
+		&i.GoalAmount,
+		&i.TargetDate,
+		&i.OrganisationID,
+		&i.FrozenAt,
+		&i.FrozenReason,
+	)
+	return i, err
+}
+
+const createTransferEntry = `
+INSERT INTO entries (
+    account_id,
+    amount,
+    transfer_id,
+    description,
+    reference,
+    metadata
+) VALUES (
+             $1, $2, $3, $4, $5, $6
+         ) RETURNING id, account_id, amount, created_at, transfer_id, description, reference, metadata, prev_hash, hash
+`
+
+type CreateTransferEntryParams struct {
+	AccountID   int64           `json:"account_id"`
+	Amount      int64           `json:"amount"`
+	TransferID  sql.NullInt64   `json:"transfer_id"`
+	Description string          `json:"description"`
+	Reference   string          `json:"reference"`
+	Metadata    json.RawMessage `json:"metadata"`
+}
+
+func (q *Queries) createTransferEntry(ctx context.Context, arg CreateTransferEntryParams) (Entry, error) {
+	row := q.db.QueryRowContext(ctx, createTransferEntry,
+		arg.AccountID,
+		arg.Amount,
+		arg.TransferID,
+		arg.Description,
+		arg.Reference,
+		arg.Metadata,
+	)
+	var i Entry
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.TransferID,
+		&i.Description,
+		&i.Reference,
+		&i.Metadata,
+		&i.PrevHash,
+		&i.Hash,
+	)
+	return i, err
+}
+
+const setEntryHash = `
+UPDATE entries
+SET prev_hash = $1,
+    hash = $2
+WHERE id = $3
+RETURNING id, account_id, amount, created_at, transfer_id, description, reference, metadata, prev_hash, hash
+`
+
+type SetEntryHashParams struct {
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash"`
+	ID       int64  `json:"id"`
+}
+
+func (q *Queries) setEntryHash(ctx context.Context, arg SetEntryHashParams) (Entry, error) {
+	row := q.db.QueryRowContext(ctx, setEntryHash, arg.PrevHash, arg.Hash, arg.ID)
+	var i Entry
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.TransferID,
+		&i.Description,
+		&i.Reference,
+		&i.Metadata,
+		&i.PrevHash,
+		&i.Hash,
+	)
+	return i, err
+}
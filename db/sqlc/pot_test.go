@@ -0,0 +1,85 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/Sinothic/simplebank/util"
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomPot(t *testing.T, parent Account) Account {
+	args := CreatePotParams{
+		UserID:     parent.UserID,
+		Currency:   parent.Currency,
+		ParentID:   parent.ID,
+		Name:       faker.Username(),
+		GoalAmount: sql.NullInt64{Int64: 1000, Valid: true},
+	}
+
+	pot, err := testQueries.CreatePot(context.Background(), args)
+	require.NoError(t, err)
+	require.Equal(t, util.AccountKindPot, pot.Kind)
+	require.Equal(t, parent.ID, pot.ParentID.Int64)
+	require.Equal(t, parent.Currency, pot.Currency)
+	require.Equal(t, int64(0), pot.Balance)
+
+	return pot
+}
+
+func TestMovePotTx(t *testing.T) {
+	store := NewStore(testDBConnection)
+	parent := createRandomAccountWithCurrency(t, util.EUR)
+	pot := createRandomPot(t, parent)
+
+	result, err := store.MovePotTx(context.Background(), MovePotTxParams{PotID: pot.ID, Amount: parent.Balance})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), result.FromAccount.Balance)
+	require.Equal(t, parent.Balance, result.ToAccount.Balance)
+
+	_, err = store.MovePotTx(context.Background(), MovePotTxParams{PotID: pot.ID, Amount: 1})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	result, err = store.MovePotTx(context.Background(), MovePotTxParams{PotID: pot.ID, Amount: -1})
+	require.NoError(t, err)
+	require.Equal(t, pot.ID, result.FromAccount.ID)
+	require.Equal(t, parent.ID, result.ToAccount.ID)
+	require.Equal(t, int64(1), result.ToAccount.Balance)
+
+	total, err := testQueries.SumPotBalances(context.Background(), parent.ID)
+	require.NoError(t, err)
+	require.Equal(t, parent.Balance-1, total)
+
+	_, err = store.MovePotTx(context.Background(), MovePotTxParams{PotID: parent.ID, Amount: 1})
+	require.ErrorIs(t, err, ErrNotAPot)
+}
+
+func TestListAndUpdatePots(t *testing.T) {
+	parent := createRandomAccountWithCurrency(t, util.CAD)
+	pot := createRandomPot(t, parent)
+	createRandomPot(t, parent)
+
+	// a user can have several pots in the currency of their account
+	pots, err := testQueries.ListPots(context.Background(), parent.ID)
+	require.NoError(t, err)
+	require.Len(t, pots, 2)
+	require.Equal(t, pot.ID, pots[0].ID)
+
+	_, err = testQueries.CreatePot(context.Background(), CreatePotParams{
+		UserID:   parent.UserID,
+		Currency: parent.Currency,
+		ParentID: parent.ID,
+		Name:     pots[1].Name,
+	})
+	require.Error(t, err)
+
+	updated, err := testQueries.UpdatePot(context.Background(), UpdatePotParams{ID: pot.ID, Name: "Holidays"})
+	require.NoError(t, err)
+	require.Equal(t, "Holidays", updated.Name)
+	require.False(t, updated.GoalAmount.Valid)
+
+	_, err = testQueries.UpdatePot(context.Background(), UpdatePotParams{ID: parent.ID, Name: "Holidays"})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
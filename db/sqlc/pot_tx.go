@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNotAPot is returned when moving money to or from an account that is not a pot
+var ErrNotAPot = errors.New("account is not a pot")
+
+// MovePotTxParams contains the input parameters of the move pot transaction,
+// a positive amount moves money from the parent account into the pot and a negative amount moves it back
+type MovePotTxParams struct {
+	PotID  int64 `json:"pot_id"`
+	Amount int64 `json:"amount"`
+}
+
+// MovePotTx moves money between a pot and its parent account, free of charge and without leaving the bank
+func (store *SQLStore) MovePotTx(ctx context.Context, arg MovePotTxParams) (TransferTxResult, error) {
+	var result TransferTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		pot, err := q.GetAccountForUpdate(ctx, arg.PotID)
+		if err != nil {
+			return err
+		}
+
+		if !pot.ParentID.Valid {
+			return ErrNotAPot
+		}
+
+		parent, err := q.GetAccountForUpdate(ctx, pot.ParentID.Int64)
+		if err != nil {
+			return err
+		}
+
+		params := TransferTxParams{
+			FromAccountID: parent.ID,
+			ToAccountID:   pot.ID,
+			Amount:        arg.Amount,
+			Description:   fmt.Sprintf("Move to pot %s", pot.Name),
+		}
+		from := parent
+		if arg.Amount < 0 {
+			params = TransferTxParams{
+				FromAccountID: pot.ID,
+				ToAccountID:   parent.ID,
+				Amount:        -arg.Amount,
+				Description:   fmt.Sprintf("Move from pot %s", pot.Name),
+			}
+			from = pot
+		}
+
+		if from.Balance < params.Amount {
+			return ErrInsufficientFunds
+		}
+
+		result, err = transfer(ctx, q, params)
+		return err
+	})
+	return result, err
+}
@@ -6,24 +6,134 @@ package db
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 )
 
 type Querier interface {
-	AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (Account, error)
+	ClaimDueWebhookDeliveries(ctx context.Context, arg ClaimDueWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	CountAchFilesCreatedSince(ctx context.Context, createdSince time.Time) (int64, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
-	CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error)
+	CreateAccountHolder(ctx context.Context, arg CreateAccountHolderParams) (AccountHolder, error)
+	CreateAccountInvitation(ctx context.Context, arg CreateAccountInvitationParams) (AccountInvitation, error)
+	CreateAchFile(ctx context.Context, arg CreateAchFileParams) (AchFile, error)
+	CreateAdminAuditLog(ctx context.Context, arg CreateAdminAuditLogParams) (AdminAuditLog, error)
+	CreateBalanceAdjustment(ctx context.Context, arg CreateBalanceAdjustmentParams) (BalanceAdjustment, error)
+	CreateBeneficiary(ctx context.Context, arg CreateBeneficiaryParams) (Beneficiary, error)
+	CreateExternalTransfer(ctx context.Context, arg CreateExternalTransferParams) (ExternalTransfer, error)
+	CreateOrganisation(ctx context.Context, arg CreateOrganisationParams) (Organisation, error)
+	CreateOrganisationAccount(ctx context.Context, arg CreateOrganisationAccountParams) (Account, error)
+	CreateOrganisationMember(ctx context.Context, arg CreateOrganisationMemberParams) (OrganisationMember, error)
+	CreateOutboundPayment(ctx context.Context, arg CreateOutboundPaymentParams) (OutboundPayment, error)
+	CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) (Outbox, error)
+	CreatePayee(ctx context.Context, arg CreatePayeeParams) (Payee, error)
+	CreatePaymentBatch(ctx context.Context, arg CreatePaymentBatchParams) (PaymentBatch, error)
+	CreatePaymentRequest(ctx context.Context, arg CreatePaymentRequestParams) (PaymentRequest, error)
+	CreatePot(ctx context.Context, arg CreatePotParams) (Account, error)
+	CreateSepaBatch(ctx context.Context, arg CreateSepaBatchParams) (SepaBatch, error)
 	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
+	CreateTransferApproval(ctx context.Context, arg CreateTransferApprovalParams) (TransferApproval, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateWebhookDeliveries(ctx context.Context, arg CreateWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error)
 	DeleteAccount(ctx context.Context, id int64) error
+	DeleteAccountHolder(ctx context.Context, arg DeleteAccountHolderParams) error
+	DeleteOrganisationMember(ctx context.Context, arg DeleteOrganisationMemberParams) error
+	DeletePayee(ctx context.Context, id int64) error
+	DeleteWebhookSubscription(ctx context.Context, arg DeleteWebhookSubscriptionParams) error
+	FreezeAccount(ctx context.Context, arg FreezeAccountParams) ([]Account, error)
 	GetAccount(ctx context.Context, id int64) (Account, error)
 	GetAccountForUpdate(ctx context.Context, id int64) (Account, error)
+	GetAccountHolder(ctx context.Context, arg GetAccountHolderParams) (AccountHolder, error)
+	GetAccountInvitation(ctx context.Context, id int64) (AccountInvitation, error)
+	GetAccountInvitationForUpdate(ctx context.Context, id int64) (AccountInvitation, error)
+	GetAccountStreamHead(ctx context.Context, accountID int64) (GetAccountStreamHeadRow, error)
+	GetBeneficiary(ctx context.Context, id int64) (Beneficiary, error)
 	GetEntry(ctx context.Context, id int64) (Entry, error)
+	GetExternalTransfer(ctx context.Context, id int64) (ExternalTransfer, error)
+	GetExternalTransferForUpdate(ctx context.Context, id int64) (ExternalTransfer, error)
+	GetInternalAccount(ctx context.Context, arg GetInternalAccountParams) (Account, error)
+	GetLastEntryHash(ctx context.Context, arg GetLastEntryHashParams) (string, error)
+	GetNewestPayeeOfDestination(ctx context.Context, arg GetNewestPayeeOfDestinationParams) (Payee, error)
+	GetOrganisation(ctx context.Context, id int64) (Organisation, error)
+	GetOrganisationMember(ctx context.Context, arg GetOrganisationMemberParams) (OrganisationMember, error)
+	GetOutboundPayment(ctx context.Context, id int64) (OutboundPayment, error)
+	GetOutboundPaymentByTraceNumberForUpdate(ctx context.Context, arg GetOutboundPaymentByTraceNumberForUpdateParams) (OutboundPayment, error)
+	GetOutboxEvent(ctx context.Context, id int64) (Outbox, error)
+	GetPayee(ctx context.Context, id int64) (Payee, error)
+	GetPaymentBatch(ctx context.Context, arg GetPaymentBatchParams) (PaymentBatch, error)
+	GetPaymentRequest(ctx context.Context, id int64) (PaymentRequest, error)
+	GetPaymentRequestForUpdate(ctx context.Context, id int64) (PaymentRequest, error)
 	GetTransfer(ctx context.Context, id int64) (Transfer, error)
+	GetTransferApproval(ctx context.Context, id int64) (TransferApproval, error)
+	GetTransferApprovalForUpdate(ctx context.Context, id int64) (TransferApproval, error)
 	GetUser(ctx context.Context, username string) (User, error)
+	GetUserByID(ctx context.Context, id int64) (User, error)
+	GetWebhookDelivery(ctx context.Context, arg GetWebhookDeliveryParams) (WebhookDelivery, error)
+	GetWebhookDeliveryForUpdate(ctx context.Context, id int64) (WebhookDelivery, error)
+	GetWebhookSubscription(ctx context.Context, arg GetWebhookSubscriptionParams) (WebhookSubscription, error)
+	GetWebhookSubscriptionByID(ctx context.Context, id int64) (WebhookSubscription, error)
+	ListAccountEntriesAfter(ctx context.Context, arg ListAccountEntriesAfterParams) ([]ListAccountEntriesAfterRow, error)
+	ListAccountHolders(ctx context.Context, accountID int64) ([]AccountHolder, error)
 	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error)
+	ListAccountsWithRoles(ctx context.Context, arg ListAccountsWithRolesParams) ([]ListAccountsWithRolesRow, error)
+	ListAdminAuditLogs(ctx context.Context, arg ListAdminAuditLogsParams) ([]AdminAuditLog, error)
+	ListAggregateOutboxEvents(ctx context.Context, arg ListAggregateOutboxEventsParams) ([]Outbox, error)
+	ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error)
+	ListBalanceAdjustments(ctx context.Context, arg ListBalanceAdjustmentsParams) ([]BalanceAdjustment, error)
+	ListBeneficiaries(ctx context.Context, arg ListBeneficiariesParams) ([]Beneficiary, error)
 	ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error)
+	ListEntriesForPeriod(ctx context.Context, arg ListEntriesForPeriodParams) ([]Entry, error)
+	ListEntryChain(ctx context.Context, arg ListEntryChainParams) ([]Entry, error)
+	ListExternalTransfers(ctx context.Context, arg ListExternalTransfersParams) ([]ExternalTransfer, error)
+	ListHeldAccounts(ctx context.Context, arg ListHeldAccountsParams) ([]Account, error)
+	ListHeldAccountsByUsers(ctx context.Context, arg ListHeldAccountsByUsersParams) ([]ListHeldAccountsByUsersRow, error)
+	ListIncomingPaymentRequests(ctx context.Context, arg ListIncomingPaymentRequestsParams) ([]PaymentRequest, error)
+	ListMemberOrganisations(ctx context.Context, userID int64) ([]Organisation, error)
+	ListOrganisationAccounts(ctx context.Context, organisationID int64) ([]Account, error)
+	ListOrganisationMembers(ctx context.Context, organisationID int64) ([]OrganisationMember, error)
+	ListOutboundPayments(ctx context.Context, arg ListOutboundPaymentsParams) ([]OutboundPayment, error)
+	ListOutgoingPaymentRequests(ctx context.Context, arg ListOutgoingPaymentRequestsParams) ([]PaymentRequest, error)
+	ListPayees(ctx context.Context, arg ListPayeesParams) ([]Payee, error)
+	ListPendingAccountInvitations(ctx context.Context, inviteeID int64) ([]AccountInvitation, error)
+	ListPendingOutboundPaymentsForUpdate(ctx context.Context, rail string) ([]OutboundPayment, error)
+	ListPendingOutboxEventsForUpdate(ctx context.Context, limit int32) ([]Outbox, error)
+	ListPendingTransferApprovals(ctx context.Context, organisationID int64) ([]TransferApproval, error)
+	ListPots(ctx context.Context, parentID int64) ([]Account, error)
+	ListRecentTransfersByAccounts(ctx context.Context, arg ListRecentTransfersByAccountsParams) ([]ListRecentTransfersByAccountsRow, error)
 	ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error)
-	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
+	ListUsersByIDs(ctx context.Context, ids json.RawMessage) ([]User, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhookSubscriptions(ctx context.Context, userID int64) ([]WebhookSubscription, error)
+	MarkOutboundPaymentBatched(ctx context.Context, arg MarkOutboundPaymentBatchedParams) (OutboundPayment, error)
+	MarkOutboundPaymentReturned(ctx context.Context, arg MarkOutboundPaymentReturnedParams) (OutboundPayment, error)
+	MarkOutboundPaymentSubmitted(ctx context.Context, arg MarkOutboundPaymentSubmittedParams) (OutboundPayment, error)
+	MarkOutboxEventPublished(ctx context.Context, id int64) (Outbox, error)
+	MarkPaymentRequestAccepted(ctx context.Context, arg MarkPaymentRequestAcceptedParams) (PaymentRequest, error)
+	MarkTransferApprovalApproved(ctx context.Context, arg MarkTransferApprovalApprovedParams) (TransferApproval, error)
+	RecordOutboxEventFailure(ctx context.Context, arg RecordOutboxEventFailureParams) (Outbox, error)
+	RecordWebhookDeliveryAttempt(ctx context.Context, arg RecordWebhookDeliveryAttemptParams) (WebhookDelivery, error)
+	RecordWebhookSubscriptionFailure(ctx context.Context, arg RecordWebhookSubscriptionFailureParams) (WebhookSubscription, error)
+	RecordWebhookSubscriptionSuccess(ctx context.Context, id int64) (WebhookSubscription, error)
+	RejectPendingTransferApproval(ctx context.Context, arg RejectPendingTransferApprovalParams) (TransferApproval, error)
+	ReplayWebhookDelivery(ctx context.Context, id int64) (WebhookDelivery, error)
+	SearchAccountEntries(ctx context.Context, arg SearchAccountEntriesParams) ([]Entry, error)
+	SearchAccountTransfers(ctx context.Context, arg SearchAccountTransfersParams) ([]Transfer, error)
+	SearchAccounts(ctx context.Context, arg SearchAccountsParams) ([]Account, error)
+	SearchUsers(ctx context.Context, arg SearchUsersParams) ([]User, error)
+	SetAuditContext(ctx context.Context, arg SetAuditContextParams) error
+	SumEntriesSince(ctx context.Context, arg SumEntriesSinceParams) (int64, error)
+	SumPotBalances(ctx context.Context, parentID int64) (int64, error)
+	UnfreezeAccount(ctx context.Context, id int64) ([]Account, error)
+	UpdateExternalTransferStatus(ctx context.Context, arg UpdateExternalTransferStatusParams) (ExternalTransfer, error)
+	UpdateOrganisationMember(ctx context.Context, arg UpdateOrganisationMemberParams) (OrganisationMember, error)
+	UpdatePayeeNickname(ctx context.Context, arg UpdatePayeeNicknameParams) (Payee, error)
+	UpdatePaymentBatchResult(ctx context.Context, arg UpdatePaymentBatchResultParams) (PaymentBatch, error)
+	UpdatePendingAccountInvitationStatus(ctx context.Context, arg UpdatePendingAccountInvitationStatusParams) (AccountInvitation, error)
+	UpdatePendingPaymentRequestStatus(ctx context.Context, arg UpdatePendingPaymentRequestStatusParams) (PaymentRequest, error)
+	UpdatePot(ctx context.Context, arg UpdatePotParams) (Account, error)
+	UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (User, error)
+	UpdateWebhookSubscription(ctx context.Context, arg UpdateWebhookSubscriptionParams) (WebhookSubscription, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountStatementTx(t *testing.T) {
+	store := NewStore(testDBConnection)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	transfer, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	statement, err := store.AccountStatementTx(context.Background(), AccountStatementTxParams{
+		AccountID: account1.ID,
+		From:      time.Now().Add(-time.Hour),
+		To:        time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, account1.ID, statement.Account.ID)
+	require.Equal(t, account1.Balance, statement.OpeningBalance)
+	require.Equal(t, account1.Balance-10, statement.ClosingBalance)
+	require.Len(t, statement.Entries, 1)
+	require.Equal(t, transfer.FromEntry.ID, statement.Entries[0].ID)
+	require.Equal(t, transfer.Transfer.ID, statement.Entries[0].TransferID.Int64)
+
+	statement, err = store.AccountStatementTx(context.Background(), AccountStatementTxParams{
+		AccountID: account1.ID,
+		From:      time.Now().Add(-2 * time.Hour),
+		To:        time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, err)
+	require.Empty(t, statement.Entries)
+	require.Equal(t, account1.Balance, statement.ClosingBalance)
+}
@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"time"
+)
+
+// AccountStatementTxParams contains the input parameters of the account statement transaction,
+// entries booked at From are included, entries booked at To are not
+type AccountStatementTxParams struct {
+	AccountID int64     `json:"account_id"`
+	From      time.Time `json:"from"`
+	To        time.Time `json:"to"`
+}
+
+// AccountStatement is an account with the entries booked during a period
+// and its balance before and after them
+type AccountStatement struct {
+	Account        Account   `json:"account"`
+	From           time.Time `json:"from"`
+	To             time.Time `json:"to"`
+	OpeningBalance int64     `json:"opening_balance"`
+	ClosingBalance int64     `json:"closing_balance"`
+	Entries        []Entry   `json:"entries"`
+}
+
+// AccountStatementTx reads the entries of an account for a period. The opening balance is worked
+// out backwards from the current balance, so accounts opened with a balance are still reported right
+func (store *SQLStore) AccountStatementTx(ctx context.Context, arg AccountStatementTxParams) (AccountStatement, error) {
+	result := AccountStatement{
+		From: arg.From,
+		To:   arg.To,
+	}
+	err := store.execReadTx(ctx, func(q *Queries) error {
+		var err error
+		result.Account, err = q.GetAccount(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		sinceFrom, err := q.SumEntriesSince(ctx, SumEntriesSinceParams{
+			AccountID: arg.AccountID,
+			Since:     arg.From,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Entries, err = q.ListEntriesForPeriod(ctx, ListEntriesForPeriodParams{
+			AccountID: arg.AccountID,
+			FromTime:  arg.From,
+			ToTime:    arg.To,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.OpeningBalance = result.Account.Balance - sinceFrom
+		result.ClosingBalance = result.OpeningBalance
+		for _, entry := range result.Entries {
+			result.ClosingBalance += entry.Amount
+		}
+		return nil
+	})
+	return result, err
+}
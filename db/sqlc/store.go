@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 )
 
 //go:generate mockgen -source store.go -destination mocks/mockStore.go -package mocks
@@ -11,6 +12,24 @@ import (
 type Store interface {
 	Querier
 	TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error)
+	AdjustBalanceTx(ctx context.Context, arg AdjustBalanceTxParams) (AdjustBalanceTxResult, error)
+	StartExternalTransferTx(ctx context.Context, arg StartExternalTransferTxParams) (ExternalTransferTxResult, error)
+	CompleteExternalTransferTx(ctx context.Context, arg CompleteExternalTransferTxParams) (ExternalTransferTxResult, error)
+	FailExternalTransferTx(ctx context.Context, arg FailExternalTransferTxParams) (ExternalTransferTxResult, error)
+	AccountStatementTx(ctx context.Context, arg AccountStatementTxParams) (AccountStatement, error)
+	CreateOutboundPaymentTx(ctx context.Context, arg CreateOutboundPaymentTxParams) (OutboundPaymentTxResult, error)
+	SubmitOutboundPaymentsTx(ctx context.Context, arg SubmitOutboundPaymentsTxParams) (SubmitOutboundPaymentsTxResult, error)
+	SubmitSEPAPaymentsTx(ctx context.Context, arg SubmitSEPAPaymentsTxParams) (SubmitSEPAPaymentsTxResult, error)
+	ReturnOutboundPaymentTx(ctx context.Context, arg ReturnOutboundPaymentTxParams) (OutboundPaymentTxResult, error)
+	AcceptPaymentRequestTx(ctx context.Context, arg AcceptPaymentRequestTxParams) (AcceptPaymentRequestTxResult, error)
+	CreateAccountTx(ctx context.Context, arg CreateAccountTxParams) (CreateAccountTxResult, error)
+	AcceptAccountInvitationTx(ctx context.Context, invitationID int64) (AcceptAccountInvitationTxResult, error)
+	MovePotTx(ctx context.Context, arg MovePotTxParams) (TransferTxResult, error)
+	CreateOrganisationTx(ctx context.Context, arg CreateOrganisationTxParams) (CreateOrganisationTxResult, error)
+	ApproveTransferTx(ctx context.Context, arg ApproveTransferTxParams) (ApproveTransferTxResult, error)
+	VerifyEntryChainTx(ctx context.Context, arg VerifyEntryChainTxParams) (EntryChainVerification, error)
+	RelayOutboxTx(ctx context.Context, arg RelayOutboxTxParams) (RelayOutboxTxResult, error)
+	RecordWebhookAttemptTx(ctx context.Context, arg RecordWebhookAttemptTxParams) (RecordWebhookAttemptTxResult, error)
 }
 
 // Store provides all functions to execute db queries and transactions
@@ -27,13 +46,35 @@ func NewStore(db *sql.DB) Store {
 	}
 }
 
-// execTx executes a function within a database transaction
+// execTx executes a function within a database transaction,
+// the changes it makes are audited with the audit context of ctx
 func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) error {
 	tx, err := store.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	q := New(tx)
+	err = applyAuditContext(ctx, q)
+	if err == nil {
+		err = fn(q)
+	}
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return rbErr
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// execReadTx executes a function within a read only database transaction,
+// every query inside sees the same snapshot of the database
+func (store *SQLStore) execReadTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := store.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	q := New(tx)
 	err = fn(q)
 	if err != nil {
 		if rbErr := tx.Rollback(); rbErr != nil {
@@ -44,11 +85,15 @@ func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) erro
 	return tx.Commit()
 }
 
-// TransferTxParams contains the input parameters of the transfer transaction
+// TransferTxParams contains the input parameters of the transfer transaction.
+// Description, Reference and Metadata are stored on the transfer and copied to both entries
 type TransferTxParams struct {
-	FromAccountID int64 `json:"from_account_id"`
-	ToAccountID   int64 `json:"to_account_id"`
-	Amount        int64 `json:"amount"`
+	FromAccountID int64           `json:"from_account_id"`
+	ToAccountID   int64           `json:"to_account_id"`
+	Amount        int64           `json:"amount"`
+	Description   string          `json:"description"`
+	Reference     string          `json:"reference"`
+	Metadata      json.RawMessage `json:"metadata"`
 }
 
 // TransferTxResult is the result of the transfer transaction
@@ -64,48 +109,86 @@ func (store *SQLStore) TransferTx(ctx context.Context, arg TransferTxParams) (Tr
 	var result TransferTxResult
 	err := store.execTx(ctx, func(q *Queries) error {
 		var err error
-		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
-			FromAccountID: arg.FromAccountID,
-			ToAccountID:   arg.ToAccountID,
-			Amount:        arg.Amount,
-		})
-		if err != nil {
-			return err
-		}
+		result, err = transfer(ctx, q, arg)
+		return err
+	})
+	return result, err
+}
 
-		result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.FromAccountID,
-			Amount:    -arg.Amount,
-		})
-		if err != nil {
-			return err
-		}
+// transfer moves money between two accounts inside the transaction of q
+func transfer(ctx context.Context, q *Queries, arg TransferTxParams) (TransferTxResult, error) {
+	var result TransferTxResult
+	metadata := arg.Metadata
+	if len(metadata) == 0 {
+		metadata = json.RawMessage("{}")
+	}
 
-		result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.ToAccountID,
-			Amount:    arg.Amount,
-		})
-		if err != nil {
-			return err
-		}
+	var err error
+	result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+		Description:   arg.Description,
+		Reference:     arg.Reference,
+		Metadata:      metadata,
+	})
+	if err != nil {
+		return result, err
+	}
 
-		result.FromAccount, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
-			ID:     arg.FromAccountID,
-			Amount: -arg.Amount,
-		})
-		if err != nil {
-			return err
-		}
+	transferID := sql.NullInt64{Int64: result.Transfer.ID, Valid: true}
+	result.FromEntry, err = postEntry(ctx, q, CreateTransferEntryParams{
+		AccountID:   arg.FromAccountID,
+		Amount:      -arg.Amount,
+		TransferID:  transferID,
+		Description: arg.Description,
+		Reference:   arg.Reference,
+		Metadata:    metadata,
+	})
+	if err != nil {
+		return result, err
+	}
 
-		result.ToAccount, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
-			ID:     arg.ToAccountID,
-			Amount: arg.Amount,
-		})
-		if err != nil {
-			return err
-		}
+	result.ToEntry, err = postEntry(ctx, q, CreateTransferEntryParams{
+		AccountID:   arg.ToAccountID,
+		Amount:      arg.Amount,
+		TransferID:  transferID,
+		Description: arg.Description,
+		Reference:   arg.Reference,
+		Metadata:    metadata,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.FromAccount, err = q.addAccountBalance(ctx, AddAccountBalanceParams{
+		ID:     arg.FromAccountID,
+		Amount: -arg.Amount,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.ToAccount, err = q.addAccountBalance(ctx, AddAccountBalanceParams{
+		ID:     arg.ToAccountID,
+		Amount: arg.Amount,
+	})
+	if err != nil {
+		return result, err
+	}
 
-		return nil
+	err = enqueueEvent(ctx, q, EventTypeTransferPosted, 1, AggregateTypeTransfer, result.Transfer.ID, TransferPostedV1{
+		TransferID:    result.Transfer.ID,
+		FromAccountID: result.Transfer.FromAccountID,
+		ToAccountID:   result.Transfer.ToAccountID,
+		Amount:        result.Transfer.Amount,
+		Currency:      result.FromAccount.Currency,
+		Description:   result.Transfer.Description,
+		Reference:     result.Transfer.Reference,
+		Metadata:      result.Transfer.Metadata,
+		FromBalance:   result.FromAccount.Balance,
+		ToBalance:     result.ToAccount.Balance,
+		CreatedAt:     result.Transfer.CreatedAt,
 	})
 	return result, err
 }
@@ -2,6 +2,8 @@ package db
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -64,6 +66,8 @@ func TestTransferTx(t *testing.T) {
 		require.Equal(t, -amount, fromEntry.Amount)
 		require.Equal(t, account2.ID, toEntry.AccountID)
 		require.Equal(t, amount, toEntry.Amount)
+		require.Equal(t, result.Result.Transfer.ID, fromEntry.TransferID.Int64)
+		require.Equal(t, result.Result.Transfer.ID, toEntry.TransferID.Int64)
 		require.NotZero(t, fromEntry.CreatedAt)
 		require.NotZero(t, toEntry.CreatedAt)
 
@@ -105,3 +109,91 @@ func TestTransferTx(t *testing.T) {
 	require.Equal(t, account2.Balance+int64(ttTransactions)*amount, updatedAccount2.Balance)
 
 }
+
+func TestTransferTxDetails(t *testing.T) {
+	store := NewStore(testDBConnection)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        1,
+		Description:   "Rent March",
+		Reference:     "INV-2024/0042",
+		Metadata:      json.RawMessage(`{"order_id":"42"}`),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Rent March", result.Transfer.Description)
+	require.Equal(t, "INV-2024/0042", result.Transfer.Reference)
+	require.JSONEq(t, `{"order_id":"42"}`, string(result.Transfer.Metadata))
+	for _, entry := range []Entry{result.FromEntry, result.ToEntry} {
+		require.Equal(t, result.Transfer.Description, entry.Description)
+		require.Equal(t, result.Transfer.Reference, entry.Reference)
+		require.JSONEq(t, `{"order_id":"42"}`, string(entry.Metadata))
+	}
+
+	plain, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        1,
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{}`, string(plain.Transfer.Metadata))
+
+	search := func(reference, description, metadata string) []Transfer {
+		transfers, err := store.SearchAccountTransfers(context.Background(), SearchAccountTransfersParams{
+			AccountID:   account2.ID,
+			Reference:   reference,
+			Description: description,
+			Metadata:    json.RawMessage(metadata),
+			PageLimit:   5,
+		})
+		require.NoError(t, err)
+		return transfers
+	}
+	require.Len(t, search("", "", `{}`), 2)
+	require.Len(t, search("INV-2024/0042", "", `{}`), 1)
+	require.Len(t, search("", "rent", `{}`), 1)
+	require.Len(t, search("", "", `{"order_id":"42"}`), 1)
+	require.Len(t, search("", "", `{"order_id":"43"}`), 0)
+
+	entries, err := store.SearchAccountEntries(context.Background(), SearchAccountEntriesParams{
+		AccountID:   account1.ID,
+		Description: "RENT",
+		Metadata:    json.RawMessage(`{}`),
+		PageLimit:   5,
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, result.FromEntry.ID, entries[0].ID)
+}
+
+func TestListRecentTransfersByAccounts(t *testing.T) {
+	store := NewStore(testDBConnection)
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	account3 := createRandomAccount(t)
+
+	var transfers []Transfer
+	for _, to := range []int64{account2.ID, account3.ID, account2.ID} {
+		result, err := store.TransferTx(context.Background(), TransferTxParams{FromAccountID: account1.ID, ToAccountID: to, Amount: 1})
+		require.NoError(t, err)
+		transfers = append(transfers, result.Transfer)
+	}
+
+	rows, err := testQueries.ListRecentTransfersByAccounts(context.Background(), ListRecentTransfersByAccountsParams{
+		AccountIds: json.RawMessage(fmt.Sprintf("[%d,%d]", account1.ID, account2.ID)),
+		PageLimit:  2,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+
+	// the latest transfers of every account, the latest first
+	require.Equal(t, account1.ID, rows[0].AccountID)
+	require.Equal(t, transfers[2].ID, rows[0].ID)
+	require.Equal(t, transfers[1].ID, rows[1].ID)
+	require.Equal(t, account2.ID, rows[2].AccountID)
+	require.Equal(t, transfers[2].ID, rows[2].ID)
+	require.Equal(t, transfers[0].ID, rows[3].ID)
+}
@@ -7,26 +7,41 @@ package db
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 )
 
 const createTransfer = `-- name: CreateTransfer :one
 INSERT INTO transfers (
     from_account_id,
     to_account_id,
-    amount
+    amount,
+    description,
+    reference,
+    metadata
 ) VALUES (
-             $1, $2, $3
-         ) RETURNING id, from_account_id, to_account_id, amount, created_at
+             $1, $2, $3, $4, $5, $6
+         ) RETURNING id, from_account_id, to_account_id, amount, created_at, description, reference, metadata
 `
 
 type CreateTransferParams struct {
-	FromAccountID int64 `json:"from_account_id"`
-	ToAccountID   int64 `json:"to_account_id"`
-	Amount        int64 `json:"amount"`
+	FromAccountID int64           `json:"from_account_id"`
+	ToAccountID   int64           `json:"to_account_id"`
+	Amount        int64           `json:"amount"`
+	Description   string          `json:"description"`
+	Reference     string          `json:"reference"`
+	Metadata      json.RawMessage `json:"metadata"`
 }
 
 func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error) {
-	row := q.db.QueryRowContext(ctx, createTransfer, arg.FromAccountID, arg.ToAccountID, arg.Amount)
+	row := q.db.QueryRowContext(ctx, createTransfer,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.Description,
+		arg.Reference,
+		arg.Metadata,
+	)
 	var i Transfer
 	err := row.Scan(
 		&i.ID,
@@ -34,12 +49,15 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.Description,
+		&i.Reference,
+		&i.Metadata,
 	)
 	return i, err
 }
 
 const getTransfer = `-- name: GetTransfer :one
-SELECT id, from_account_id, to_account_id, amount, created_at FROM transfers
+SELECT id, from_account_id, to_account_id, amount, created_at, description, reference, metadata FROM transfers
 WHERE id = $1 LIMIT 1
 `
 
@@ -52,12 +70,77 @@ func (q *Queries) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.Description,
+		&i.Reference,
+		&i.Metadata,
 	)
 	return i, err
 }
 
+const listRecentTransfersByAccounts = `-- name: ListRecentTransfersByAccounts :many
+SELECT ids.account_id::bigint AS account_id, transfers.id, transfers.from_account_id, transfers.to_account_id, transfers.amount, transfers.created_at, transfers.description, transfers.reference, transfers.metadata
+FROM jsonb_array_elements_text($1::jsonb) AS ids(account_id)
+CROSS JOIN LATERAL (
+    SELECT * FROM transfers
+    WHERE from_account_id = ids.account_id::bigint OR to_account_id = ids.account_id::bigint
+    ORDER BY id DESC
+    LIMIT $2
+) transfers
+ORDER BY account_id, transfers.id DESC
+`
+
+type ListRecentTransfersByAccountsParams struct {
+	AccountIds json.RawMessage `json:"account_ids"`
+	PageLimit  int32           `json:"page_limit"`
+}
+
+type ListRecentTransfersByAccountsRow struct {
+	AccountID     int64           `json:"account_id"`
+	ID            int64           `json:"id"`
+	FromAccountID int64           `json:"from_account_id"`
+	ToAccountID   int64           `json:"to_account_id"`
+	Amount        int64           `json:"amount"`
+	CreatedAt     time.Time       `json:"created_at"`
+	Description   string          `json:"description"`
+	Reference     string          `json:"reference"`
+	Metadata      json.RawMessage `json:"metadata"`
+}
+
+func (q *Queries) ListRecentTransfersByAccounts(ctx context.Context, arg ListRecentTransfersByAccountsParams) ([]ListRecentTransfersByAccountsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRecentTransfersByAccounts, arg.AccountIds, arg.PageLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRecentTransfersByAccountsRow{}
+	for rows.Next() {
+		var i ListRecentTransfersByAccountsRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.Description,
+			&i.Reference,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTransfers = `-- name: ListTransfers :many
-SELECT id, from_account_id, to_account_id, amount, created_at FROM transfers
+SELECT id, from_account_id, to_account_id, amount, created_at, description, reference, metadata FROM transfers
 WHERE
     from_account_id = $1 OR
     to_account_id = $2
@@ -93,6 +176,68 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 			&i.ToAccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.Description,
+			&i.Reference,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchAccountTransfers = `-- name: SearchAccountTransfers :many
+SELECT id, from_account_id, to_account_id, amount, created_at, description, reference, metadata FROM transfers
+WHERE (from_account_id = $1 OR to_account_id = $1)
+  AND ($2::varchar = '' OR reference = $2::varchar)
+  AND strpos(lower(description), lower($3::varchar)) > 0
+  AND metadata @> $4::jsonb
+ORDER BY id
+LIMIT $5
+OFFSET $6
+`
+
+type SearchAccountTransfersParams struct {
+	AccountID   int64           `json:"account_id"`
+	Reference   string          `json:"reference"`
+	Description string          `json:"description"`
+	Metadata    json.RawMessage `json:"metadata"`
+	PageLimit   int32           `json:"page_limit"`
+	PageOffset  int32           `json:"page_offset"`
+}
+
+func (q *Queries) SearchAccountTransfers(ctx context.Context, arg SearchAccountTransfersParams) ([]Transfer, error) {
+	rows, err := q.db.QueryContext(ctx, searchAccountTransfers,
+		arg.AccountID,
+		arg.Reference,
+		arg.Description,
+		arg.Metadata,
+		arg.PageLimit,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.Description,
+			&i.Reference,
+			&i.Metadata,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,155 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Sinothic/simplebank/util"
+	"github.com/go-faker/faker/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomWebhookSubscription(t *testing.T, userID int64, eventTypes ...string) WebhookSubscription {
+	types, err := json.Marshal(eventTypes)
+	require.NoError(t, err)
+
+	subscription, err := testQueries.CreateWebhookSubscription(context.Background(), CreateWebhookSubscriptionParams{
+		UserID:     userID,
+		Url:        "https://example.com/" + faker.Word(),
+		EventTypes: types,
+		Secret:     "whsec_" + faker.UUIDDigit(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.WebhookStatusActive, subscription.Status)
+	return subscription
+}
+
+// transferEvent posts a transfer from a new account of the user and returns its outbox event
+func transferEvent(t *testing.T, userID int64) (Account, Outbox) {
+	store := NewStore(testDBConnection)
+	from, err := store.CreateAccountTx(context.Background(), CreateAccountTxParams{UserID: userID, Currency: util.CAD})
+	require.NoError(t, err)
+	to := createRandomAccountWithCurrency(t, util.CAD)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{FromAccountID: from.Account.ID, ToAccountID: to.ID, Amount: 10})
+	require.NoError(t, err)
+
+	events, err := testQueries.ListAggregateOutboxEvents(context.Background(), ListAggregateOutboxEventsParams{
+		AggregateType: AggregateTypeTransfer,
+		AggregateID:   strconv.FormatInt(result.Transfer.ID, 10),
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	return from.Account, events[0]
+}
+
+func TestCreateWebhookDeliveries(t *testing.T) {
+	user := createRandomAccountWithCurrency(t, util.USD).UserID
+	transfers := createRandomWebhookSubscription(t, user, EventTypeTransferPosted, EventTypeBalanceAdjusted)
+	createRandomWebhookSubscription(t, user, EventTypeAccountCreated)
+	other := createRandomWebhookSubscription(t, createRandomAccountWithCurrency(t, util.USD).UserID, EventTypeTransferPosted)
+
+	account, event := transferEvent(t, user)
+	arg := CreateWebhookDeliveriesParams{
+		EventID:   event.ID,
+		EventType: event.EventType,
+		Payload:   event.Payload,
+		AccountID: account.ID,
+	}
+
+	deliveries, err := testQueries.CreateWebhookDeliveries(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, transfers.ID, deliveries[0].SubscriptionID)
+	require.NotEqual(t, other.ID, deliveries[0].SubscriptionID)
+	require.Equal(t, util.WebhookDeliveryStatusPending, deliveries[0].Status)
+
+	// the event is relayed again
+	deliveries, err = testQueries.CreateWebhookDeliveries(context.Background(), arg)
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+}
+
+func TestRecordWebhookAttemptTx(t *testing.T) {
+	store := NewStore(testDBConnection)
+	user := createRandomAccountWithCurrency(t, util.USD).UserID
+	subscription := createRandomWebhookSubscription(t, user, EventTypeTransferPosted)
+
+	account, event := transferEvent(t, user)
+	deliveries, err := testQueries.CreateWebhookDeliveries(context.Background(), CreateWebhookDeliveriesParams{
+		EventID:   event.ID,
+		EventType: event.EventType,
+		Payload:   event.Payload,
+		AccountID: account.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	delivery := deliveries[0]
+
+	failure := RecordWebhookAttemptTxParams{
+		DeliveryID:     delivery.ID,
+		SubscriptionID: subscription.ID,
+		StatusCode:     500,
+		Error:          "receiver answered 500 Internal Server Error",
+		NextAttemptAt:  time.Now().Add(time.Minute),
+		DisableAfter:   2,
+	}
+	result, err := store.RecordWebhookAttemptTx(context.Background(), failure)
+	require.NoError(t, err)
+	require.Equal(t, util.WebhookDeliveryStatusPending, result.Delivery.Status)
+	require.Equal(t, int32(1), result.Delivery.Attempts)
+	require.Equal(t, int32(500), result.Delivery.LastStatusCode)
+	require.Equal(t, int32(1), result.Subscription.ConsecutiveFailures)
+	require.Equal(t, util.WebhookStatusActive, result.Subscription.Status)
+
+	failure.GiveUp = true
+	result, err = store.RecordWebhookAttemptTx(context.Background(), failure)
+	require.NoError(t, err)
+	require.Equal(t, util.WebhookDeliveryStatusFailed, result.Delivery.Status)
+	require.Equal(t, util.WebhookStatusDisabled, result.Subscription.Status)
+	require.True(t, result.Subscription.DisabledAt.Valid)
+
+	replayed, err := store.ReplayWebhookDelivery(context.Background(), delivery.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.WebhookDeliveryStatusPending, replayed.Status)
+	require.Zero(t, replayed.Attempts)
+
+	_, err = store.ReplayWebhookDelivery(context.Background(), delivery.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// the deliveries of a disabled subscription wait until it is enabled again
+	claimed, err := testQueries.ClaimDueWebhookDeliveries(context.Background(), ClaimDueWebhookDeliveriesParams{LeasedUntil: time.Now().Add(time.Minute), PageLimit: 1000})
+	require.NoError(t, err)
+	for _, claim := range claimed {
+		require.NotEqual(t, delivery.ID, claim.ID)
+	}
+
+	enabled, err := store.UpdateWebhookSubscription(context.Background(), UpdateWebhookSubscriptionParams{
+		ID:         subscription.ID,
+		UserID:     user,
+		Url:        subscription.Url,
+		EventTypes: subscription.EventTypes,
+		Status:     util.WebhookStatusActive,
+	})
+	require.NoError(t, err)
+	require.Zero(t, enabled.ConsecutiveFailures)
+	require.False(t, enabled.DisabledAt.Valid)
+
+	result, err = store.RecordWebhookAttemptTx(context.Background(), RecordWebhookAttemptTxParams{
+		DeliveryID:     delivery.ID,
+		SubscriptionID: subscription.ID,
+		Succeeded:      true,
+		StatusCode:     204,
+		NextAttemptAt:  time.Now(),
+		DisableAfter:   2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.WebhookDeliveryStatusSucceeded, result.Delivery.Status)
+	require.True(t, result.Delivery.DeliveredAt.Valid)
+	require.Empty(t, result.Delivery.LastError)
+	require.Zero(t, result.Subscription.ConsecutiveFailures)
+}
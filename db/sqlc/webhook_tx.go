@@ -0,0 +1,67 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/Sinothic/simplebank/util"
+)
+
+// RecordWebhookAttemptTxParams contains the input parameters of the record webhook attempt transaction.
+// A failed delivery is tried again at NextAttemptAt unless GiveUp is set, the subscription is disabled
+// once DisableAfter attempts failed in a row
+type RecordWebhookAttemptTxParams struct {
+	DeliveryID     int64     `json:"delivery_id"`
+	SubscriptionID int64     `json:"subscription_id"`
+	Succeeded      bool      `json:"succeeded"`
+	StatusCode     int32     `json:"status_code"`
+	Error          string    `json:"error"`
+	NextAttemptAt  time.Time `json:"next_attempt_at"`
+	GiveUp         bool      `json:"give_up"`
+	DisableAfter   int32     `json:"disable_after"`
+}
+
+// RecordWebhookAttemptTxResult is the result of the record webhook attempt transaction
+type RecordWebhookAttemptTxResult struct {
+	Delivery     WebhookDelivery     `json:"delivery"`
+	Subscription WebhookSubscription `json:"subscription"`
+}
+
+// RecordWebhookAttemptTx records the outcome of an attempt to deliver a webhook on the delivery and
+// counts the failures of its subscription in a row
+func (store *SQLStore) RecordWebhookAttemptTx(ctx context.Context, arg RecordWebhookAttemptTxParams) (RecordWebhookAttemptTxResult, error) {
+	var result RecordWebhookAttemptTxResult
+	err := store.execTx(ctx, func(q *Queries) error {
+		status := util.WebhookDeliveryStatusPending
+		switch {
+		case arg.Succeeded:
+			status = util.WebhookDeliveryStatusSucceeded
+		case arg.GiveUp:
+			status = util.WebhookDeliveryStatusFailed
+		}
+
+		var err error
+		result.Delivery, err = q.RecordWebhookDeliveryAttempt(ctx, RecordWebhookDeliveryAttemptParams{
+			ID:             arg.DeliveryID,
+			Status:         status,
+			NextAttemptAt:  arg.NextAttemptAt,
+			LastStatusCode: arg.StatusCode,
+			LastError:      arg.Error,
+		})
+		if err != nil {
+			return err
+		}
+
+		if arg.Succeeded {
+			result.Subscription, err = q.RecordWebhookSubscriptionSuccess(ctx, arg.SubscriptionID)
+			return err
+		}
+
+		result.Subscription, err = q.RecordWebhookSubscriptionFailure(ctx, RecordWebhookSubscriptionFailureParams{
+			ID:           arg.SubscriptionID,
+			DisableAfter: arg.DisableAfter,
+		})
+		return err
+	})
+	return result, err
+}
@@ -0,0 +1,55 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FilePublisher appends the events to a file as JSON lines, each event is synced to disk before
+// Publish returns
+type FilePublisher struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFilePublisher creates a new FilePublisher writing to path
+func NewFilePublisher(path string) *FilePublisher {
+	return &FilePublisher{path: path}
+}
+
+func (publisher *FilePublisher) Publish(ctx context.Context, envelope Envelope) error {
+	line, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(publisher.path), 0o750); err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(publisher.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o640)
+	if err != nil {
+		return err
+	}
+
+	if _, err := out.Write(append(line, '\n')); err != nil {
+		out.Close()
+		return err
+	}
+
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func (publisher *FilePublisher) Close() error {
+	return nil
+}
@@ -0,0 +1,47 @@
+package event
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryPublisher keeps the published events in memory, unless told otherwise with SetError
+type MemoryPublisher struct {
+	mu        sync.Mutex
+	err       error
+	envelopes []Envelope
+}
+
+// NewMemoryPublisher creates a new MemoryPublisher
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (publisher *MemoryPublisher) Publish(ctx context.Context, envelope Envelope) error {
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+
+	if publisher.err != nil {
+		return publisher.err
+	}
+	publisher.envelopes = append(publisher.envelopes, envelope)
+	return nil
+}
+
+func (publisher *MemoryPublisher) Close() error {
+	return nil
+}
+
+// SetError makes the next publications fail with err until it is reset with nil
+func (publisher *MemoryPublisher) SetError(err error) {
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+	publisher.err = err
+}
+
+// Envelopes returns the events published so far
+func (publisher *MemoryPublisher) Envelopes() []Envelope {
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+	return append([]Envelope(nil), publisher.envelopes...)
+}
@@ -0,0 +1,34 @@
+package event
+
+import (
+	"context"
+	"errors"
+)
+
+// MultiPublisher publishes every event to each of its publishers in turn. When one fails the event is
+// published again to all of them, so the publishers have to cope with duplicates anyway
+type MultiPublisher struct {
+	publishers []Publisher
+}
+
+// NewMultiPublisher creates a new MultiPublisher
+func NewMultiPublisher(publishers ...Publisher) *MultiPublisher {
+	return &MultiPublisher{publishers: publishers}
+}
+
+func (multi *MultiPublisher) Publish(ctx context.Context, envelope Envelope) error {
+	for _, publisher := range multi.publishers {
+		if err := publisher.Publish(ctx, envelope); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (multi *MultiPublisher) Close() error {
+	var errs []error
+	for _, publisher := range multi.publishers {
+		errs = append(errs, publisher.Close())
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,167 @@
+package event
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// natsTimeout bounds the time the broker has to acknowledge a publication
+const natsTimeout = 10 * time.Second
+
+// NATSPublisher publishes the events on a NATS server, or any broker speaking the NATS client protocol,
+// under the subject prefix.Type.vVersion. Every publication is followed by a PING and only counts once
+// the broker answered PONG, so the broker has processed it. The connection is opened on the first
+// publication and opened again after an error
+type NATSPublisher struct {
+	mu      sync.Mutex
+	address string
+	prefix  string
+	conn    net.Conn
+	reader  *bufio.Reader
+}
+
+// NewNATSPublisher creates a new NATSPublisher for a nats://host:port URL
+func NewNATSPublisher(rawURL string, prefix string) (*NATSPublisher, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid nats url %q: %w", rawURL, err)
+	}
+
+	if u.Scheme != "nats" || u.Host == "" {
+		return nil, fmt.Errorf("invalid nats url %q: expected nats://host:port", rawURL)
+	}
+
+	address := u.Host
+	if u.Port() == "" {
+		address = net.JoinHostPort(u.Hostname(), "4222")
+	}
+
+	if prefix == "" {
+		prefix = "simplebank"
+	}
+	return &NATSPublisher{address: address, prefix: prefix}, nil
+}
+
+func (publisher *NATSPublisher) Publish(ctx context.Context, envelope Envelope) error {
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+
+	err = publisher.publish(ctx, envelope.Subject(publisher.prefix), data)
+	if err != nil && publisher.conn != nil {
+		publisher.conn.Close()
+		publisher.conn = nil
+	}
+	return err
+}
+
+func (publisher *NATSPublisher) publish(ctx context.Context, subject string, data []byte) error {
+	if publisher.conn == nil {
+		if err := publisher.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	deadline := time.Now().Add(natsTimeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := publisher.conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+
+	msg := fmt.Sprintf("PUB %s %d\r\n%s\r\nPING\r\n", subject, len(data), data)
+	if _, err := publisher.conn.Write([]byte(msg)); err != nil {
+		return err
+	}
+	return publisher.awaitPong()
+}
+
+// connect opens the connection and introduces the client, the broker starts with an INFO line
+func (publisher *NATSPublisher) connect(ctx context.Context) error {
+	dialer := net.Dialer{Timeout: natsTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", publisher.address)
+	if err != nil {
+		return err
+	}
+
+	if err := conn.SetDeadline(time.Now().Add(natsTimeout)); err != nil {
+		conn.Close()
+		return err
+	}
+
+	reader := bufio.NewReader(conn)
+	line, err := readLine(reader)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	if !strings.HasPrefix(line, "INFO") {
+		conn.Close()
+		return fmt.Errorf("unexpected nats greeting %q", line)
+	}
+
+	_, err = conn.Write([]byte("CONNECT {\"verbose\":false,\"pedantic\":false,\"name\":\"simplebank\"}\r\nPING\r\n"))
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	publisher.conn = conn
+	publisher.reader = reader
+	return publisher.awaitPong()
+}
+
+// awaitPong reads the lines sent by the broker until it answers the last PING
+func (publisher *NATSPublisher) awaitPong() error {
+	for {
+		line, err := readLine(publisher.reader)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := publisher.conn.Write([]byte("PONG\r\n")); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return errors.New("nats: " + strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
+
+func (publisher *NATSPublisher) Close() error {
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+
+	if publisher.conn == nil {
+		return nil
+	}
+	err := publisher.conn.Close()
+	publisher.conn = nil
+	return err
+}
+
+func readLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
@@ -0,0 +1,68 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+)
+
+// Envelope is a domain event as handed to consumers. ID grows with every event and is the same
+// on every delivery of an event, consumers use it to drop the duplicates of an at least once delivery
+type Envelope struct {
+	ID            int64           `json:"id"`
+	Type          string          `json:"type"`
+	Version       int32           `json:"version"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+// NewEnvelope wraps an event of the outbox
+func NewEnvelope(event db.Outbox) Envelope {
+	return Envelope{
+		ID:            event.ID,
+		Type:          event.EventType,
+		Version:       event.EventVersion,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		OccurredAt:    event.CreatedAt,
+		Payload:       event.Payload,
+	}
+}
+
+// Subject is where the event is published on a message broker, prefix.Type.vVersion
+func (envelope Envelope) Subject(prefix string) string {
+	return fmt.Sprintf("%s.%s.v%d", prefix, envelope.Type, envelope.Version)
+}
+
+// Publisher delivers domain events to the services reacting to them, an event is delivered
+// once Publish returns without error
+type Publisher interface {
+	Publish(ctx context.Context, envelope Envelope) error
+	Close() error
+}
+
+// Names of the publishers
+const (
+	MemoryPublisherName = "memory"
+	FilePublisherName   = "file"
+	NATSPublisherName   = "nats"
+)
+
+// NewPublisher creates the publisher configured by name
+func NewPublisher(config util.Config) (Publisher, error) {
+	switch config.EventPublisher {
+	case "", MemoryPublisherName:
+		return NewMemoryPublisher(), nil
+	case FilePublisherName:
+		return NewFilePublisher(config.EventFilePath), nil
+	case NATSPublisherName:
+		return NewNATSPublisher(config.EventNATSURL, config.EventSubjectPrefix)
+	}
+	return nil, fmt.Errorf("unsupported event publisher %q", config.EventPublisher)
+}
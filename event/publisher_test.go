@@ -0,0 +1,256 @@
+package event
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func testEnvelope(id int64) Envelope {
+	return Envelope{
+		ID:            id,
+		Type:          db.EventTypeTransferPosted,
+		Version:       1,
+		AggregateType: db.AggregateTypeTransfer,
+		AggregateID:   strconv.FormatInt(id, 10),
+		OccurredAt:    time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC),
+		Payload:       json.RawMessage(`{"transfer_id":` + strconv.FormatInt(id, 10) + `}`),
+	}
+}
+
+func TestMemoryPublisher(t *testing.T) {
+	publisher := NewMemoryPublisher()
+	require.NoError(t, publisher.Publish(context.Background(), testEnvelope(1)))
+
+	publisher.SetError(errors.New("broker down"))
+	require.EqualError(t, publisher.Publish(context.Background(), testEnvelope(2)), "broker down")
+
+	publisher.SetError(nil)
+	require.NoError(t, publisher.Publish(context.Background(), testEnvelope(2)))
+	require.Equal(t, []Envelope{testEnvelope(1), testEnvelope(2)}, publisher.Envelopes())
+}
+
+func TestFilePublisher(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events", "events.jsonl")
+	publisher := NewFilePublisher(path)
+	defer publisher.Close()
+
+	require.NoError(t, publisher.Publish(context.Background(), testEnvelope(1)))
+	require.NoError(t, publisher.Publish(context.Background(), testEnvelope(2)))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 2)
+	for i, line := range lines {
+		var envelope Envelope
+		require.NoError(t, json.Unmarshal([]byte(line), &envelope))
+		require.Equal(t, int64(i+1), envelope.ID)
+		require.Equal(t, db.EventTypeTransferPosted, envelope.Type)
+		require.JSONEq(t, string(testEnvelope(int64(i+1)).Payload), string(envelope.Payload))
+	}
+}
+
+func TestMultiPublisher(t *testing.T) {
+	first := NewMemoryPublisher()
+	second := NewMemoryPublisher()
+	publisher := NewMultiPublisher(first, second)
+
+	require.NoError(t, publisher.Publish(context.Background(), testEnvelope(1)))
+	require.Equal(t, []Envelope{testEnvelope(1)}, first.Envelopes())
+	require.Equal(t, []Envelope{testEnvelope(1)}, second.Envelopes())
+
+	// the first publisher gets the event again when it is retried
+	second.SetError(errors.New("broker down"))
+	require.EqualError(t, publisher.Publish(context.Background(), testEnvelope(2)), "broker down")
+	second.SetError(nil)
+	require.NoError(t, publisher.Publish(context.Background(), testEnvelope(2)))
+	require.Equal(t, []Envelope{testEnvelope(1), testEnvelope(2), testEnvelope(2)}, first.Envelopes())
+	require.Equal(t, []Envelope{testEnvelope(1), testEnvelope(2)}, second.Envelopes())
+	require.NoError(t, publisher.Close())
+}
+
+type natsMessage struct {
+	subject string
+	data    []byte
+}
+
+// natsStandIn is a local stand-in for a NATS server, it accepts one connection at a time and acknowledges
+// the publications, unless reject is set
+type natsStandIn struct {
+	listener net.Listener
+	messages chan natsMessage
+	reject   string
+}
+
+func newNATSStandIn(t *testing.T, reject string) *natsStandIn {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	standIn := &natsStandIn{listener: listener, messages: make(chan natsMessage, 10), reject: reject}
+	go standIn.serve()
+	return standIn
+}
+
+func (standIn *natsStandIn) url() string {
+	return "nats://" + standIn.listener.Addr().String()
+}
+
+func (standIn *natsStandIn) serve() {
+	for {
+		conn, err := standIn.listener.Accept()
+		if err != nil {
+			return
+		}
+		standIn.handle(conn)
+	}
+}
+
+func (standIn *natsStandIn) handle(conn net.Conn) {
+	defer conn.Close()
+	conn.Write([]byte("INFO {\"server_id\":\"stand-in\",\"max_payload\":1048576}\r\n"))
+
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := readLine(reader)
+		if err != nil {
+			return
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "PING":
+			conn.Write([]byte("PONG\r\n"))
+		case "PUB":
+			size, _ := strconv.Atoi(fields[len(fields)-1])
+			data := make([]byte, size+2)
+			if _, err := io.ReadFull(reader, data); err != nil {
+				return
+			}
+
+			if standIn.reject != "" {
+				conn.Write([]byte("-ERR '" + standIn.reject + "'\r\n"))
+				continue
+			}
+			standIn.messages <- natsMessage{subject: fields[1], data: data[:size]}
+		}
+	}
+}
+
+func TestNATSPublisher(t *testing.T) {
+	standIn := newNATSStandIn(t, "")
+
+	publisher, err := NewNATSPublisher(standIn.url(), "bank")
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	for i := int64(1); i <= 2; i++ {
+		require.NoError(t, publisher.Publish(context.Background(), testEnvelope(i)))
+
+		msg := <-standIn.messages
+		require.Equal(t, "bank.TransferPosted.v1", msg.subject)
+
+		var envelope Envelope
+		require.NoError(t, json.Unmarshal(msg.data, &envelope))
+		require.Equal(t, i, envelope.ID)
+	}
+}
+
+func TestNATSPublisherRejected(t *testing.T) {
+	standIn := newNATSStandIn(t, "Permissions Violation for Publish")
+
+	publisher, err := NewNATSPublisher(standIn.url(), "")
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	err = publisher.Publish(context.Background(), testEnvelope(1))
+	require.EqualError(t, err, "nats: 'Permissions Violation for Publish'")
+	require.Nil(t, publisher.conn)
+}
+
+func TestNATSPublisherUnreachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	listener.Close()
+
+	publisher, err := NewNATSPublisher("nats://"+address, "")
+	require.NoError(t, err)
+	require.Error(t, publisher.Publish(context.Background(), testEnvelope(1)))
+}
+
+func TestNewPublisher(t *testing.T) {
+	testCases := []struct {
+		name          string
+		config        util.Config
+		checkResponse func(t *testing.T, publisher Publisher, err error)
+	}{
+		{
+			name:   "Default",
+			config: util.Config{},
+			checkResponse: func(t *testing.T, publisher Publisher, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &MemoryPublisher{}, publisher)
+			},
+		},
+		{
+			name:   "File",
+			config: util.Config{EventPublisher: FilePublisherName, EventFilePath: "/tmp/events.jsonl"},
+			checkResponse: func(t *testing.T, publisher Publisher, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &FilePublisher{}, publisher)
+			},
+		},
+		{
+			name:   "NATS",
+			config: util.Config{EventPublisher: NATSPublisherName, EventNATSURL: "nats://localhost"},
+			checkResponse: func(t *testing.T, publisher Publisher, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "localhost:4222", publisher.(*NATSPublisher).address)
+				require.Equal(t, "simplebank", publisher.(*NATSPublisher).prefix)
+			},
+		},
+		{
+			name:   "InvalidNATSURL",
+			config: util.Config{EventPublisher: NATSPublisherName, EventNATSURL: "http://localhost:4222"},
+			checkResponse: func(t *testing.T, publisher Publisher, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name:   "Unsupported",
+			config: util.Config{EventPublisher: "kafka"},
+			checkResponse: func(t *testing.T, publisher Publisher, err error) {
+				require.EqualError(t, err, `unsupported event publisher "kafka"`)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			publisher, err := NewPublisher(tc.config)
+			tc.checkResponse(t, publisher, err)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package event
+
+import (
+	"context"
+	"log"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+)
+
+// defaultRelayBatchSize is the number of events published per transaction when it isn't configured
+const defaultRelayBatchSize = 100
+
+// Relay moves the events of the outbox to a publisher, in the order they were written
+type Relay struct {
+	store     db.Store
+	publisher Publisher
+	interval  time.Duration
+	batchSize int32
+}
+
+// NewRelay creates a new Relay
+func NewRelay(store db.Store, publisher Publisher, config util.Config) *Relay {
+	batchSize := config.EventRelayBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultRelayBatchSize
+	}
+
+	return &Relay{
+		store:     store,
+		publisher: publisher,
+		interval:  config.EventRelayInterval,
+		batchSize: batchSize,
+	}
+}
+
+// RelayPending publishes the pending events batch after batch until there is none left or one fails,
+// it returns the number of events published
+func (relay *Relay) RelayPending(ctx context.Context) (int, error) {
+	published := 0
+	for {
+		result, err := relay.store.RelayOutboxTx(ctx, db.RelayOutboxTxParams{
+			Limit: relay.batchSize,
+			Publish: func(event db.Outbox) error {
+				return relay.publisher.Publish(ctx, NewEnvelope(event))
+			},
+		})
+		if err != nil {
+			return published, err
+		}
+
+		published += len(result.Published)
+		if result.Failed != nil {
+			log.Printf("cannot publish event %d (%s), attempt %d: %s",
+				result.Failed.ID, result.Failed.EventType, result.Failed.Attempts, result.Failed.LastError)
+			return published, nil
+		}
+
+		if len(result.Published) < int(relay.batchSize) {
+			return published, nil
+		}
+	}
+}
+
+// Run relays the pending events every interval until the context is done
+func (relay *Relay) Run(ctx context.Context) {
+	if relay.interval <= 0 {
+		log.Printf("event relay interval is not set, events won't be published")
+		return
+	}
+
+	ticker := time.NewTicker(relay.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := relay.RelayPending(ctx); err != nil {
+			log.Printf("cannot relay events: %v", err)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// relayOutbox answers RelayOutboxTx like the store, publishing the events until one fails
+func relayOutbox(events []db.Outbox) func(ctx context.Context, arg db.RelayOutboxTxParams) (db.RelayOutboxTxResult, error) {
+	return func(ctx context.Context, arg db.RelayOutboxTxParams) (db.RelayOutboxTxResult, error) {
+		var result db.RelayOutboxTxResult
+		for _, event := range events {
+			if err := arg.Publish(event); err != nil {
+				event.Attempts++
+				event.LastError = err.Error()
+				result.Failed = &event
+				return result, nil
+			}
+			result.Published = append(result.Published, event)
+		}
+		return result, nil
+	}
+}
+
+func TestRelayPending(t *testing.T) {
+	events := []db.Outbox{
+		{ID: 1, EventType: db.EventTypeAccountCreated, EventVersion: 1, AggregateType: db.AggregateTypeAccount, AggregateID: "1", Payload: []byte(`{}`)},
+		{ID: 2, EventType: db.EventTypeTransferPosted, EventVersion: 1, AggregateType: db.AggregateTypeTransfer, AggregateID: "1", Payload: []byte(`{}`)},
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mocks.MockStore)
+		publishErr    error
+		checkResponse func(t *testing.T, published int, err error, publisher *MemoryPublisher)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mocks.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().
+						RelayOutboxTx(gomock.Any(), gomock.Any()).
+						Times(1).
+						DoAndReturn(relayOutbox(events)),
+					store.EXPECT().
+						RelayOutboxTx(gomock.Any(), gomock.Any()).
+						Times(1).
+						DoAndReturn(relayOutbox(nil)),
+				)
+			},
+			checkResponse: func(t *testing.T, published int, err error, publisher *MemoryPublisher) {
+				require.NoError(t, err)
+				require.Equal(t, 2, published)
+				require.Equal(t, []Envelope{NewEnvelope(events[0]), NewEnvelope(events[1])}, publisher.Envelopes())
+			},
+		},
+		{
+			name: "PublisherFails",
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().
+					RelayOutboxTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(relayOutbox(events))
+			},
+			publishErr: errors.New("broker down"),
+			checkResponse: func(t *testing.T, published int, err error, publisher *MemoryPublisher) {
+				require.NoError(t, err)
+				require.Zero(t, published)
+				require.Empty(t, publisher.Envelopes())
+			},
+		},
+		{
+			name: "StoreFails",
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().
+					RelayOutboxTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.RelayOutboxTxResult{}, errors.New("connection refused"))
+			},
+			checkResponse: func(t *testing.T, published int, err error, publisher *MemoryPublisher) {
+				require.EqualError(t, err, "connection refused")
+				require.Zero(t, published)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mocks.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			publisher := NewMemoryPublisher()
+			publisher.SetError(tc.publishErr)
+
+			relay := NewRelay(store, publisher, util.Config{EventRelayBatchSize: 2})
+			published, err := relay.RelayPending(context.Background())
+			tc.checkResponse(t, published, err, publisher)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/Sinothic/simplebank/access"
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// accessAccount loads an account and checks the role of the authenticated user grants the permission, with the
+// rules of access.Resolve. The refused accesses are mapped to their status by the error interceptor
+func (server *Server) accessAccount(ctx context.Context, accountID int64, permission string) (db.Account, access.Access, error) {
+	return access.Resolve(ctx, server.store, authPayload(ctx).UserID, accountID, permission)
+}
+
+// checkAccount makes sure money can be moved in the currency from or to the account
+func checkAccount(account db.Account, currency string) error {
+	if account.Kind != util.AccountKindCustomer {
+		return status.Errorf(codes.InvalidArgument, "account %d is not a customer account", account.ID)
+	}
+
+	if account.Currency != currency {
+		return status.Errorf(codes.InvalidArgument, "account %d currency mismatch: %s vs %s", account.ID, account.Currency, currency)
+	}
+	return nil
+}
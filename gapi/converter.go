@@ -0,0 +1,104 @@
+package gapi
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func convertUser(user db.User) *pb.User {
+	return &pb.User{
+		Id:                user.ID,
+		Username:          user.Username,
+		FullName:          user.FullName,
+		Email:             user.Email,
+		Role:              user.Role,
+		PasswordChangedAt: timestamppb.New(user.PasswordChangedAt),
+		CreatedAt:         timestamppb.New(user.CreatedAt),
+	}
+}
+
+func convertAccount(account db.Account) *pb.Account {
+	return &pb.Account{
+		Id:             account.ID,
+		UserId:         account.UserID,
+		Balance:        account.Balance,
+		Currency:       account.Currency,
+		Kind:           account.Kind,
+		ParentId:       account.ParentID.Int64,
+		Name:           account.Name,
+		OrganisationId: account.OrganisationID.Int64,
+		FrozenAt:       convertNullTime(account.FrozenAt),
+		CreatedAt:      timestamppb.New(account.CreatedAt),
+	}
+}
+
+func convertAccounts(accounts []db.Account) []*pb.Account {
+	converted := make([]*pb.Account, 0, len(accounts))
+	for _, account := range accounts {
+		converted = append(converted, convertAccount(account))
+	}
+	return converted
+}
+
+func convertTransfer(transfer db.Transfer) (*pb.Transfer, error) {
+	metadata, err := convertMetadata(transfer.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Transfer{
+		Id:            transfer.ID,
+		FromAccountId: transfer.FromAccountID,
+		ToAccountId:   transfer.ToAccountID,
+		Amount:        transfer.Amount,
+		Description:   transfer.Description,
+		Reference:     transfer.Reference,
+		Metadata:      metadata,
+		CreatedAt:     timestamppb.New(transfer.CreatedAt),
+	}, nil
+}
+
+func convertEntry(entry db.Entry) *pb.Entry {
+	return &pb.Entry{
+		Id:         entry.ID,
+		AccountId:  entry.AccountID,
+		Amount:     entry.Amount,
+		TransferId: entry.TransferID.Int64,
+		CreatedAt:  timestamppb.New(entry.CreatedAt),
+	}
+}
+
+func convertTransferApproval(approval db.TransferApproval) *pb.TransferApproval {
+	return &pb.TransferApproval{
+		Id:             approval.ID,
+		OrganisationId: approval.OrganisationID,
+		FromAccountId:  approval.FromAccountID,
+		ToAccountId:    approval.ToAccountID,
+		Amount:         approval.Amount,
+		Status:         approval.Status,
+		InitiatorId:    approval.InitiatorID,
+		CreatedAt:      timestamppb.New(approval.CreatedAt),
+	}
+}
+
+// convertMetadata decodes the flat metadata object of a transfer
+func convertMetadata(raw json.RawMessage) (map[string]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var metadata map[string]string
+	err := json.Unmarshal(raw, &metadata)
+	return metadata, err
+}
+
+func convertNullTime(t sql.NullTime) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
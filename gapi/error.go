@@ -0,0 +1,54 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Sinothic/simplebank/access"
+	"github.com/Sinothic/simplebank/token"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatusError maps an error to the gRPC status the HTTP server answers with the closest status code,
+// the status errors returned by the handlers are kept
+func toStatusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var accessErr *access.Error
+	if errors.As(err, &accessErr) {
+		return status.Error(accessCodes[accessErr.Code], err.Error())
+	}
+
+	var pqErr *pq.Error
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation":
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, token.ErrInvalidToken), errors.Is(err, token.ErrExpiredToken):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// accessCodes are the status codes of the accesses refused by the access rules
+var accessCodes = map[access.Code]codes.Code{
+	access.NotFound:   codes.NotFound,
+	access.Forbidden:  codes.PermissionDenied,
+	access.Frozen:     codes.FailedPrecondition,
+	access.CoolingOff: codes.PermissionDenied,
+}
+
+func invalidArgument(field string, reason string) error {
+	return status.Errorf(codes.InvalidArgument, "invalid %s: %s", field, reason)
+}
@@ -0,0 +1,75 @@
+package gapi
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/Sinothic/simplebank/pb"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// NewGateway creates the HTTP handler translating the JSON requests under /v1 to calls to the gRPC server
+// at grpcAddress, so they go through the same interceptors as any other call. The fields keep their
+// snake_case names and the zero values are sent, as the HTTP server does
+func NewGateway(ctx context.Context, grpcAddress string) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher),
+	)
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	err := pb.RegisterSimpleBankHandlerFromEndpoint(ctx, mux, dialAddress(grpcAddress), opts)
+	if err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
+
+// gatewayHeaderMatcher forwards the request ID of the HTTP clients along with the default headers
+func gatewayHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, requestIDHeader) {
+		return requestIDHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// dialAddress reaches a server listening on every interface, such as ":9090", on localhost
+func dialAddress(address string) string {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+
+	switch host {
+	case "", "0.0.0.0", "::":
+		return net.JoinHostPort("localhost", port)
+	}
+	return address
+}
+
+// NewGatewayServer creates the HTTP server of the gateway, with the timeouts of the HTTP server. Its connection
+// to the gRPC server is closed when ctx is done, so ctx has to outlive the shutdown of the gateway server
+func NewGatewayServer(ctx context.Context, config util.Config) (*http.Server, error) {
+	gateway, err := NewGateway(ctx, config.GRPCServerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	server := util.NewHTTPServer(config, gateway)
+	server.Addr = config.GatewayServerAddress
+	return server, nil
+}
@@ -0,0 +1,65 @@
+package gapi
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGateway(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	hashedPassword, err := util.HashPassword("secret123")
+	require.NoError(t, err)
+
+	store := mocks.NewMockStore(ctrl)
+	store.EXPECT().
+		GetUser(gomock.Any(), "alice").
+		Return(db.User{ID: 7, Username: "alice", HashedPassword: hashedPassword, Role: util.UserRoleCustomer}, nil)
+
+	server, err := NewServer(newTestConfig(), store)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := server.NewGRPCServer()
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	gateway, err := NewGateway(ctx, listener.Addr().String())
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/v1/users/login", strings.NewReader(`{"username":"alice","password":"secret123"}`))
+	gateway.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var rsp map[string]any
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+	require.NotEmpty(t, rsp["access_token"])
+	require.Equal(t, "alice", rsp["user"].(map[string]any)["username"])
+
+	recorder = httptest.NewRecorder()
+	request = httptest.NewRequest(http.MethodGet, "/v1/accounts/1", nil)
+	gateway.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestDialAddress(t *testing.T) {
+	require.Equal(t, "localhost:9090", dialAddress(":9090"))
+	require.Equal(t, "localhost:9090", dialAddress("0.0.0.0:9090"))
+	require.Equal(t, "bank.internal:9090", dialAddress("bank.internal:9090"))
+}
@@ -0,0 +1,150 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/pb"
+	"github.com/Sinothic/simplebank/token"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader     = "authorization"
+	authorizationTypeBearer = "bearer"
+	requestIDHeader         = "x-request-id"
+	maxRequestIDLength      = 64
+	// the gateway forwards the user agent and the address of the HTTP clients under these keys
+	gatewayUserAgentHeader = "grpcgateway-user-agent"
+	userAgentHeader        = "user-agent"
+	forwardedForHeader     = "x-forwarded-for"
+)
+
+// publicMethods can be called without an access token
+var publicMethods = map[string]bool{
+	pb.SimpleBank_CreateUser_FullMethodName: true,
+	pb.SimpleBank_LoginUser_FullMethodName:  true,
+}
+
+type authPayloadKey struct{}
+
+// loggingInterceptor logs every call with its status code and duration
+func loggingInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	rsp, err := handler(ctx, req)
+	log.Printf("gRPC %s %s %s", info.FullMethod, status.Code(err), time.Since(start))
+	return rsp, err
+}
+
+// errorInterceptor maps the errors of the handlers to gRPC status errors
+func errorInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	rsp, err := handler(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return rsp, nil
+}
+
+// auditInterceptor attaches the audit context of the call to its context, the store records it with every
+// change made for the call. The request ID is taken from the x-request-id metadata or generated, and sent back
+func auditInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	requestID := firstValue(md, requestIDHeader)
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		requestID = uuid.NewString()
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, requestID)); err != nil {
+		return nil, err
+	}
+
+	audit := db.AuditContext{
+		Route:     info.FullMethod,
+		RequestID: requestID,
+		IP:        clientIP(ctx, md),
+		UserAgent: firstValue(md, gatewayUserAgentHeader),
+	}
+	if audit.UserAgent == "" {
+		audit.UserAgent = firstValue(md, userAgentHeader)
+	}
+	return handler(db.WithAuditContext(ctx, audit), req)
+}
+
+// authInterceptor only lets calls carrying a valid bearer access token through, but for the public methods.
+// The token payload is stored in the context and read with authPayload
+func (server *Server) authInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if publicMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	payload, err := server.authorize(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	ctx = context.WithValue(ctx, authPayloadKey{}, payload)
+	if audit, ok := db.AuditContextFrom(ctx); ok {
+		audit.ActorID = payload.UserID
+		ctx = db.WithAuditContext(ctx, audit)
+	}
+	return handler(ctx, req)
+}
+
+// authorize verifies the bearer access token of the authorization metadata
+func (server *Server) authorize(ctx context.Context) (*token.Payload, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	authorization := firstValue(md, authorizationHeader)
+	if authorization == "" {
+		return nil, errors.New("authorization metadata is not provided")
+	}
+
+	fields := strings.Fields(authorization)
+	if len(fields) < 2 {
+		return nil, errors.New("invalid authorization metadata format")
+	}
+
+	authorizationType := strings.ToLower(fields[0])
+	if authorizationType != authorizationTypeBearer {
+		return nil, fmt.Errorf("unsupported authorization type %s", authorizationType)
+	}
+
+	return server.tokenMaker.VerifyToken(fields[1])
+}
+
+// authPayload returns the token payload stored by authInterceptor
+func authPayload(ctx context.Context) *token.Payload {
+	return ctx.Value(authPayloadKey{}).(*token.Payload)
+}
+
+// clientIP is the first address forwarded by the gateway, or the address of the peer
+func clientIP(ctx context.Context, md metadata.MD) string {
+	if forwarded := firstValue(md, forwardedForHeader); forwarded != "" {
+		return strings.TrimSpace(strings.Split(forwarded, ",")[0])
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		address := p.Addr.String()
+		if i := strings.LastIndex(address, ":"); i > 0 {
+			return strings.Trim(address[:i], "[]")
+		}
+		return address
+	}
+	return ""
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
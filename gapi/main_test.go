@@ -0,0 +1,85 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"net"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/pb"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestConfig() util.Config {
+	return util.Config{
+		TokenSymmetricKey:     "test-token-symmetric-key-0123456789",
+		AccessTokenDuration:   time.Minute,
+		PayeeCoolingOffPeriod: 24 * time.Hour,
+		PayeeCoolingOffLimit:  100000,
+	}
+}
+
+// newTestClient serves the server in memory with its interceptors and returns a client calling it
+func newTestClient(t *testing.T, store db.Store) (*Server, pb.SimpleBankClient) {
+	server, err := NewServer(newTestConfig(), store)
+	require.NoError(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := server.NewGRPCServer()
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return server, pb.NewSimpleBankClient(conn)
+}
+
+// authorizedContext carries an access token of the user in the outgoing metadata
+func authorizedContext(t *testing.T, server *Server, userID int64) context.Context {
+	accessToken, _, err := server.tokenMaker.CreateToken(userID, "user", util.UserRoleCustomer, time.Minute)
+	require.NoError(t, err)
+
+	return metadata.AppendToOutgoingContext(context.Background(), authorizationHeader, authorizationTypeBearer+" "+accessToken)
+}
+
+// expectHolders lets the authenticated user hold the accounts of roles, by account id, with the given role,
+// they hold no other account
+func expectHolders(store *mocks.MockStore, roles map[int64]string) {
+	store.EXPECT().
+		GetAccountHolder(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.GetAccountHolderParams) (db.AccountHolder, error) {
+			role, ok := roles[arg.AccountID]
+			if !ok {
+				return db.AccountHolder{}, sql.ErrNoRows
+			}
+			return db.AccountHolder{AccountID: arg.AccountID, UserID: arg.UserID, Role: role}, nil
+		}).
+		AnyTimes()
+}
+
+func randomAccount(id int64, currency string) db.Account {
+	return db.Account{
+		ID:        id,
+		UserID:    1,
+		Balance:   544,
+		Currency:  currency,
+		Kind:      util.AccountKindCustomer,
+		CreatedAt: time.Now(),
+	}
+}
@@ -0,0 +1,77 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/pb"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateAccount opens an empty account owned by the authenticated user
+func (server *Server) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
+	if err := validateCurrency(req.GetCurrency()); err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.CreateAccountTx(ctx, db.CreateAccountTxParams{
+		UserID:   authPayload(ctx).UserID,
+		Currency: req.GetCurrency(),
+	})
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code.Name() == "unique_violation" {
+			return nil, status.Errorf(codes.AlreadyExists, "you already own a %s account", req.GetCurrency())
+		}
+		return nil, err
+	}
+
+	return &pb.CreateAccountResponse{Account: convertAccount(result.Account)}, nil
+}
+
+// GetAccount returns an account held by the authenticated user with the total balance of its pots
+func (server *Server) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	if err := validateID("id", req.GetId()); err != nil {
+		return nil, err
+	}
+
+	account, _, err := server.accessAccount(ctx, req.GetId(), util.AccountPermissionView)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.GetAccountResponse{Account: convertAccount(account), TotalBalance: account.Balance}
+	if account.Kind == util.AccountKindCustomer {
+		potsBalance, err := server.store.SumPotBalances(ctx, account.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		rsp.PotsBalance = potsBalance
+		rsp.TotalBalance += potsBalance
+	}
+
+	return rsp, nil
+}
+
+// ListAccounts lists the accounts the authenticated user owns, co-owns or views
+func (server *Server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	if err := validatePage(req.GetPageId(), req.GetPageSize()); err != nil {
+		return nil, err
+	}
+
+	accounts, err := server.store.ListHeldAccounts(ctx, db.ListHeldAccountsParams{
+		UserID: authPayload(ctx).UserID,
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListAccountsResponse{Accounts: convertAccounts(accounts)}, nil
+}
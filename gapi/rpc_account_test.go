@@ -0,0 +1,141 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/pb"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetAccount(t *testing.T) {
+	account := randomAccount(1, util.USD)
+
+	testCases := []struct {
+		name          string
+		authorized    bool
+		req           *pb.GetAccountRequest
+		roles         map[int64]string
+		buildStubs    func(store *mocks.MockStore)
+		checkResponse func(t *testing.T, rsp *pb.GetAccountResponse, err error)
+	}{
+		{
+			name:       "OK",
+			authorized: true,
+			req:        &pb.GetAccountRequest{Id: account.ID},
+			roles:      map[int64]string{account.ID: util.AccountRoleViewer},
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), account.ID).Return(account, nil)
+				store.EXPECT().SumPotBalances(gomock.Any(), account.ID).Return(int64(300), nil)
+			},
+			checkResponse: func(t *testing.T, rsp *pb.GetAccountResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, account.ID, rsp.GetAccount().GetId())
+				require.Equal(t, account.Currency, rsp.GetAccount().GetCurrency())
+				require.Equal(t, int64(300), rsp.GetPotsBalance())
+				require.Equal(t, account.Balance+300, rsp.GetTotalBalance())
+			},
+		},
+		{
+			name: "Unauthenticated",
+			req:  &pb.GetAccountRequest{Id: account.ID},
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, rsp *pb.GetAccountResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name:       "InvalidID",
+			authorized: true,
+			req:        &pb.GetAccountRequest{},
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, rsp *pb.GetAccountResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name:       "NotFound",
+			authorized: true,
+			req:        &pb.GetAccountRequest{Id: account.ID},
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), account.ID).Return(db.Account{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, rsp *pb.GetAccountResponse, err error) {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name:       "HeldByAnotherUser",
+			authorized: true,
+			req:        &pb.GetAccountRequest{Id: account.ID},
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), account.ID).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, rsp *pb.GetAccountResponse, err error) {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			name:       "InternalError",
+			authorized: true,
+			req:        &pb.GetAccountRequest{Id: account.ID},
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), account.ID).Return(db.Account{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, rsp *pb.GetAccountResponse, err error) {
+				require.Equal(t, codes.Internal, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mocks.NewMockStore(ctrl)
+			expectHolders(store, tc.roles)
+			tc.buildStubs(store)
+
+			server, client := newTestClient(t, store)
+			ctx := context.Background()
+			if tc.authorized {
+				ctx = authorizedContext(t, server, 1)
+			}
+
+			rsp, err := client.GetAccount(ctx, tc.req)
+			tc.checkResponse(t, rsp, err)
+		})
+	}
+}
+
+func TestListAccounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accounts := []db.Account{randomAccount(1, util.USD), randomAccount(2, util.EUR)}
+	store := mocks.NewMockStore(ctrl)
+	store.EXPECT().
+		ListHeldAccounts(gomock.Any(), db.ListHeldAccountsParams{UserID: 7, Limit: 5, Offset: 5}).
+		Return(accounts, nil)
+
+	server, client := newTestClient(t, store)
+	rsp, err := client.ListAccounts(authorizedContext(t, server, 7), &pb.ListAccountsRequest{PageId: 2, PageSize: 5})
+	require.NoError(t, err)
+	require.Len(t, rsp.GetAccounts(), 2)
+	require.Equal(t, util.EUR, rsp.GetAccounts()[1].GetCurrency())
+
+	_, err = client.ListAccounts(authorizedContext(t, server, 7), &pb.ListAccountsRequest{PageId: 1, PageSize: 50})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,107 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/Sinothic/simplebank/access"
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/pb"
+	"github.com/Sinothic/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateTransfer moves money from an account the authenticated user can transact on to another account.
+// A transfer from an organisation account above the transfer limit of the member is held until another member
+// approves it, the response then only carries the approval
+func (server *Server) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
+	if err := validateID("from_account_id", req.GetFromAccountId()); err != nil {
+		return nil, err
+	}
+	if err := validateID("to_account_id", req.GetToAccountId()); err != nil {
+		return nil, err
+	}
+	if req.GetAmount() <= 0 {
+		return nil, invalidArgument("amount", "must be positive")
+	}
+	if err := validateCurrency(req.GetCurrency()); err != nil {
+		return nil, err
+	}
+	if err := validateTransferDetails(req.GetDescription(), req.GetReference(), req.GetMetadata()); err != nil {
+		return nil, err
+	}
+
+	from, fromAccess, err := server.accessAccount(ctx, req.GetFromAccountId(), util.AccountPermissionTransact)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAccount(from, req.GetCurrency()); err != nil {
+		return nil, err
+	}
+
+	to, err := server.store.GetAccount(ctx, req.GetToAccountId())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "account %d not found", req.GetToAccountId())
+		}
+		return nil, err
+	}
+	if err := checkAccount(to, req.GetCurrency()); err != nil {
+		return nil, err
+	}
+
+	policy := access.CoolingOffPolicy{Period: server.config.PayeeCoolingOffPeriod, Limit: server.config.PayeeCoolingOffLimit}
+	err = policy.Check(ctx, server.store, authPayload(ctx).UserID, access.Destination{AccountID: to.ID}, req.GetAmount())
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.TransferTxParams{
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        req.GetAmount(),
+		Description:   req.GetDescription(),
+		Reference:     req.GetReference(),
+	}
+	if len(req.GetMetadata()) > 0 {
+		arg.Metadata, err = json.Marshal(req.GetMetadata())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if fromAccess.Exceeds(req.GetAmount()) {
+		return server.holdTransfer(ctx, from, arg)
+	}
+
+	result, err := server.store.TransferTx(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := convertTransfer(result.Transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateTransferResponse{
+		Transfer:    transfer,
+		FromAccount: convertAccount(result.FromAccount),
+		ToAccount:   convertAccount(result.ToAccount),
+		FromEntry:   convertEntry(result.FromEntry),
+		ToEntry:     convertEntry(result.ToEntry),
+	}, nil
+}
+
+// holdTransfer records a transfer waiting for the approval of another member of the organisation
+func (server *Server) holdTransfer(ctx context.Context, from db.Account, arg db.TransferTxParams) (*pb.CreateTransferResponse, error) {
+	approval, err := access.HoldTransfer(ctx, server.store, authPayload(ctx).UserID, from, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateTransferResponse{Approval: convertTransferApproval(approval)}, nil
+}
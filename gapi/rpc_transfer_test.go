@@ -0,0 +1,177 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/pb"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateTransfer(t *testing.T) {
+	from := randomAccount(1, util.USD)
+	to := randomAccount(2, util.USD)
+	euros := randomAccount(3, util.EUR)
+	organisation := randomAccount(4, util.USD)
+	organisation.OrganisationID = sql.NullInt64{Int64: 9, Valid: true}
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateTransferRequest
+		buildStubs    func(store *mocks.MockStore)
+		checkResponse func(t *testing.T, rsp *pb.CreateTransferResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.CreateTransferRequest{
+				FromAccountId: from.ID,
+				ToAccountId:   to.ID,
+				Amount:        10,
+				Currency:      util.USD,
+				Reference:     "INV-42",
+				Metadata:      map[string]string{"order_id": "42"},
+			},
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), from.ID).Return(from, nil)
+				store.EXPECT().GetAccount(gomock.Any(), to.ID).Return(to, nil)
+				store.EXPECT().
+					TransferTx(gomock.Any(), db.TransferTxParams{
+						FromAccountID: from.ID,
+						ToAccountID:   to.ID,
+						Amount:        10,
+						Reference:     "INV-42",
+						Metadata:      json.RawMessage(`{"order_id":"42"}`),
+					}).
+					Return(db.TransferTxResult{
+						Transfer:    db.Transfer{ID: 5, FromAccountID: from.ID, ToAccountID: to.ID, Amount: 10, Metadata: json.RawMessage(`{"order_id":"42"}`)},
+						FromAccount: from,
+						ToAccount:   to,
+						FromEntry:   db.Entry{ID: 11, AccountID: from.ID, Amount: -10},
+						ToEntry:     db.Entry{ID: 12, AccountID: to.ID, Amount: 10},
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, rsp *pb.CreateTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(5), rsp.GetTransfer().GetId())
+				require.Equal(t, "42", rsp.GetTransfer().GetMetadata()["order_id"])
+				require.Equal(t, int64(-10), rsp.GetFromEntry().GetAmount())
+				require.Nil(t, rsp.GetApproval())
+			},
+		},
+		{
+			name: "CurrencyMismatch",
+			req:  &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: euros.ID, Amount: 10, Currency: util.USD},
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), from.ID).Return(from, nil)
+				store.EXPECT().GetAccount(gomock.Any(), euros.ID).Return(euros, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, rsp *pb.CreateTransferResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "InvalidReference",
+			req:  &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: to.ID, Amount: 10, Currency: util.USD, Reference: "no_underscores"},
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, rsp *pb.CreateTransferResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "FrozenAccount",
+			req:  &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: to.ID, Amount: 10, Currency: util.USD},
+			buildStubs: func(store *mocks.MockStore) {
+				frozen := from
+				frozen.FrozenAt = sql.NullTime{Valid: true}
+				store.EXPECT().GetAccount(gomock.Any(), from.ID).Return(frozen, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, rsp *pb.CreateTransferResponse, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			name: "NewPayee",
+			req:  &pb.CreateTransferRequest{FromAccountId: from.ID, ToAccountId: to.ID, Amount: 100001, Currency: util.USD},
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), from.ID).Return(from, nil)
+				store.EXPECT().GetAccount(gomock.Any(), to.ID).Return(to, nil)
+				store.EXPECT().
+					GetNewestPayeeOfDestination(gomock.Any(), db.GetNewestPayeeOfDestinationParams{
+						UserID:    1,
+						AccountID: sql.NullInt64{Int64: to.ID, Valid: true},
+					}).
+					Return(db.Payee{ID: 6, UserID: 1, AccountID: sql.NullInt64{Int64: to.ID, Valid: true}, CreatedAt: time.Now()}, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, rsp *pb.CreateTransferResponse, err error) {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			},
+		},
+		{
+			name: "AboveTransferLimit",
+			req:  &pb.CreateTransferRequest{FromAccountId: organisation.ID, ToAccountId: to.ID, Amount: 500, Currency: util.USD},
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), organisation.ID).Return(organisation, nil)
+				store.EXPECT().GetAccount(gomock.Any(), to.ID).Return(to, nil)
+				store.EXPECT().
+					GetOrganisationMember(gomock.Any(), db.GetOrganisationMemberParams{OrganisationID: 9, UserID: 1}).
+					Return(db.OrganisationMember{
+						OrganisationID: 9,
+						UserID:         1,
+						Role:           util.OrganisationRoleInitiator,
+						TransferLimit:  sql.NullInt64{Int64: 100, Valid: true},
+					}, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().
+					CreateTransferApproval(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.CreateTransferApprovalParams) (db.TransferApproval, error) {
+						require.Equal(t, int64(1), arg.InitiatorID)
+						require.JSONEq(t, `{}`, string(arg.Metadata))
+						return db.TransferApproval{
+							ID:             3,
+							OrganisationID: arg.OrganisationID,
+							FromAccountID:  arg.FromAccountID,
+							ToAccountID:    arg.ToAccountID,
+							Amount:         arg.Amount,
+							InitiatorID:    arg.InitiatorID,
+							Status:         "pending",
+						}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, rsp *pb.CreateTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Nil(t, rsp.GetTransfer())
+				require.Equal(t, int64(3), rsp.GetApproval().GetId())
+				require.Equal(t, "pending", rsp.GetApproval().GetStatus())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mocks.NewMockStore(ctrl)
+			expectHolders(store, map[int64]string{from.ID: util.AccountRoleOwner})
+			tc.buildStubs(store)
+
+			server, client := newTestClient(t, store)
+			rsp, err := client.CreateTransfer(authorizedContext(t, server, 1), tc.req)
+			tc.checkResponse(t, rsp, err)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/pb"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateUser(t *testing.T) {
+	req := &pb.CreateUserRequest{Username: "alice", Password: "secret123", FullName: "Alice", Email: "alice@example.com"}
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateUserRequest
+		buildStubs    func(store *mocks.MockStore)
+		checkResponse func(t *testing.T, rsp *pb.CreateUserResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  req,
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().
+					CreateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+						require.NoError(t, util.CheckPassword(req.Password, arg.HashedPassword))
+
+						audit, ok := db.AuditContextFrom(ctx)
+						require.True(t, ok)
+						require.Equal(t, pb.SimpleBank_CreateUser_FullMethodName, audit.Route)
+						require.Equal(t, "request-42", audit.RequestID)
+						return db.User{ID: 1, Username: arg.Username, FullName: arg.FullName, Email: arg.Email, Role: util.UserRoleCustomer}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, rsp *pb.CreateUserResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "alice", rsp.GetUser().GetUsername())
+				require.Equal(t, util.UserRoleCustomer, rsp.GetUser().GetRole())
+			},
+		},
+		{
+			name: "DuplicateUsername",
+			req:  req,
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().
+					CreateUser(gomock.Any(), gomock.Any()).
+					Return(db.User{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(t *testing.T, rsp *pb.CreateUserResponse, err error) {
+				require.Equal(t, codes.AlreadyExists, status.Code(err))
+			},
+		},
+		{
+			name: "InvalidEmail",
+			req:  &pb.CreateUserRequest{Username: "alice", Password: "secret123", FullName: "Alice", Email: "alice"},
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, rsp *pb.CreateUserResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mocks.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			_, client := newTestClient(t, store)
+			ctx := metadata.AppendToOutgoingContext(context.Background(), requestIDHeader, "request-42")
+
+			var header metadata.MD
+			rsp, err := client.CreateUser(ctx, tc.req, grpc.Header(&header))
+			tc.checkResponse(t, rsp, err)
+			require.Equal(t, []string{"request-42"}, header.Get(requestIDHeader))
+		})
+	}
+}
+
+func TestLoginUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	hashedPassword, err := util.HashPassword("secret123")
+	require.NoError(t, err)
+	user := db.User{ID: 7, Username: "alice", HashedPassword: hashedPassword, Role: util.UserRoleCustomer}
+
+	store := mocks.NewMockStore(ctrl)
+	store.EXPECT().GetUser(gomock.Any(), "alice").Return(user, nil).Times(2)
+	store.EXPECT().GetUser(gomock.Any(), "bob").Return(db.User{}, sql.ErrNoRows)
+
+	server, client := newTestClient(t, store)
+	rsp, err := client.LoginUser(context.Background(), &pb.LoginUserRequest{Username: "alice", Password: "secret123"})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, rsp.GetUser().GetId())
+
+	payload, err := server.tokenMaker.VerifyToken(rsp.GetAccessToken())
+	require.NoError(t, err)
+	require.Equal(t, user.ID, payload.UserID)
+
+	_, err = client.LoginUser(context.Background(), &pb.LoginUserRequest{Username: "alice", Password: "wrong-password"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = client.LoginUser(context.Background(), &pb.LoginUserRequest{Username: "bob", Password: "secret123"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
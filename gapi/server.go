@@ -0,0 +1,75 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/pb"
+	"github.com/Sinothic/simplebank/token"
+	"github.com/Sinothic/simplebank/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// Server serves gRPC requests with the same store and access tokens as the HTTP server
+type Server struct {
+	pb.UnimplementedSimpleBankServer
+	config     util.Config
+	store      db.Store
+	tokenMaker token.Maker
+	grpcServer *grpc.Server
+}
+
+// NewServer creates a new gRPC server
+func NewServer(config util.Config, store db.Store) (*Server, error) {
+	tokenMaker, err := token.NewJWTMaker(config.TokenSymmetricKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create token maker: %w", err)
+	}
+
+	server := &Server{config: config, store: store, tokenMaker: tokenMaker}
+	server.grpcServer = server.NewGRPCServer()
+	return server, nil
+}
+
+// NewGRPCServer registers the server on a gRPC server with its interceptors: the calls are logged, their errors
+// mapped to status codes, their audit context attached and their access token verified, in that order
+func (server *Server) NewGRPCServer() *grpc.Server {
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		loggingInterceptor,
+		errorInterceptor,
+		auditInterceptor,
+		server.authInterceptor,
+	))
+	pb.RegisterSimpleBankServer(grpcServer, server)
+	reflection.Register(grpcServer)
+	return grpcServer
+}
+
+// Start runs the gRPC server on a specific address until it is stopped
+func (server *Server) Start(address string) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	return server.grpcServer.Serve(listener)
+}
+
+// Stop stops accepting connections and waits for the calls in flight to finish, the calls still running
+// when ctx is done are cancelled
+func (server *Server) Stop(ctx context.Context) {
+	stopped := make(chan struct{})
+	go func() {
+		server.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		server.grpcServer.Stop()
+	}
+}
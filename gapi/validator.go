@@ -0,0 +1,89 @@
+package gapi
+
+import (
+	"net/mail"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Sinothic/simplebank/util"
+)
+
+// The validators check the fields of the requests with the rules of the binding tags of the HTTP server
+
+func validateUsername(username string) error {
+	if username == "" {
+		return invalidArgument("username", "is required")
+	}
+
+	for _, r := range username {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return invalidArgument("username", "must only contain letters and digits")
+		}
+	}
+	return nil
+}
+
+func validatePassword(password string) error {
+	if utf8.RuneCountInString(password) < 8 {
+		return invalidArgument("password", "must have at least 8 characters")
+	}
+	return nil
+}
+
+func validateEmail(email string) error {
+	if _, err := mail.ParseAddress(email); err != nil {
+		return invalidArgument("email", "is not a valid email address")
+	}
+	return nil
+}
+
+func validateID(field string, id int64) error {
+	if id < 1 {
+		return invalidArgument(field, "must be a positive integer")
+	}
+	return nil
+}
+
+func validateCurrency(currency string) error {
+	if !util.IsSupportedCurrency(currency) {
+		return invalidArgument("currency", "is not supported")
+	}
+	return nil
+}
+
+func validatePage(pageID, pageSize int32) error {
+	if pageID < 1 {
+		return invalidArgument("page_id", "must be a positive integer")
+	}
+
+	if pageSize < 5 || pageSize > 10 {
+		return invalidArgument("page_size", "must be between 5 and 10")
+	}
+	return nil
+}
+
+// validateTransferDetails checks the description, reference and metadata copied to the entries of a transfer
+func validateTransferDetails(description string, reference string, metadata map[string]string) error {
+	if utf8.RuneCountInString(description) > 140 || !util.IsValidDescription(description) {
+		return invalidArgument("description", "must be at most 140 characters on a single line")
+	}
+
+	if len(reference) > 35 || !util.IsValidReference(reference) {
+		return invalidArgument("reference", "must be at most 35 characters of the SWIFT character set")
+	}
+
+	if len(metadata) > 20 {
+		return invalidArgument("metadata", "must have at most 20 keys")
+	}
+
+	for key, value := range metadata {
+		if len(key) > 40 || !util.IsValidMetadataKey(key) {
+			return invalidArgument("metadata", "keys must be at most 40 letters, digits, '_', '-' or '.'")
+		}
+
+		if utf8.RuneCountInString(value) > 500 {
+			return invalidArgument("metadata", "values must be at most 500 characters")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+// Viewer is the authenticated user running a query
+type Viewer struct {
+	UserID int64
+	Role   string
+}
+
+// staff viewers can read every user and account
+func (viewer Viewer) staff() bool {
+	return viewer.Role == util.UserRoleSupport || viewer.Role == util.UserRoleAdmin
+}
+
+// Request is a GraphQL request, as posted over HTTP
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// Schema runs read only GraphQL queries over the store on behalf of a viewer
+type Schema struct {
+	store         db.Store
+	schema        graphql.Schema
+	maxDepth      int
+	maxComplexity int
+}
+
+// NewSchema creates a new Schema
+func NewSchema(store db.Store, config util.Config) (*Schema, error) {
+	schema, err := newSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	maxDepth := config.GraphQLMaxDepth
+	if maxDepth <= 0 {
+		maxDepth = defaultMaxDepth
+	}
+
+	maxComplexity := config.GraphQLMaxComplexity
+	if maxComplexity <= 0 {
+		maxComplexity = defaultMaxComplexity
+	}
+
+	return &Schema{
+		store:         store,
+		schema:        schema,
+		maxDepth:      maxDepth,
+		maxComplexity: maxComplexity,
+	}, nil
+}
+
+// Execute parses and validates a request, checks its depth and complexity and runs it. The store is only
+// called by the loaders of the request, so a query makes one call per loader and level of the query
+func (schema *Schema) Execute(ctx context.Context, viewer Viewer, req Request) *graphql.Result {
+	doc, err := parser.Parse(parser.ParseParams{Source: req.Query})
+	if err != nil {
+		return &graphql.Result{Errors: []gqlerrors.FormattedError{gqlerrors.FormatError(err)}}
+	}
+
+	validation := graphql.ValidateDocument(&schema.schema, doc, nil)
+	if !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}
+	}
+
+	err = checkLimits(doc, req.OperationName, req.Variables, schema.maxDepth, schema.maxComplexity)
+	if err != nil {
+		return &graphql.Result{Errors: []gqlerrors.FormattedError{gqlerrors.FormatError(err)}}
+	}
+
+	ctx = context.WithValue(ctx, requestKey{}, &request{
+		viewer:  viewer,
+		loaders: newLoaders(schema.store, viewer),
+	})
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        schema.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       ctx,
+	})
+}
+
+type requestKey struct{}
+
+// request is what the resolvers of a query share
+type request struct {
+	viewer  Viewer
+	loaders *loaders
+}
+
+func requestFrom(ctx context.Context) *request {
+	return ctx.Value(requestKey{}).(*request)
+}
+
+// pageKey loads the first Limit items of a list by the id of its parent
+type pageKey struct {
+	ID    int64
+	Limit int32
+}
+
+// loaders batch the reads of a query, they live as long as the query
+type loaders struct {
+	users        *batchLoader[int64, db.User]
+	accounts     *batchLoader[int64, db.ListAccountsWithRolesRow]
+	heldAccounts *batchLoader[pageKey, []db.Account]
+	transfers    *batchLoader[pageKey, []db.Transfer]
+}
+
+func newLoaders(store db.Store, viewer Viewer) *loaders {
+	return &loaders{
+		users: newLoader(func(ctx context.Context, ids []int64) (map[int64]db.User, error) {
+			users, err := store.ListUsersByIDs(ctx, jsonIDs(ids))
+			if err != nil {
+				return nil, err
+			}
+
+			values := make(map[int64]db.User, len(users))
+			for _, user := range users {
+				values[user.ID] = user
+			}
+			return values, nil
+		}),
+		accounts: newLoader(func(ctx context.Context, ids []int64) (map[int64]db.ListAccountsWithRolesRow, error) {
+			accounts, err := store.ListAccountsWithRoles(ctx, db.ListAccountsWithRolesParams{
+				UserID:     viewer.UserID,
+				AccountIds: jsonIDs(ids),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			values := make(map[int64]db.ListAccountsWithRolesRow, len(accounts))
+			for _, account := range accounts {
+				values[account.ID] = account
+			}
+			return values, nil
+		}),
+		heldAccounts: newLoader(func(ctx context.Context, keys []pageKey) (map[pageKey][]db.Account, error) {
+			values := make(map[pageKey][]db.Account, len(keys))
+			for limit, ids := range byLimit(keys) {
+				rows, err := store.ListHeldAccountsByUsers(ctx, db.ListHeldAccountsByUsersParams{
+					UserIds:   jsonIDs(ids),
+					PageLimit: limit,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				for _, id := range ids {
+					values[pageKey{ID: id, Limit: limit}] = []db.Account{}
+				}
+				for _, row := range rows {
+					key := pageKey{ID: row.HolderID, Limit: limit}
+					values[key] = append(values[key], heldAccount(row))
+				}
+			}
+			return values, nil
+		}),
+		transfers: newLoader(func(ctx context.Context, keys []pageKey) (map[pageKey][]db.Transfer, error) {
+			values := make(map[pageKey][]db.Transfer, len(keys))
+			for limit, ids := range byLimit(keys) {
+				rows, err := store.ListRecentTransfersByAccounts(ctx, db.ListRecentTransfersByAccountsParams{
+					AccountIds: jsonIDs(ids),
+					PageLimit:  limit,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				for _, id := range ids {
+					values[pageKey{ID: id, Limit: limit}] = []db.Transfer{}
+				}
+				for _, row := range rows {
+					key := pageKey{ID: row.AccountID, Limit: limit}
+					values[key] = append(values[key], recentTransfer(row))
+				}
+			}
+			return values, nil
+		}),
+	}
+}
+
+// jsonIDs encodes ids as the JSON array the batch queries take
+func jsonIDs(ids []int64) json.RawMessage {
+	data, _ := json.Marshal(ids)
+	return data
+}
+
+// byLimit groups the ids of the keys by the size of their page, a query loads them one page size at a time
+func byLimit(keys []pageKey) map[int32][]int64 {
+	ids := map[int32][]int64{}
+	for _, key := range keys {
+		ids[key.Limit] = append(ids[key.Limit], key.ID)
+	}
+	return ids
+}
+
+func heldAccount(row db.ListHeldAccountsByUsersRow) db.Account {
+	return db.Account{
+		ID:             row.ID,
+		UserID:         row.UserID,
+		Balance:        row.Balance,
+		Currency:       row.Currency,
+		CreatedAt:      row.CreatedAt,
+		Kind:           row.Kind,
+		ParentID:       row.ParentID,
+		Name:           row.Name,
+		GoalAmount:     row.GoalAmount,
+		TargetDate:     row.TargetDate,
+		OrganisationID: row.OrganisationID,
+		FrozenAt:       row.FrozenAt,
+		FrozenReason:   row.FrozenReason,
+	}
+}
+
+func recentTransfer(row db.ListRecentTransfersByAccountsRow) db.Transfer {
+	return db.Transfer{
+		ID:            row.ID,
+		FromAccountID: row.FromAccountID,
+		ToAccountID:   row.ToAccountID,
+		Amount:        row.Amount,
+		CreatedAt:     row.CreatedAt,
+		Description:   row.Description,
+		Reference:     row.Reference,
+		Metadata:      row.Metadata,
+	}
+}
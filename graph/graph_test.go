@@ -0,0 +1,241 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func testUser(id int64, role string) db.User {
+	return db.User{
+		ID:        id,
+		Username:  "user" + formatID(id),
+		FullName:  "User " + formatID(id),
+		Email:     "user" + formatID(id) + "@example.com",
+		Role:      role,
+		CreatedAt: time.Now().Truncate(time.Second),
+	}
+}
+
+func heldAccountRow(holderID, id int64) db.ListHeldAccountsByUsersRow {
+	return db.ListHeldAccountsByUsersRow{HolderID: holderID, ID: id, UserID: holderID, Balance: id * 100, Currency: util.USD, Kind: "checking"}
+}
+
+func recentTransferRow(accountID, id, fromAccountID, toAccountID int64) db.ListRecentTransfersByAccountsRow {
+	return db.ListRecentTransfersByAccountsRow{AccountID: accountID, ID: id, FromAccountID: fromAccountID, ToAccountID: toAccountID, Amount: 10}
+}
+
+func requireJSON(t *testing.T, expected string, actual any) {
+	data, err := json.Marshal(actual)
+	require.NoError(t, err)
+	require.JSONEq(t, expected, string(data))
+}
+
+func TestExecute(t *testing.T) {
+	testCases := []struct {
+		name       string
+		viewer     Viewer
+		query      string
+		buildStubs func(store *mocks.MockStore)
+		check      func(t *testing.T, result *graphql.Result)
+	}{
+		{
+			name:   "user with accounts and transfers",
+			viewer: Viewer{UserID: 1, Role: util.UserRoleCustomer},
+			query:  `{ me { username accounts(first: 2) { id balance transfers(last: 3) { id amount } } } }`,
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().
+					ListUsersByIDs(gomock.Any(), json.RawMessage(`[1]`)).
+					Times(1).
+					Return([]db.User{testUser(1, util.UserRoleCustomer)}, nil)
+				store.EXPECT().
+					ListHeldAccountsByUsers(gomock.Any(), db.ListHeldAccountsByUsersParams{UserIds: json.RawMessage(`[1]`), PageLimit: 2}).
+					Times(1).
+					Return([]db.ListHeldAccountsByUsersRow{heldAccountRow(1, 10), heldAccountRow(1, 11)}, nil)
+				store.EXPECT().
+					ListRecentTransfersByAccounts(gomock.Any(), db.ListRecentTransfersByAccountsParams{AccountIds: json.RawMessage(`[10,11]`), PageLimit: 3}).
+					Times(1).
+					Return([]db.ListRecentTransfersByAccountsRow{
+						recentTransferRow(10, 6, 10, 11),
+						recentTransferRow(11, 6, 10, 11),
+						recentTransferRow(11, 5, 20, 11),
+					}, nil)
+			},
+			check: func(t *testing.T, result *graphql.Result) {
+				require.Empty(t, result.Errors)
+				requireJSON(t, `{"me": {"username": "user1", "accounts": [
+					{"id": "10", "balance": 1000, "transfers": [{"id": "6", "amount": 10}]},
+					{"id": "11", "balance": 1100, "transfers": [{"id": "6", "amount": 10}, {"id": "5", "amount": 10}]}
+				]}}`, result.Data)
+			},
+		},
+		{
+			name:   "accounts the viewer can't read",
+			viewer: Viewer{UserID: 1, Role: util.UserRoleCustomer},
+			query:  `{ account(id: "10") { transfers { fromAccount { id } } } }`,
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().
+					ListAccountsWithRoles(gomock.Any(), db.ListAccountsWithRolesParams{UserID: 1, AccountIds: json.RawMessage(`[10]`)}).
+					Times(1).
+					Return([]db.ListAccountsWithRolesRow{{ID: 10, UserID: 1, HolderRole: util.AccountRoleOwner}}, nil)
+				store.EXPECT().
+					ListRecentTransfersByAccounts(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListRecentTransfersByAccountsRow{
+						recentTransferRow(10, 3, 20, 10),
+						recentTransferRow(10, 2, 30, 10),
+						recentTransferRow(10, 1, 20, 10),
+					}, nil)
+				store.EXPECT().
+					ListAccountsWithRoles(gomock.Any(), db.ListAccountsWithRolesParams{UserID: 1, AccountIds: json.RawMessage(`[20,30]`)}).
+					Times(1).
+					Return([]db.ListAccountsWithRolesRow{
+						{ID: 20, UserID: 2},
+						{ID: 30, UserID: 3, OrganisationID: sql.NullInt64{Int64: 4, Valid: true}, MemberRole: util.OrganisationRoleViewer},
+					}, nil)
+			},
+			check: func(t *testing.T, result *graphql.Result) {
+				require.Len(t, result.Errors, 2)
+				require.Contains(t, result.Errors[0].Message, "account 20 doesn't belong to the authenticated user")
+				requireJSON(t, `{"account": {"transfers": [
+					{"fromAccount": null}, {"fromAccount": {"id": "30"}}, {"fromAccount": null}
+				]}}`, result.Data)
+			},
+		},
+		{
+			name:   "private fields of another user",
+			viewer: Viewer{UserID: 1, Role: util.UserRoleCustomer},
+			query:  `{ account(id: "10") { owner { username email accounts { id } } } }`,
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().
+					ListAccountsWithRoles(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListAccountsWithRolesRow{{ID: 10, UserID: 2, HolderRole: util.AccountRoleViewer}}, nil)
+				store.EXPECT().
+					ListUsersByIDs(gomock.Any(), json.RawMessage(`[2]`)).
+					Times(1).
+					Return([]db.User{testUser(2, util.UserRoleCustomer)}, nil)
+				store.EXPECT().ListHeldAccountsByUsers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, result *graphql.Result) {
+				require.Len(t, result.Errors, 2)
+				requireJSON(t, `{"account": {"owner": {"username": "user2", "email": null, "accounts": null}}}`, result.Data)
+			},
+		},
+		{
+			name:   "another user",
+			viewer: Viewer{UserID: 1, Role: util.UserRoleCustomer},
+			query:  `{ user(id: "2") { username } }`,
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().ListUsersByIDs(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, result *graphql.Result) {
+				require.Len(t, result.Errors, 1)
+				require.Contains(t, result.Errors[0].Message, "user 2 can only be read by themselves")
+				requireJSON(t, `{"user": null}`, result.Data)
+			},
+		},
+		{
+			name:   "staff",
+			viewer: Viewer{UserID: 1, Role: util.UserRoleSupport},
+			query:  `{ user(id: "2") { email } account(id: "20") { id } }`,
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().
+					ListUsersByIDs(gomock.Any(), json.RawMessage(`[2]`)).
+					Times(1).
+					Return([]db.User{testUser(2, util.UserRoleCustomer)}, nil)
+				store.EXPECT().
+					ListAccountsWithRoles(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListAccountsWithRolesRow{{ID: 20, UserID: 2}}, nil)
+			},
+			check: func(t *testing.T, result *graphql.Result) {
+				require.Empty(t, result.Errors)
+				requireJSON(t, `{"user": {"email": "user2@example.com"}, "account": {"id": "20"}}`, result.Data)
+			},
+		},
+		{
+			name:   "not found",
+			viewer: Viewer{UserID: 1, Role: util.UserRoleCustomer},
+			query:  `{ account(id: "10") { id } }`,
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().
+					ListAccountsWithRoles(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListAccountsWithRolesRow{}, nil)
+			},
+			check: func(t *testing.T, result *graphql.Result) {
+				require.Len(t, result.Errors, 1)
+				require.Contains(t, result.Errors[0].Message, "account 10 not found")
+			},
+		},
+		{
+			name:   "page size out of range",
+			viewer: Viewer{UserID: 1, Role: util.UserRoleCustomer},
+			query:  `{ me { accounts(first: 0) { id } } }`,
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().
+					ListUsersByIDs(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.User{testUser(1, util.UserRoleCustomer)}, nil)
+				store.EXPECT().ListHeldAccountsByUsers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, result *graphql.Result) {
+				require.Len(t, result.Errors, 1)
+				require.Contains(t, result.Errors[0].Message, "first must be between 1 and 50")
+			},
+		},
+		{
+			name:   "invalid query",
+			viewer: Viewer{UserID: 1, Role: util.UserRoleCustomer},
+			query:  `{ me { password } }`,
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().ListUsersByIDs(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, result *graphql.Result) {
+				require.Len(t, result.Errors, 1)
+				require.Nil(t, result.Data)
+			},
+		},
+		{
+			name:   "too complex",
+			viewer: Viewer{UserID: 1, Role: util.UserRoleCustomer},
+			query:  `{ me { accounts(first: 50) { transfers(last: 50) { id amount } } } }`,
+			buildStubs: func(store *mocks.MockStore) {
+				store.EXPECT().ListUsersByIDs(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, result *graphql.Result) {
+				require.Len(t, result.Errors, 1)
+				require.Contains(t, result.Errors[0].Message, "complexity")
+				require.Nil(t, result.Data)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mocks.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			schema, err := NewSchema(store, util.Config{})
+			require.NoError(t, err)
+
+			result := schema.Execute(context.Background(), tc.viewer, Request{Query: tc.query})
+			tc.check(t, result)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package graph
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+const (
+	// defaultMaxDepth is the deepest a query can nest fields when it isn't configured
+	defaultMaxDepth = 8
+	// defaultMaxComplexity is the most fields a query can resolve when it isn't configured
+	defaultMaxComplexity = 1000
+	// defaultPageSize is the number of items of a list field without a first or last argument
+	defaultPageSize = 10
+	// maxPageSize caps the first and last arguments of the list fields
+	maxPageSize = 50
+)
+
+// queryCost measures the operation of a query document before it runs
+type queryCost struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]any
+}
+
+// checkLimits rejects an operation nested deeper than maxDepth or resolving more than maxComplexity fields.
+// Every field costs 1 and the fields under a list cost as many times as the list can have items
+func checkLimits(doc *ast.Document, operationName string, variables map[string]any, maxDepth, maxComplexity int) error {
+	cost := queryCost{
+		fragments: map[string]*ast.FragmentDefinition{},
+		variables: variables,
+	}
+
+	var operation *ast.OperationDefinition
+	for _, definition := range doc.Definitions {
+		switch definition := definition.(type) {
+		case *ast.FragmentDefinition:
+			cost.fragments[definition.Name.Value] = definition
+		case *ast.OperationDefinition:
+			if operationName == "" || (definition.Name != nil && definition.Name.Value == operationName) {
+				operation = definition
+			}
+		}
+	}
+	if operation == nil {
+		return nil
+	}
+
+	depth, complexity := cost.selectionSet(operation.SelectionSet, map[string]bool{})
+	if depth > maxDepth {
+		return fmt.Errorf("query is nested %d levels deep, the limit is %d", depth, maxDepth)
+	}
+	if complexity > maxComplexity {
+		return fmt.Errorf("query has a complexity of %d, the limit is %d", complexity, maxComplexity)
+	}
+	return nil
+}
+
+// selectionSet returns the depth and the complexity of a selection set, visiting tracks the fragments
+// being spread so that a cycle, which the validation rejects anyway, doesn't recurse forever
+func (cost queryCost) selectionSet(set *ast.SelectionSet, visiting map[string]bool) (int, int) {
+	if set == nil {
+		return 0, 0
+	}
+
+	depth, complexity := 0, 0
+	for _, selection := range set.Selections {
+		var d, c int
+		switch selection := selection.(type) {
+		case *ast.Field:
+			d, c = cost.selectionSet(selection.SelectionSet, visiting)
+			d, c = d+1, 1+cost.multiplier(selection)*c
+		case *ast.InlineFragment:
+			d, c = cost.selectionSet(selection.SelectionSet, visiting)
+		case *ast.FragmentSpread:
+			name := selection.Name.Value
+			fragment, ok := cost.fragments[name]
+			if !ok || visiting[name] {
+				continue
+			}
+			visiting[name] = true
+			d, c = cost.selectionSet(fragment.SelectionSet, visiting)
+			delete(visiting, name)
+		}
+
+		depth = max(depth, d)
+		complexity += c
+	}
+	return depth, complexity
+}
+
+// multiplier is the number of items a list field can return, its first or last argument
+func (cost queryCost) multiplier(field *ast.Field) int {
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != "first" && argument.Name.Value != "last" {
+			continue
+		}
+
+		switch value := argument.Value.(type) {
+		case *ast.IntValue:
+			if n, err := strconv.Atoi(value.Value); err == nil {
+				return min(max(n, 1), maxPageSize)
+			}
+		case *ast.Variable:
+			if n, ok := cost.variables[value.Name.Value].(float64); ok {
+				return min(max(int(n), 1), maxPageSize)
+			}
+		}
+		return maxPageSize
+	}
+
+	if field.SelectionSet != nil && listFields[field.Name.Value] {
+		return defaultPageSize
+	}
+	return 1
+}
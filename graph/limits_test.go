@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckLimits(t *testing.T) {
+	testCases := []struct {
+		name      string
+		query     string
+		variables map[string]any
+		errText   string
+	}{
+		{
+			name:  "within limits",
+			query: `{ me { id accounts(first: 5) { id transfers(last: 5) { id amount } } } }`,
+		},
+		{
+			name:    "too deep",
+			query:   `{ me { accounts { owner { accounts { owner { accounts { owner { accounts { id } } } } } } } } }`,
+			errText: "nested 9 levels deep",
+		},
+		{
+			name:    "default page size",
+			query:   `{ me { accounts { transfers { fromAccount { transfers { id } } } } } }`,
+			errText: "complexity of 1212",
+		},
+		{
+			name:      "page size from a variable",
+			query:     `query($n: Int) { me { accounts(first: $n) { transfers(last: $n) { id amount createdAt } } } }`,
+			variables: map[string]any{"n": float64(20)},
+			errText:   "complexity of 1222",
+		},
+		{
+			name:    "page size capped",
+			query:   `{ me { accounts(first: 1000) { transfers(last: 1000) { id } } } }`,
+			errText: "complexity of 2552",
+		},
+		{
+			name: "fragments",
+			query: `
+				query { me { ...UserFields } }
+				fragment UserFields on User { accounts(first: 50) { ...AccountFields } }
+				fragment AccountFields on Account { transfers(last: 50) { id } }`,
+			errText: "complexity of 2552",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := parser.Parse(parser.ParseParams{Source: tc.query})
+			require.NoError(t, err)
+
+			err = checkLimits(doc, "", tc.variables, defaultMaxDepth, defaultMaxComplexity)
+			if tc.errText == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errText)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+)
+
+// errNotFound is returned by a loader for the keys its batch function didn't find
+var errNotFound = errors.New("not found")
+
+// batchFunc loads the values of many keys at once, the keys it doesn't return are not found
+type batchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+type loaded[V any] struct {
+	value V
+	err   error
+}
+
+// batchLoader batches the keys loaded while a level of a query is resolved into a single call of its batch function.
+// Load only queues the key and returns a thunk, the executor calls the thunks of a level once every field of
+// the level has been resolved so the first thunk called loads all the keys queued by the level.
+// The values are cached for the rest of the request
+type batchLoader[K comparable, V any] struct {
+	batch   batchFunc[K, V]
+	mu      sync.Mutex
+	pending []K
+	results map[K]loaded[V]
+}
+
+// newLoader creates a new batchLoader
+func newLoader[K comparable, V any](batch batchFunc[K, V]) *batchLoader[K, V] {
+	return &batchLoader[K, V]{
+		batch:   batch,
+		results: map[K]loaded[V]{},
+	}
+}
+
+// Load queues a key and returns a thunk returning its value
+func (loader *batchLoader[K, V]) Load(ctx context.Context, key K) func() (V, error) {
+	loader.mu.Lock()
+	if _, ok := loader.results[key]; !ok && !slices.Contains(loader.pending, key) {
+		loader.pending = append(loader.pending, key)
+	}
+	loader.mu.Unlock()
+
+	return func() (V, error) {
+		loader.mu.Lock()
+		defer loader.mu.Unlock()
+
+		if _, ok := loader.results[key]; !ok {
+			loader.flush(ctx)
+		}
+
+		result := loader.results[key]
+		return result.value, result.err
+	}
+}
+
+// flush loads the pending keys, a failed batch fails all of its keys
+func (loader *batchLoader[K, V]) flush(ctx context.Context) {
+	keys := loader.pending
+	loader.pending = nil
+
+	values, err := loader.batch(ctx, keys)
+	for _, key := range keys {
+		switch value, ok := values[key]; {
+		case err != nil:
+			loader.results[key] = loaded[V]{err: err}
+		case !ok:
+			loader.results[key] = loaded[V]{err: errNotFound}
+		default:
+			loader.results[key] = loaded[V]{value: value}
+		}
+	}
+}
@@ -0,0 +1,323 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// listFields are the fields paged with a first or last argument, they cost defaultPageSize times their fields
+// when the argument isn't set
+var listFields = map[string]bool{
+	"accounts":  true,
+	"transfers": true,
+}
+
+// int64Type carries the amounts and balances, in cents, which can be out of the 32 bit range of Int
+var int64Type = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "Int64",
+	Description: "A 64 bit integer",
+	Serialize: func(value any) any {
+		return value
+	},
+	ParseValue: func(value any) any {
+		switch value := value.(type) {
+		case float64:
+			return int64(value)
+		case int:
+			return int64(value)
+		}
+		return nil
+	},
+	ParseLiteral: func(value ast.Value) any {
+		if value, ok := value.(*ast.IntValue); ok {
+			if n, err := strconv.ParseInt(value.Value, 10, 64); err == nil {
+				return n
+			}
+		}
+		return nil
+	},
+})
+
+func newSchema() (graphql.Schema, error) {
+	var userType, accountType *graphql.Object
+
+	transferType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Transfer",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":            {Type: graphql.NewNonNull(graphql.ID), Resolve: transferField(func(t db.Transfer) any { return formatID(t.ID) })},
+				"amount":        {Type: graphql.NewNonNull(int64Type), Resolve: transferField(func(t db.Transfer) any { return t.Amount })},
+				"description":   {Type: graphql.NewNonNull(graphql.String), Resolve: transferField(func(t db.Transfer) any { return t.Description })},
+				"reference":     {Type: graphql.NewNonNull(graphql.String), Resolve: transferField(func(t db.Transfer) any { return t.Reference })},
+				"createdAt":     {Type: graphql.NewNonNull(graphql.DateTime), Resolve: transferField(func(t db.Transfer) any { return t.CreatedAt })},
+				"fromAccountId": {Type: graphql.NewNonNull(graphql.ID), Resolve: transferField(func(t db.Transfer) any { return formatID(t.FromAccountID) })},
+				"toAccountId":   {Type: graphql.NewNonNull(graphql.ID), Resolve: transferField(func(t db.Transfer) any { return formatID(t.ToAccountID) })},
+				"fromAccount": {
+					Type:        accountType,
+					Description: "The account the money left, only readable by the users who can view it",
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return loadAccount(p.Context, p.Source.(db.Transfer).FromAccountID), nil
+					},
+				},
+				"toAccount": {
+					Type:        accountType,
+					Description: "The account the money went to, only readable by the users who can view it",
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return loadAccount(p.Context, p.Source.(db.Transfer).ToAccountID), nil
+					},
+				},
+			}
+		}),
+	})
+
+	accountType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Account",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":        {Type: graphql.NewNonNull(graphql.ID), Resolve: accountField(func(a db.Account) any { return formatID(a.ID) })},
+				"balance":   {Type: graphql.NewNonNull(int64Type), Resolve: accountField(func(a db.Account) any { return a.Balance })},
+				"currency":  {Type: graphql.NewNonNull(graphql.String), Resolve: accountField(func(a db.Account) any { return a.Currency })},
+				"kind":      {Type: graphql.NewNonNull(graphql.String), Resolve: accountField(func(a db.Account) any { return a.Kind })},
+				"name":      {Type: graphql.NewNonNull(graphql.String), Resolve: accountField(func(a db.Account) any { return a.Name })},
+				"frozen":    {Type: graphql.NewNonNull(graphql.Boolean), Resolve: accountField(func(a db.Account) any { return a.FrozenAt.Valid })},
+				"createdAt": {Type: graphql.NewNonNull(graphql.DateTime), Resolve: accountField(func(a db.Account) any { return a.CreatedAt })},
+				"owner": {
+					Type: graphql.NewNonNull(userType),
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return loadUser(p.Context, p.Source.(db.Account).UserID), nil
+					},
+				},
+				"transfers": {
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(transferType))),
+					Description: "The latest transfers from or to the account, the latest first",
+					Args: graphql.FieldConfigArgument{
+						"last": {Type: graphql.Int, DefaultValue: defaultPageSize},
+					},
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						limit, err := pageSize(p, "last")
+						if err != nil {
+							return nil, err
+						}
+
+						key := pageKey{ID: p.Source.(db.Account).ID, Limit: limit}
+						thunk := requestFrom(p.Context).loaders.transfers.Load(p.Context, key)
+						return func() (any, error) {
+							return thunk()
+						}, nil
+					},
+				},
+			}
+		}),
+	})
+
+	userType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":        {Type: graphql.NewNonNull(graphql.ID), Resolve: userField(func(u db.User) any { return formatID(u.ID) })},
+				"username":  {Type: graphql.NewNonNull(graphql.String), Resolve: userField(func(u db.User) any { return u.Username })},
+				"fullName":  {Type: graphql.NewNonNull(graphql.String), Resolve: userField(func(u db.User) any { return u.FullName })},
+				"role":      {Type: graphql.NewNonNull(graphql.String), Resolve: userField(func(u db.User) any { return u.Role })},
+				"createdAt": {Type: graphql.NewNonNull(graphql.DateTime), Resolve: userField(func(u db.User) any { return u.CreatedAt })},
+				"email": {
+					Type:        graphql.String,
+					Description: "Only readable by the user and the staff",
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						user := p.Source.(db.User)
+						if err := authorizeUser(p.Context, user.ID); err != nil {
+							return nil, err
+						}
+						return user.Email, nil
+					},
+				},
+				"accounts": {
+					Type:        graphql.NewList(graphql.NewNonNull(accountType)),
+					Description: "The accounts the user holds, only readable by the user and the staff",
+					Args: graphql.FieldConfigArgument{
+						"first": {Type: graphql.Int, DefaultValue: defaultPageSize},
+					},
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						user := p.Source.(db.User)
+						if err := authorizeUser(p.Context, user.ID); err != nil {
+							return nil, err
+						}
+
+						limit, err := pageSize(p, "first")
+						if err != nil {
+							return nil, err
+						}
+
+						thunk := requestFrom(p.Context).loaders.heldAccounts.Load(p.Context, pageKey{ID: user.ID, Limit: limit})
+						return func() (any, error) {
+							return thunk()
+						}, nil
+					},
+				},
+			}
+		}),
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"me": {
+				Type:        graphql.NewNonNull(userType),
+				Description: "The authenticated user",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return loadUser(p.Context, requestFrom(p.Context).viewer.UserID), nil
+				},
+			},
+			"user": {
+				Type:        userType,
+				Description: "A user, only readable by the user and the staff",
+				Args: graphql.FieldConfigArgument{
+					"id": {Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					id, err := parseID(p.Args["id"])
+					if err != nil {
+						return nil, err
+					}
+
+					if err := authorizeUser(p.Context, id); err != nil {
+						return nil, err
+					}
+					return loadUser(p.Context, id), nil
+				},
+			},
+			"account": {
+				Type:        accountType,
+				Description: "An account the authenticated user can view",
+				Args: graphql.FieldConfigArgument{
+					"id": {Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					id, err := parseID(p.Args["id"])
+					if err != nil {
+						return nil, err
+					}
+					return loadAccount(p.Context, id), nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: queryType,
+		Types: []graphql.Type{int64Type},
+	})
+}
+
+func userField(fn func(user db.User) any) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (any, error) {
+		return fn(p.Source.(db.User)), nil
+	}
+}
+
+func accountField(fn func(account db.Account) any) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (any, error) {
+		return fn(p.Source.(db.Account)), nil
+	}
+}
+
+func transferField(fn func(transfer db.Transfer) any) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (any, error) {
+		return fn(p.Source.(db.Transfer)), nil
+	}
+}
+
+func formatID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+func parseID(value any) (int64, error) {
+	s, _ := value.(string)
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || id < 1 {
+		return 0, fmt.Errorf("invalid id %q", s)
+	}
+	return id, nil
+}
+
+// pageSize reads the first or last argument of a list field
+func pageSize(p graphql.ResolveParams, name string) (int32, error) {
+	size, ok := p.Args[name].(int)
+	if !ok {
+		size = defaultPageSize
+	}
+
+	if size < 1 || size > maxPageSize {
+		return 0, fmt.Errorf("%s must be between 1 and %d", name, maxPageSize)
+	}
+	return int32(size), nil
+}
+
+// authorizeUser lets the users read their own private fields, and the staff read those of everyone
+func authorizeUser(ctx context.Context, userID int64) error {
+	viewer := requestFrom(ctx).viewer
+	if viewer.UserID != userID && !viewer.staff() {
+		return fmt.Errorf("user %d can only be read by themselves", userID)
+	}
+	return nil
+}
+
+// loadUser returns a thunk loading a user with the other users of the level
+func loadUser(ctx context.Context, id int64) func() (any, error) {
+	thunk := requestFrom(ctx).loaders.users.Load(ctx, id)
+	return func() (any, error) {
+		user, err := thunk()
+		if err == errNotFound {
+			return nil, fmt.Errorf("user %d not found", id)
+		}
+		return user, err
+	}
+}
+
+// loadAccount returns a thunk loading an account with the other accounts of the level, with the rules
+// of the HTTP API: the role comes from the organisation membership for the accounts of an organisation
+// and from the account holders otherwise, pots are held by the holders of their parent account
+func loadAccount(ctx context.Context, id int64) func() (any, error) {
+	req := requestFrom(ctx)
+	thunk := req.loaders.accounts.Load(ctx, id)
+	return func() (any, error) {
+		row, err := thunk()
+		if err == errNotFound {
+			return nil, fmt.Errorf("account %d not found", id)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		allowed := req.viewer.staff()
+		if row.OrganisationID.Valid {
+			allowed = allowed || util.MemberCan(row.MemberRole, util.AccountPermissionView)
+		} else {
+			allowed = allowed || util.HolderCan(row.HolderRole, util.AccountPermissionView)
+		}
+		if !allowed {
+			return nil, fmt.Errorf("account %d doesn't belong to the authenticated user", id)
+		}
+
+		return db.Account{
+			ID:             row.ID,
+			UserID:         row.UserID,
+			Balance:        row.Balance,
+			Currency:       row.Currency,
+			CreatedAt:      row.CreatedAt,
+			Kind:           row.Kind,
+			ParentID:       row.ParentID,
+			Name:           row.Name,
+			GoalAmount:     row.GoalAmount,
+			TargetDate:     row.TargetDate,
+			OrganisationID: row.OrganisationID,
+			FrozenAt:       row.FrozenAt,
+			FrozenReason:   row.FrozenReason,
+		}, nil
+	}
+}
@@ -0,0 +1,91 @@
+// Package health tells the orchestrator whether the server is alive and whether it is ready to take traffic
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Constants for the status of a readiness report and of its checks
+const (
+	StatusOK       = "ok"
+	StatusFailing  = "failing"
+	StatusDraining = "draining"
+)
+
+// checkTimeout bounds every check of a readiness probe, a dependency that hangs is failing
+const checkTimeout = 2 * time.Second
+
+// Check tells whether a dependency of the server works, the error of a failing one is reported
+type Check func(ctx context.Context) error
+
+// Report is the readiness of the server with the result of each of its checks
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// Probes runs the readiness checks of the server. A draining server isn't ready whatever its checks say,
+// so that the load balancer stops sending it requests before it shuts down
+type Probes struct {
+	mu       sync.Mutex
+	names    []string
+	checks   map[string]Check
+	draining bool
+}
+
+// NewProbes creates a new Probes without any check
+func NewProbes() *Probes {
+	return &Probes{checks: map[string]Check{}}
+}
+
+// AddCheck adds a check to the readiness probe
+func (probes *Probes) AddCheck(name string, check Check) {
+	probes.mu.Lock()
+	defer probes.mu.Unlock()
+
+	if _, ok := probes.checks[name]; !ok {
+		probes.names = append(probes.names, name)
+	}
+	probes.checks[name] = check
+}
+
+// Drain makes the server unready for the rest of its life
+func (probes *Probes) Drain() {
+	probes.mu.Lock()
+	defer probes.mu.Unlock()
+
+	probes.draining = true
+}
+
+// Ready runs the checks, the server is ready when none of them fails and it isn't draining
+func (probes *Probes) Ready(ctx context.Context) (Report, bool) {
+	probes.mu.Lock()
+	draining := probes.draining
+	names := append([]string(nil), probes.names...)
+	checks := make([]Check, len(names))
+	for i, name := range names {
+		checks[i] = probes.checks[name]
+	}
+	probes.mu.Unlock()
+
+	if draining {
+		return Report{Status: StatusDraining}, false
+	}
+
+	report := Report{Status: StatusOK, Checks: make(map[string]string, len(names))}
+	for i, name := range names {
+		checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+		err := checks[i](checkCtx)
+		cancel()
+
+		if err != nil {
+			report.Status = StatusFailing
+			report.Checks[name] = err.Error()
+			continue
+		}
+		report.Checks[name] = StatusOK
+	}
+	return report, report.Status == StatusOK
+}
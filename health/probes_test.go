@@ -0,0 +1,89 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbesReady(t *testing.T) {
+	testCases := []struct {
+		name           string
+		checks         map[string]Check
+		drain          bool
+		expectedReady  bool
+		expectedReport Report
+	}{
+		{
+			name:           "no check",
+			expectedReady:  true,
+			expectedReport: Report{Status: StatusOK, Checks: map[string]string{}},
+		},
+		{
+			name: "OK",
+			checks: map[string]Check{
+				"database": func(ctx context.Context) error { return nil },
+				"workers":  func(ctx context.Context) error { return nil },
+			},
+			expectedReady: true,
+			expectedReport: Report{
+				Status: StatusOK,
+				Checks: map[string]string{"database": StatusOK, "workers": StatusOK},
+			},
+		},
+		{
+			name: "failing check",
+			checks: map[string]Check{
+				"database": func(ctx context.Context) error { return errors.New("connection refused") },
+				"workers":  func(ctx context.Context) error { return nil },
+			},
+			expectedReport: Report{
+				Status: StatusFailing,
+				Checks: map[string]string{"database": "connection refused", "workers": StatusOK},
+			},
+		},
+		{
+			name: "draining",
+			checks: map[string]Check{
+				"database": func(ctx context.Context) error { return nil },
+			},
+			drain:          true,
+			expectedReport: Report{Status: StatusDraining},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			probes := NewProbes()
+			for name, check := range tc.checks {
+				probes.AddCheck(name, check)
+			}
+			if tc.drain {
+				probes.Drain()
+			}
+
+			report, ready := probes.Ready(context.Background())
+			require.Equal(t, tc.expectedReady, ready)
+			require.Equal(t, tc.expectedReport, report)
+		})
+	}
+}
+
+func TestProbesCheckTimeout(t *testing.T) {
+	probes := NewProbes()
+	// a check hanging until its deadline fails without holding the probe any longer
+	probes.AddCheck("database", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	report, ready := probes.Ready(ctx)
+	require.False(t, ready)
+	require.Equal(t, context.DeadlineExceeded.Error(), report.Checks["database"])
+}
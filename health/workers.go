@@ -0,0 +1,75 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Workers tracks the background workers of the server. A worker that returns on its own, such as one disabled
+// by the config, is fine, but one that panics makes the server unready
+type Workers struct {
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	crashed map[string]string
+}
+
+// NewWorkers creates a new Workers
+func NewWorkers() *Workers {
+	return &Workers{crashed: map[string]string{}}
+}
+
+// Go runs a worker in its own goroutine until ctx is done
+func (workers *Workers) Go(ctx context.Context, name string, run func(ctx context.Context)) {
+	workers.wg.Add(1)
+	go func() {
+		defer workers.wg.Done()
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("worker %s crashed: %v\n%s", name, r, debug.Stack())
+				workers.mu.Lock()
+				workers.crashed[name] = fmt.Sprint(r)
+				workers.mu.Unlock()
+			}
+		}()
+
+		run(ctx)
+	}()
+}
+
+// Wait waits for the workers until ctx is done, it tells whether they all returned
+func (workers *Workers) Wait(ctx context.Context) bool {
+	stopped := make(chan struct{})
+	go func() {
+		workers.wg.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Check fails when a worker crashed, it is the readiness check of the workers
+func (workers *Workers) Check(ctx context.Context) error {
+	workers.mu.Lock()
+	defer workers.mu.Unlock()
+
+	if len(workers.crashed) == 0 {
+		return nil
+	}
+
+	var crashes []string
+	for name, reason := range workers.crashed {
+		crashes = append(crashes, name+": "+reason)
+	}
+	slices.Sort(crashes)
+	return fmt.Errorf("crashed workers %s", strings.Join(crashes, ", "))
+}
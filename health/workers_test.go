@@ -0,0 +1,56 @@
+package health
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkers(t *testing.T) {
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	workers := NewWorkers()
+
+	// a worker returning on its own isn't a failure
+	workers.Go(workersCtx, "disabled", func(ctx context.Context) {})
+
+	started := make(chan struct{})
+	workers.Go(workersCtx, "relay", func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+	})
+	<-started
+	require.NoError(t, workers.Check(context.Background()))
+
+	crashed := make(chan struct{})
+	workers.Go(workersCtx, "dispatcher", func(ctx context.Context) {
+		defer close(crashed)
+		panic("nil map")
+	})
+	<-crashed
+	require.Eventually(t, func() bool {
+		return workers.Check(context.Background()) != nil
+	}, time.Second, time.Millisecond)
+	require.EqualError(t, workers.Check(context.Background()), "crashed workers dispatcher: nil map")
+
+	stopWorkers()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.True(t, workers.Wait(ctx))
+}
+
+func TestWorkersWaitTimeout(t *testing.T) {
+	workers := NewWorkers()
+
+	release := make(chan struct{})
+	defer close(release)
+	workers.Go(context.Background(), "stuck", func(ctx context.Context) {
+		<-release
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.False(t, workers.Wait(ctx))
+}
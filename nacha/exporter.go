@@ -0,0 +1,210 @@
+package nacha
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+)
+
+// Exporter writes the pending outbound payments to NACHA files and imports their returns
+type Exporter struct {
+	store  db.Store
+	config util.Config
+	now    func() time.Time
+}
+
+// NewExporter creates a new Exporter
+func NewExporter(store db.Store, config util.Config) *Exporter {
+	return &Exporter{
+		store:  store,
+		config: config,
+		now:    time.Now,
+	}
+}
+
+// Export writes every pending outbound payment to a new file in the output directory and marks
+// them as submitted. The file only appears once the payments are marked, nothing is written when
+// there is no pending payment
+func (exporter *Exporter) Export(ctx context.Context) (db.SubmitOutboundPaymentsTxResult, string, error) {
+	now := exporter.now()
+	fileName := fmt.Sprintf("ach-%s.txt", now.UTC().Format("20060102T150405"))
+	path := filepath.Join(exporter.config.ACHOutputDir, fileName)
+	tmpPath := filepath.Join(exporter.config.ACHOutputDir, "."+fileName+".tmp")
+
+	if err := os.MkdirAll(exporter.config.ACHOutputDir, 0o750); err != nil {
+		return db.SubmitOutboundPaymentsTxResult{}, "", err
+	}
+
+	result, err := exporter.store.SubmitOutboundPaymentsTx(ctx, db.SubmitOutboundPaymentsTxParams{
+		FileName:           fileName,
+		ODFIIdentification: exporter.config.ACHOriginRoutingNumber,
+		Day:                time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()),
+		WriteFile: func(achFile db.AchFile, entries []db.OutboundPaymentEntry) error {
+			return exporter.writeFile(tmpPath, now, achFile, entries)
+		},
+	})
+	if err != nil {
+		os.Remove(tmpPath)
+		return result, "", err
+	}
+
+	if len(result.Entries) == 0 {
+		return result, "", nil
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return result, "", fmt.Errorf("ach file %s was submitted but cannot be moved to %s: %w", tmpPath, path, err)
+	}
+	return result, path, nil
+}
+
+func (exporter *Exporter) writeFile(path string, now time.Time, achFile db.AchFile, entries []db.OutboundPaymentEntry) error {
+	batch := Batch{
+		CompanyName:           exporter.config.ACHCompanyName,
+		CompanyIdentification: exporter.config.ACHCompanyID,
+		EntryDescription:      "PAYMENT",
+		EffectiveEntryDate:    now.AddDate(0, 0, 1),
+		ODFIIdentification:    fmt.Sprintf("%.8s", exporter.config.ACHOriginRoutingNumber),
+	}
+
+	for _, entry := range entries {
+		transactionCode := TransactionCodeCheckingCredit
+		if entry.Beneficiary.AccountType == util.BankAccountTypeSavings {
+			transactionCode = TransactionCodeSavingsCredit
+		}
+
+		batch.Entries = append(batch.Entries, Entry{
+			TransactionCode:   transactionCode,
+			RDFIRoutingNumber: entry.Beneficiary.RoutingNumber,
+			AccountNumber:     entry.Beneficiary.AccountNumber,
+			Amount:            entry.Payment.Amount,
+			IndividualID:      individualID(entry.Payment.ID),
+			IndividualName:    entry.Beneficiary.Name,
+			TraceNumber:       entry.Payment.TraceNumber.String,
+		})
+	}
+
+	file := File{
+		ImmediateDestination:     exporter.config.ACHDestinationRoutingNumber,
+		ImmediateDestinationName: exporter.config.ACHDestinationName,
+		ImmediateOrigin:          exporter.config.ACHOriginRoutingNumber,
+		ImmediateOriginName:      exporter.config.ACHCompanyName,
+		CreatedAt:                now,
+		FileIDModifier:           achFile.FileIDModifier,
+		Batches:                  []Batch{batch},
+	}
+
+	out, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := Write(out, file); err != nil {
+		out.Close()
+		os.Remove(path)
+		return err
+	}
+	return out.Close()
+}
+
+// RunDaily exports the pending payments every day at the configured cut-off time until the context is done
+func (exporter *Exporter) RunDaily(ctx context.Context) {
+	for {
+		next := nextCutOff(exporter.now(), exporter.config.ACHCutOffTime)
+		timer := time.NewTimer(time.Until(next))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		result, path, err := exporter.Export(ctx)
+		if err != nil {
+			log.Printf("cannot export ach file: %v", err)
+			continue
+		}
+
+		if path == "" {
+			log.Printf("no pending outbound payment to export")
+			continue
+		}
+		log.Printf("exported %d outbound payments to %s", len(result.Entries), path)
+	}
+}
+
+// nextCutOff returns the next time of the day cutOff after midnight
+func nextCutOff(now time.Time, cutOff time.Duration) time.Time {
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	next := midnight.Add(cutOff)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// ReturnsResult lists what happened to the entries of a return file
+type ReturnsResult struct {
+	Returned []db.OutboundPayment `json:"returned"`
+	Skipped  []Return             `json:"skipped"`
+}
+
+// individualIDPrefix starts the individual id of our entries, which the receiving bank copies to its returns
+const individualIDPrefix = "OBP"
+
+// individualID identifies the payment of an entry, trace numbers restart with every file
+func individualID(paymentID int64) string {
+	return fmt.Sprintf("%s%d", individualIDPrefix, paymentID)
+}
+
+func parseIndividualID(value string) (int64, bool) {
+	id, err := strconv.ParseInt(strings.TrimPrefix(value, individualIDPrefix), 10, 64)
+	return id, err == nil && strings.HasPrefix(value, individualIDPrefix) && id > 0
+}
+
+// ImportReturns gives the money of every returned entry back to the account that sent it, found by the
+// individual id and the trace number of the entry. Entries that don't match a submitted payment are
+// skipped, so the same file can safely be imported twice
+func (exporter *Exporter) ImportReturns(ctx context.Context, returns []Return) (ReturnsResult, error) {
+	result := ReturnsResult{
+		Returned: []db.OutboundPayment{},
+		Skipped:  []Return{},
+	}
+
+	for _, ret := range returns {
+		paymentID, ok := parseIndividualID(ret.IndividualID)
+		if !ok {
+			log.Printf("skipping return %s of entry %s: unknown individual id %q", ret.ReturnCode, ret.OriginalTraceNumber, ret.IndividualID)
+			result.Skipped = append(result.Skipped, ret)
+			continue
+		}
+
+		txResult, err := exporter.store.ReturnOutboundPaymentTx(ctx, db.ReturnOutboundPaymentTxParams{
+			ID:          paymentID,
+			TraceNumber: ret.OriginalTraceNumber,
+			ReturnCode:  ret.ReturnCode,
+		})
+		if err != nil {
+			if errors.Is(err, db.ErrOutboundPaymentNotSubmitted) || err.Error() == sql.ErrNoRows.Error() {
+				log.Printf("skipping return %s of entry %s: %v", ret.ReturnCode, ret.OriginalTraceNumber, err)
+				result.Skipped = append(result.Skipped, ret)
+				continue
+			}
+			return result, err
+		}
+
+		result.Returned = append(result.Returned, txResult.OutboundPayment)
+	}
+	return result, nil
+}
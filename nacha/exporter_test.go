@@ -0,0 +1,165 @@
+package nacha
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func testExporter(t *testing.T, store db.Store) *Exporter {
+	exporter := NewExporter(store, util.Config{
+		ACHOutputDir:                t.TempDir(),
+		ACHOriginRoutingNumber:      "011000015",
+		ACHDestinationRoutingNumber: "021000021",
+		ACHDestinationName:          "Federal Reserve Bank",
+		ACHCompanyName:              "Simplebank",
+		ACHCompanyID:                "1234567890",
+		ACHCutOffTime:               17 * time.Hour,
+	})
+	exporter.now = func() time.Time {
+		return time.Date(2024, time.March, 1, 17, 0, 0, 0, time.UTC)
+	}
+	return exporter
+}
+
+func TestExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	entries := []db.OutboundPaymentEntry{
+		{
+			Payment: db.OutboundPayment{
+				ID:          1,
+				Amount:      12550,
+				TraceNumber: sql.NullString{String: "011000010000001", Valid: true},
+			},
+			Beneficiary: db.Beneficiary{
+				Name:          "Jane Doe",
+				RoutingNumber: "021000021",
+				AccountNumber: "123456789",
+				AccountType:   util.BankAccountTypeChecking,
+			},
+		},
+	}
+
+	store := mocks.NewMockStore(ctrl)
+	store.EXPECT().
+		SubmitOutboundPaymentsTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, arg db.SubmitOutboundPaymentsTxParams) (db.SubmitOutboundPaymentsTxResult, error) {
+			require.Equal(t, "ach-20240301T170000.txt", arg.FileName)
+			require.Equal(t, "011000015", arg.ODFIIdentification)
+			require.Equal(t, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), arg.Day)
+			achFile := db.AchFile{ID: 1, FileIDModifier: "C"}
+			return db.SubmitOutboundPaymentsTxResult{AchFile: achFile, Entries: entries}, arg.WriteFile(achFile, entries)
+		})
+
+	exporter := testExporter(t, store)
+	result, path, err := exporter.Export(context.Background())
+	require.NoError(t, err)
+	require.Len(t, result.Entries, 1)
+	require.Equal(t, filepath.Join(exporter.config.ACHOutputDir, "ach-20240301T170000.txt"), path)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(content), "101 021000021 0110000152403011700C094101"))
+	require.Contains(t, string(content), "622021000021123456789        0000012550OBP1           JANE DOE                0011000010000001")
+
+	files, err := os.ReadDir(exporter.config.ACHOutputDir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+}
+
+func TestExportNothingPending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mocks.NewMockStore(ctrl)
+	store.EXPECT().
+		SubmitOutboundPaymentsTx(gomock.Any(), gomock.Any()).
+		Return(db.SubmitOutboundPaymentsTxResult{}, nil)
+
+	exporter := testExporter(t, store)
+	_, path, err := exporter.Export(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, path)
+
+	files, err := os.ReadDir(exporter.config.ACHOutputDir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func TestExportRollback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mocks.NewMockStore(ctrl)
+	store.EXPECT().
+		SubmitOutboundPaymentsTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, arg db.SubmitOutboundPaymentsTxParams) (db.SubmitOutboundPaymentsTxResult, error) {
+			require.NoError(t, arg.WriteFile(db.AchFile{FileIDModifier: "A"}, nil))
+			return db.SubmitOutboundPaymentsTxResult{}, sql.ErrTxDone
+		})
+
+	exporter := testExporter(t, store)
+	_, _, err := exporter.Export(context.Background())
+	require.ErrorIs(t, err, sql.ErrTxDone)
+
+	// the file of a rolled back submission must not be left behind
+	files, err := os.ReadDir(exporter.config.ACHOutputDir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func TestImportReturns(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mocks.NewMockStore(ctrl)
+	store.EXPECT().
+		ReturnOutboundPaymentTx(gomock.Any(), db.ReturnOutboundPaymentTxParams{ID: 1, TraceNumber: "011000010000001", ReturnCode: "R01"}).
+		Return(db.OutboundPaymentTxResult{OutboundPayment: db.OutboundPayment{ID: 1, Status: util.OutboundPaymentStatusReturned}}, nil)
+	store.EXPECT().
+		ReturnOutboundPaymentTx(gomock.Any(), db.ReturnOutboundPaymentTxParams{ID: 3, TraceNumber: "011000010000003", ReturnCode: "R03"}).
+		Return(db.OutboundPaymentTxResult{}, db.ErrOutboundPaymentNotSubmitted)
+	store.EXPECT().
+		ReturnOutboundPaymentTx(gomock.Any(), db.ReturnOutboundPaymentTxParams{ID: 4, TraceNumber: "011000010000004", ReturnCode: "R02"}).
+		Return(db.OutboundPaymentTxResult{}, sql.ErrNoRows)
+
+	result, err := testExporter(t, store).ImportReturns(context.Background(), []Return{
+		{IndividualID: "OBP1", OriginalTraceNumber: "011000010000001", ReturnCode: "R01"},
+		{IndividualID: "OBP3", OriginalTraceNumber: "011000010000003", ReturnCode: "R03"},
+		{IndividualID: "OBP4", OriginalTraceNumber: "011000010000004", ReturnCode: "R02"},
+		{IndividualID: "INV42", OriginalTraceNumber: "011000010000005", ReturnCode: "R02"},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Returned, 1)
+	require.Len(t, result.Skipped, 3)
+
+	store.EXPECT().
+		ReturnOutboundPaymentTx(gomock.Any(), gomock.Any()).
+		Return(db.OutboundPaymentTxResult{}, errors.New("connection reset"))
+
+	_, err = testExporter(t, store).ImportReturns(context.Background(), []Return{{IndividualID: "OBP5", OriginalTraceNumber: "011000010000005", ReturnCode: "R01"}})
+	require.Error(t, err)
+}
+
+func TestNextCutOff(t *testing.T) {
+	cutOff := 17 * time.Hour
+
+	before := time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2024, time.March, 1, 17, 0, 0, 0, time.UTC), nextCutOff(before, cutOff))
+
+	after := time.Date(2024, time.March, 1, 17, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2024, time.March, 2, 17, 0, 0, 0, time.UTC), nextCutOff(after, cutOff))
+}
@@ -0,0 +1,219 @@
+// Package nacha writes NACHA formatted ACH files for outbound payments and reads the returns
+// sent back by the receiving banks
+package nacha
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// recordSize is the length of every record of a NACHA file
+const recordSize = 94
+
+// blockingFactor is the number of records per block, the last block is padded with 9s
+const blockingFactor = 10
+
+// Constants for the transaction codes of the entries we originate
+const (
+	TransactionCodeCheckingCredit = "22"
+	TransactionCodeSavingsCredit  = "32"
+)
+
+// serviceClassCreditsOnly is the service class of batches that only contain credits
+const serviceClassCreditsOnly = "220"
+
+// File is a NACHA file sent to the ACH operator
+type File struct {
+	ImmediateDestination     string
+	ImmediateDestinationName string
+	ImmediateOrigin          string
+	ImmediateOriginName      string
+	CreatedAt                time.Time
+	FileIDModifier           string
+	Batches                  []Batch
+}
+
+// Batch is a set of PPD entries of the same originator
+type Batch struct {
+	CompanyName           string
+	CompanyIdentification string
+	EntryDescription      string
+	EffectiveEntryDate    time.Time
+	ODFIIdentification    string
+	Entries               []Entry
+}
+
+// MaxEntryAmount is the largest amount in cents the 10 digit amount field of an entry can carry
+const MaxEntryAmount = 9999999999
+
+// Entry is a single credit to a receiver account, Amount is in cents
+type Entry struct {
+	TransactionCode   string
+	RDFIRoutingNumber string
+	AccountNumber     string
+	Amount            int64
+	IndividualID      string
+	IndividualName    string
+	TraceNumber       string
+}
+
+// Write renders the file in the NACHA fixed width format. Nothing is written when an entry or a total
+// doesn't fit in its field
+func Write(w io.Writer, file File) error {
+	var out strings.Builder
+	records := 0
+	var recordErr error
+	writeRecord := func(fields ...string) {
+		record := strings.Join(fields, "")
+		if len(record) != recordSize && recordErr == nil {
+			recordErr = fmt.Errorf("nacha record %q is %d characters long", record, len(record))
+		}
+		out.WriteString(record)
+		out.WriteString("\n")
+		records++
+	}
+
+	if len(file.ImmediateDestination) != 9 || len(file.ImmediateOrigin) != 9 {
+		return fmt.Errorf("immediate destination and origin must be 9 digit routing numbers")
+	}
+
+	writeRecord(
+		"1",
+		"01",
+		" "+file.ImmediateDestination,
+		" "+file.ImmediateOrigin,
+		file.CreatedAt.Format("060102"),
+		file.CreatedAt.Format("1504"),
+		alpha(file.FileIDModifier, 1),
+		"094",
+		fmt.Sprintf("%02d", blockingFactor),
+		"1",
+		alpha(file.ImmediateDestinationName, 23),
+		alpha(file.ImmediateOriginName, 23),
+		alpha("", 8),
+	)
+
+	var fileEntries, fileHash, fileCredit int64
+	for i, batch := range file.Batches {
+		batchNumber := int64(i + 1)
+		writeRecord(
+			"5",
+			serviceClassCreditsOnly,
+			alpha(batch.CompanyName, 16),
+			alpha("", 20),
+			alpha(batch.CompanyIdentification, 10),
+			"PPD",
+			alpha(batch.EntryDescription, 10),
+			alpha("", 6),
+			batch.EffectiveEntryDate.Format("060102"),
+			alpha("", 3),
+			"1",
+			alpha(batch.ODFIIdentification, 8),
+			numeric(batchNumber, 7),
+		)
+
+		var batchHash, batchCredit int64
+		for _, entry := range batch.Entries {
+			if _, err := strconv.ParseUint(entry.RDFIRoutingNumber, 10, 64); err != nil || len(entry.RDFIRoutingNumber) != 9 {
+				return fmt.Errorf("invalid routing number %q in entry %s", entry.RDFIRoutingNumber, entry.TraceNumber)
+			}
+			if entry.Amount <= 0 || !fits(entry.Amount, 10) {
+				return fmt.Errorf("amount %d of entry %s doesn't fit in the 10 digit amount field", entry.Amount, entry.TraceNumber)
+			}
+
+			writeRecord(
+				"6",
+				entry.TransactionCode,
+				entry.RDFIRoutingNumber,
+				alpha(entry.AccountNumber, 17),
+				numeric(entry.Amount, 10),
+				alpha(entry.IndividualID, 15),
+				alpha(entry.IndividualName, 22),
+				alpha("", 2),
+				"0",
+				alpha(entry.TraceNumber, 15),
+			)
+
+			rdfi, _ := strconv.ParseInt(entry.RDFIRoutingNumber[:8], 10, 64)
+			batchHash += rdfi
+			batchCredit += entry.Amount
+		}
+
+		if !fits(int64(len(batch.Entries)), 6) || !fits(batchCredit, 12) {
+			return fmt.Errorf("batch %d has %d entries for a total of %d, more than its control record can carry", batchNumber, len(batch.Entries), batchCredit)
+		}
+
+		writeRecord(
+			"8",
+			serviceClassCreditsOnly,
+			numeric(int64(len(batch.Entries)), 6),
+			numeric(batchHash%10000000000, 10),
+			numeric(0, 12),
+			numeric(batchCredit, 12),
+			alpha(batch.CompanyIdentification, 10),
+			alpha("", 19),
+			alpha("", 6),
+			alpha(batch.ODFIIdentification, 8),
+			numeric(batchNumber, 7),
+		)
+
+		fileEntries += int64(len(batch.Entries))
+		fileHash += batchHash
+		fileCredit += batchCredit
+	}
+
+	// the file control record is part of the last block
+	blocks := (records + 1 + blockingFactor - 1) / blockingFactor
+	if !fits(int64(len(file.Batches)), 6) || !fits(int64(blocks), 6) || !fits(fileEntries, 8) || !fits(fileCredit, 12) {
+		return fmt.Errorf("file has %d entries for a total of %d, more than its control record can carry", fileEntries, fileCredit)
+	}
+	writeRecord(
+		"9",
+		numeric(int64(len(file.Batches)), 6),
+		numeric(int64(blocks), 6),
+		numeric(fileEntries, 8),
+		numeric(fileHash%10000000000, 10),
+		numeric(0, 12),
+		numeric(fileCredit, 12),
+		alpha("", 39),
+	)
+
+	for records%blockingFactor != 0 {
+		writeRecord(strings.Repeat("9", recordSize))
+	}
+
+	if recordErr != nil {
+		return recordErr
+	}
+
+	_, err := io.WriteString(w, out.String())
+	return err
+}
+
+// alpha left justifies an alphanumeric field, padding it with spaces or truncating it.
+// Characters outside of printable ASCII are not allowed and become spaces
+func alpha(value string, size int) string {
+	value = strings.Map(func(r rune) rune {
+		if r < ' ' || r > '~' {
+			return ' '
+		}
+		return r
+	}, strings.ToUpper(value))
+	if len(value) > size {
+		return value[:size]
+	}
+	return value + strings.Repeat(" ", size-len(value))
+}
+
+// fits reports whether a positive value or zero can be written in a numeric field of size digits
+func fits(value int64, size int) bool {
+	return value >= 0 && len(strconv.FormatInt(value, 10)) <= size
+}
+
+// numeric right justifies a numeric field, padding it with zeros
+func numeric(value int64, size int) string {
+	return fmt.Sprintf("%0*d", size, value)
+}
@@ -0,0 +1,144 @@
+package nacha
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func testFile() File {
+	createdAt := time.Date(2024, time.March, 1, 17, 0, 0, 0, time.UTC)
+	return File{
+		ImmediateDestination:     "021000021",
+		ImmediateDestinationName: "Federal Reserve Bank",
+		ImmediateOrigin:          "011000015",
+		ImmediateOriginName:      "Simplebank",
+		CreatedAt:                createdAt,
+		FileIDModifier:           "A",
+		Batches: []Batch{
+			{
+				CompanyName:           "Simplebank",
+				CompanyIdentification: "1234567890",
+				EntryDescription:      "Payment",
+				EffectiveEntryDate:    createdAt.AddDate(0, 0, 1),
+				ODFIIdentification:    "01100001",
+				Entries: []Entry{
+					{
+						TransactionCode:   TransactionCodeCheckingCredit,
+						RDFIRoutingNumber: "021000021",
+						AccountNumber:     "123456789",
+						Amount:            12550,
+						IndividualID:      "OBP1",
+						IndividualName:    "Jane Doe",
+						TraceNumber:       "011000010000001",
+					},
+					{
+						TransactionCode:   TransactionCodeSavingsCredit,
+						RDFIRoutingNumber: "011000015",
+						AccountNumber:     "98765432101234567",
+						Amount:            100,
+						IndividualID:      "OBP2",
+						IndividualName:    "Zoë Supplier With A Very Long Name",
+						TraceNumber:       "011000010000002",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestWrite(t *testing.T) {
+	var out bytes.Buffer
+	err := Write(&out, testFile())
+	require.NoError(t, err)
+
+	records := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	require.Len(t, records, blockingFactor)
+	for _, record := range records {
+		require.Len(t, record, recordSize)
+	}
+
+	// entry hash is the sum of the 8 first digits of the receiving bank routing numbers
+	require.Equal(t, "0003200003", records[4][10:20])
+	require.Equal(t, "000000012650", records[4][32:44])
+
+	golden := "testdata/ach.golden"
+	if *update {
+		require.NoError(t, os.WriteFile(golden, out.Bytes(), 0o644))
+	}
+
+	expected, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), out.String())
+}
+
+func TestWriteInvalidRoutingNumber(t *testing.T) {
+	file := testFile()
+	file.Batches[0].Entries[0].RDFIRoutingNumber = "0210"
+
+	err := Write(&bytes.Buffer{}, file)
+	require.Error(t, err)
+}
+
+func TestWriteAmountOverflow(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(file *File)
+	}{
+		{
+			name: "entry amount too large",
+			modify: func(file *File) {
+				file.Batches[0].Entries[0].Amount = MaxEntryAmount + 1
+			},
+		},
+		{
+			name: "negative entry amount",
+			modify: func(file *File) {
+				file.Batches[0].Entries[0].Amount = -100
+			},
+		},
+		{
+			name: "batch total too large",
+			modify: func(file *File) {
+				entry := file.Batches[0].Entries[0]
+				entry.Amount = MaxEntryAmount
+				entries := make([]Entry, 101)
+				for i := range entries {
+					entries[i] = entry
+				}
+				file.Batches[0].Entries = entries
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			file := testFile()
+			tc.modify(&file)
+
+			var out bytes.Buffer
+			require.NotPanics(t, func() {
+				err := Write(&out, file)
+				require.Error(t, err)
+			})
+			require.Zero(t, out.Len())
+		})
+	}
+}
+
+func TestWriteMaxEntryAmount(t *testing.T) {
+	file := testFile()
+	file.Batches[0].Entries[0].Amount = MaxEntryAmount
+
+	var out bytes.Buffer
+	err := Write(&out, file)
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "9999999999")
+}
@@ -0,0 +1,77 @@
+package nacha
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Return is an entry sent back by the receiving bank, identified by the individual id and the trace number we assigned
+type Return struct {
+	IndividualID        string `json:"individual_id"`
+	OriginalTraceNumber string `json:"original_trace_number"`
+	ReturnCode          string `json:"return_code"`
+	Amount              int64  `json:"amount"`
+}
+
+// ParseReturns reads the returned entries of a NACHA return file. Every return is an entry detail
+// record followed by a 99 addenda record carrying the return reason code and the original trace number,
+// notifications of change (98 addenda) don't move money and are ignored
+func ParseReturns(r io.Reader) ([]Return, error) {
+	var returns []Return
+	var amount int64
+	var individualID string
+	var hasEntry bool
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		record := strings.TrimRight(scanner.Text(), "\r")
+		if record == "" || strings.Trim(record, "9") == "" {
+			continue
+		}
+
+		if len(record) != recordSize {
+			return nil, fmt.Errorf("line %d: record is %d characters long, expected %d", line, len(record), recordSize)
+		}
+
+		switch record[0] {
+		case '6':
+			value, err := strconv.ParseInt(record[29:39], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid amount %q", line, record[29:39])
+			}
+			amount = value
+			individualID = strings.TrimSpace(record[39:54])
+			hasEntry = true
+		case '7':
+			if record[1:3] != "99" {
+				continue
+			}
+			if !hasEntry {
+				return nil, fmt.Errorf("line %d: return addenda without entry detail record", line)
+			}
+
+			code := record[3:6]
+			if code[0] != 'R' {
+				return nil, fmt.Errorf("line %d: invalid return reason code %q", line, code)
+			}
+
+			returns = append(returns, Return{
+				IndividualID:        individualID,
+				OriginalTraceNumber: record[6:21],
+				ReturnCode:          code,
+				Amount:              amount,
+			})
+			hasEntry = false
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return returns, nil
+}
@@ -0,0 +1,33 @@
+package nacha
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReturns(t *testing.T) {
+	file, err := os.Open("testdata/returns.ach")
+	require.NoError(t, err)
+	defer file.Close()
+
+	returns, err := ParseReturns(file)
+	require.NoError(t, err)
+	require.Equal(t, []Return{
+		{IndividualID: "OBP1", OriginalTraceNumber: "011000010000001", ReturnCode: "R01", Amount: 12550},
+		{IndividualID: "OBP3", OriginalTraceNumber: "011000010000003", ReturnCode: "R03", Amount: 100},
+	}, returns)
+}
+
+func TestParseReturnsInvalidRecord(t *testing.T) {
+	_, err := ParseReturns(strings.NewReader("6220110000151234\n"))
+	require.Error(t, err)
+}
+
+func TestParseReturnsAddendaWithoutEntry(t *testing.T) {
+	addenda := "799R01011000010000001" + strings.Repeat(" ", recordSize-21)
+	_, err := ParseReturns(strings.NewReader(addenda + "\n"))
+	require.Error(t, err)
+}
@@ -0,0 +1,387 @@
+package pain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Sinothic/simplebank/access"
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+)
+
+// Importer turns the credit transfers of pain.001 messages into transfers between our accounts
+type Importer struct {
+	store      db.Store
+	coolingOff access.CoolingOffPolicy
+	now        func() time.Time
+}
+
+// NewImporter creates a new Importer
+func NewImporter(store db.Store, config util.Config) *Importer {
+	return &Importer{
+		store:      store,
+		coolingOff: access.CoolingOffPolicy{Period: config.PayeeCoolingOffPeriod, Limit: config.PayeeCoolingOffLimit},
+		now:        time.Now,
+	}
+}
+
+// Import executes every credit transfer of the message on behalf of the user, who must be allowed to
+// transact on the debtor accounts. Transactions above the transfer limit of an organisation member wait
+// for an approval, transactions above the cooling-off limit to a creditor the user saved as a payee recently
+// are rejected and rejected transactions don't stop the others, the returned report says what happened
+// to each of them
+func (importer *Importer) Import(ctx context.Context, userID int64, doc *Document) (*StatusReport, error) {
+	header := doc.Initiation.GroupHeader
+	report := &StatusReport{
+		MessageID:          fmt.Sprintf("STS-%s", header.MessageID),
+		CreatedAt:          importer.now(),
+		OriginalMessageID:  header.MessageID,
+		OriginalNbOfTxs:    header.NumberOfTransactions,
+		OriginalControlSum: header.ControlSum,
+	}
+
+	if reason := checkGroupHeader(doc); reason != nil {
+		return rejectGroup(report, reason), nil
+	}
+
+	_, err := importer.store.GetPaymentBatch(ctx, db.GetPaymentBatchParams{
+		UserID:    userID,
+		MessageID: header.MessageID,
+	})
+	if err == nil {
+		return rejectGroup(report, &StatusReason{Code: ReasonDuplicateMessage, AdditionalInfo: "message already imported"}), nil
+	}
+	if err.Error() != sql.ErrNoRows.Error() {
+		return nil, err
+	}
+
+	batch, err := importer.store.CreatePaymentBatch(ctx, db.CreatePaymentBatchParams{
+		UserID:               userID,
+		MessageID:            header.MessageID,
+		NumberOfTransactions: int64(doc.TransactionCount()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var counts statusCounts
+	for _, payment := range doc.Initiation.Payments {
+		paymentStatus := importer.importPayment(ctx, userID, payment)
+		for _, tx := range paymentStatus.Transactions {
+			counts.add(tx.Status)
+		}
+		report.Payments = append(report.Payments, paymentStatus)
+	}
+
+	// the transactions held for an approval aren't rejected, the batch counts them as accepted
+	_, err = importer.store.UpdatePaymentBatchResult(ctx, db.UpdatePaymentBatchResultParams{
+		ID:                   batch.ID,
+		AcceptedTransactions: counts.accepted + counts.pending,
+		RejectedTransactions: counts.rejected,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report.GroupStatus = counts.overallStatus()
+	return report, nil
+}
+
+func (importer *Importer) importPayment(ctx context.Context, userID int64, payment PaymentInformation) PaymentStatus {
+	status := PaymentStatus{PaymentInformationID: payment.PaymentInformationID}
+
+	debtor, debtorAccess, reason := importer.debtorAccount(ctx, userID, payment.DebtorAccount)
+	available := debtor.Balance
+
+	var counts statusCounts
+	for _, cdtTrf := range payment.CreditTransfers {
+		txStatus := TransactionStatus{
+			InstructionID: cdtTrf.InstructionID,
+			EndToEndID:    cdtTrf.EndToEndID,
+			Status:        StatusRejected,
+			Reason:        reason,
+		}
+
+		if reason == nil {
+			amount, txReason := importer.checkCreditTransfer(ctx, debtor, available, cdtTrf)
+			reference, description := "", ""
+			if txReason == nil {
+				reference, description, txReason = transferDetails(cdtTrf)
+			}
+			creditor, _ := strconv.ParseInt(cdtTrf.CreditorAccount.Other, 10, 64)
+			if txReason == nil {
+				txReason = importer.checkCoolingOff(ctx, userID, creditor, amount)
+			}
+			txStatus.Reason = txReason
+			if txReason == nil {
+				arg := db.TransferTxParams{
+					FromAccountID: debtor.ID,
+					ToAccountID:   creditor,
+					Amount:        amount,
+					Description:   description,
+					Reference:     reference,
+				}
+				if debtorAccess.Exceeds(amount) {
+					txStatus = importer.holdTransfer(ctx, userID, debtor, arg, txStatus)
+				} else {
+					txStatus = importer.transfer(ctx, arg, txStatus)
+					if txStatus.Status == StatusAccepted {
+						available -= amount
+					}
+				}
+			}
+		}
+
+		counts.add(txStatus.Status)
+		status.Transactions = append(status.Transactions, txStatus)
+	}
+
+	status.Status = counts.overallStatus()
+	return status
+}
+
+// transfer executes a credit transfer
+func (importer *Importer) transfer(ctx context.Context, arg db.TransferTxParams, txStatus TransactionStatus) TransactionStatus {
+	result, err := importer.store.TransferTx(ctx, arg)
+	if err != nil {
+		log.Printf("cannot execute credit transfer %s: %v", txStatus.EndToEndID, err)
+		txStatus.Reason = &StatusReason{Code: ReasonNarrative, AdditionalInfo: "internal error, the transfer was not executed"}
+		return txStatus
+	}
+
+	txStatus.Status = StatusAccepted
+	txStatus.TransferID = result.Transfer.ID
+	return txStatus
+}
+
+// holdTransfer keeps a credit transfer above the transfer limit of the organisation member who initiated
+// the message until another member approves it, like the transfers of the API
+func (importer *Importer) holdTransfer(ctx context.Context, userID int64, debtor db.Account, arg db.TransferTxParams, txStatus TransactionStatus) TransactionStatus {
+	approval, err := access.HoldTransfer(ctx, importer.store, userID, debtor, arg)
+	if err != nil {
+		log.Printf("cannot hold credit transfer %s: %v", txStatus.EndToEndID, err)
+		txStatus.Reason = &StatusReason{Code: ReasonNarrative, AdditionalInfo: "internal error, the transfer was not held for approval"}
+		return txStatus
+	}
+
+	txStatus.Status = StatusPending
+	txStatus.ApprovalID = approval.ID
+	return txStatus
+}
+
+// checkCoolingOff applies the payee cooling-off of the API to the creditor account
+func (importer *Importer) checkCoolingOff(ctx context.Context, userID int64, creditorID int64, amount int64) *StatusReason {
+	err := importer.coolingOff.Check(ctx, importer.store, userID, access.Destination{AccountID: creditorID}, amount)
+	if err == nil {
+		return nil
+	}
+
+	var accessErr *access.Error
+	if !errors.As(err, &accessErr) {
+		log.Printf("cannot check the cooling-off of user %d to account %d: %v", userID, creditorID, err)
+		return &StatusReason{Code: ReasonNarrative, AdditionalInfo: "internal error, the creditor account was not checked"}
+	}
+	return &StatusReason{
+		Code:           ReasonTransactionForbidden,
+		AdditionalInfo: fmt.Sprintf("creditor saved as a payee less than %s ago, amounts limited to %d", importer.coolingOff.Period, importer.coolingOff.Limit),
+	}
+}
+
+// debtorAccount resolves the debtor account with the access rules of the API: the organisation membership
+// of the user for the accounts of an organisation and the account holders otherwise
+func (importer *Importer) debtorAccount(ctx context.Context, userID int64, cashAccount CashAccount) (db.Account, access.Access, *StatusReason) {
+	unknown := &StatusReason{Code: ReasonIncorrectAccountNumber, AdditionalInfo: "unknown account"}
+
+	id, ok := accountID(cashAccount)
+	if !ok {
+		return db.Account{}, access.Access{}, unknown
+	}
+
+	account, debtorAccess, err := access.Resolve(ctx, importer.store, userID, id, util.AccountPermissionTransact)
+	if err != nil {
+		var accessErr *access.Error
+		switch {
+		case !errors.As(err, &accessErr):
+			log.Printf("cannot resolve the access of user %d to account %d: %v", userID, id, err)
+			return account, debtorAccess, &StatusReason{Code: ReasonNarrative, AdditionalInfo: "internal error, the debtor account was not checked"}
+		case accessErr.Code == access.NotFound:
+			return account, debtorAccess, unknown
+		case accessErr.Code == access.Frozen:
+			return account, debtorAccess, &StatusReason{Code: ReasonTransactionForbidden, AdditionalInfo: "debtor account is frozen"}
+		default:
+			return account, debtorAccess, &StatusReason{Code: ReasonTransactionForbidden, AdditionalInfo: "initiating party is not allowed to transact on the debtor account"}
+		}
+	}
+
+	if account.Kind != util.AccountKindCustomer {
+		return db.Account{}, access.Access{}, unknown
+	}
+
+	if cashAccount.Currency != "" && cashAccount.Currency != account.Currency {
+		return account, debtorAccess, &StatusReason{Code: ReasonInvalidCurrency, AdditionalInfo: fmt.Sprintf("debtor account currency is %s", account.Currency)}
+	}
+	return account, debtorAccess, nil
+}
+
+func (importer *Importer) checkCreditTransfer(ctx context.Context, debtor db.Account, available int64, cdtTrf CreditTransfer) (int64, *StatusReason) {
+	amount, err := ParseAmount(cdtTrf.Amount.Value)
+	if err != nil || amount == 0 {
+		return 0, &StatusReason{Code: ReasonInvalidAmount, AdditionalInfo: "amount must be positive with at most two decimals"}
+	}
+
+	if cdtTrf.Amount.Currency != debtor.Currency {
+		return 0, &StatusReason{Code: ReasonInvalidCurrency, AdditionalInfo: fmt.Sprintf("debtor account currency is %s", debtor.Currency)}
+	}
+
+	creditor, reason := importer.resolveAccount(ctx, cdtTrf.CreditorAccount)
+	if reason != nil {
+		return 0, reason
+	}
+
+	if creditor.ID == debtor.ID {
+		return 0, &StatusReason{Code: ReasonTransactionForbidden, AdditionalInfo: "creditor and debtor accounts are the same"}
+	}
+
+	if creditor.Currency != debtor.Currency {
+		return 0, &StatusReason{Code: ReasonInvalidCurrency, AdditionalInfo: fmt.Sprintf("creditor account currency is %s", creditor.Currency)}
+	}
+
+	if amount > available {
+		return 0, &StatusReason{Code: ReasonInsufficientFunds}
+	}
+	return amount, nil
+}
+
+// notProvided is the end to end id of the credit transfers the debtor didn't give a reference to
+const notProvided = "NOTPROVIDED"
+
+// transferDetails returns the reference and the description of the transfer made for a credit transfer: its end
+// to end id, which matches the transfer back to the message on statements and in searches, and its unstructured
+// remittance information. They follow the rules of the transfer details of the API
+func transferDetails(cdtTrf CreditTransfer) (string, string, *StatusReason) {
+	reference := cdtTrf.EndToEndID
+	if reference == notProvided {
+		reference = ""
+	}
+	if len(reference) > 35 || !util.IsValidReference(reference) {
+		return "", "", &StatusReason{Code: ReasonNarrative, AdditionalInfo: "end to end id must be at most 35 SWIFT characters"}
+	}
+
+	var description string
+	if cdtTrf.Remittance != nil {
+		description = cdtTrf.Remittance.Unstructured
+	}
+	if utf8.RuneCountInString(description) > 140 || !util.IsValidDescription(description) {
+		return "", "", &StatusReason{Code: ReasonNarrative, AdditionalInfo: "remittance information must be a single line of at most 140 characters"}
+	}
+	return reference, description, nil
+}
+
+// resolveAccount finds the customer account identified by the other identification of a cash account
+func (importer *Importer) resolveAccount(ctx context.Context, cashAccount CashAccount) (db.Account, *StatusReason) {
+	unknown := &StatusReason{Code: ReasonIncorrectAccountNumber, AdditionalInfo: "unknown account"}
+
+	id, ok := accountID(cashAccount)
+	if !ok {
+		return db.Account{}, unknown
+	}
+
+	account, err := importer.store.GetAccount(ctx, id)
+	if err != nil {
+		if err.Error() != sql.ErrNoRows.Error() {
+			log.Printf("cannot get account %d: %v", id, err)
+		}
+		return db.Account{}, unknown
+	}
+
+	if account.Kind != util.AccountKindCustomer {
+		return db.Account{}, unknown
+	}
+	return account, nil
+}
+
+// accountID reads our account id from the other identification of a cash account
+func accountID(cashAccount CashAccount) (int64, bool) {
+	id, err := strconv.ParseInt(cashAccount.Other, 10, 64)
+	return id, err == nil && id > 0
+}
+
+// checkGroupHeader makes sure the message is complete before anything is executed
+func checkGroupHeader(doc *Document) *StatusReason {
+	header := doc.Initiation.GroupHeader
+	if header.MessageID == "" {
+		return &StatusReason{Code: ReasonNarrative, AdditionalInfo: "missing message id"}
+	}
+
+	count, err := strconv.Atoi(header.NumberOfTransactions)
+	if err != nil || count != doc.TransactionCount() {
+		return &StatusReason{Code: ReasonInvalidNumberOfTxs}
+	}
+
+	if header.ControlSum == "" {
+		return nil
+	}
+
+	controlSum, err := ParseAmount(header.ControlSum)
+	if err != nil {
+		return &StatusReason{Code: ReasonInvalidControlSum}
+	}
+
+	var total int64
+	for _, payment := range doc.Initiation.Payments {
+		for _, cdtTrf := range payment.CreditTransfers {
+			amount, err := ParseAmount(cdtTrf.Amount.Value)
+			if err != nil {
+				return &StatusReason{Code: ReasonInvalidAmount, AdditionalInfo: fmt.Sprintf("invalid amount in %s", cdtTrf.EndToEndID)}
+			}
+			total += amount
+		}
+	}
+
+	if total != controlSum {
+		return &StatusReason{Code: ReasonInvalidControlSum}
+	}
+	return nil
+}
+
+func rejectGroup(report *StatusReport, reason *StatusReason) *StatusReport {
+	report.GroupStatus = StatusRejected
+	report.GroupReason = reason
+	return report
+}
+
+// statusCounts counts the transactions of a message or a payment by status
+type statusCounts struct {
+	accepted, pending, rejected int64
+}
+
+func (counts *statusCounts) add(status string) {
+	switch status {
+	case StatusAccepted:
+		counts.accepted++
+	case StatusPending:
+		counts.pending++
+	default:
+		counts.rejected++
+	}
+}
+
+// overallStatus is accepted or rejected when every transaction is, pending when they all wait for an
+// approval and partially accepted otherwise
+func (counts statusCounts) overallStatus() string {
+	switch {
+	case counts.pending == 0 && counts.rejected == 0:
+		return StatusAccepted
+	case counts.accepted == 0 && counts.pending == 0:
+		return StatusRejected
+	case counts.accepted == 0 && counts.rejected == 0:
+		return StatusPending
+	}
+	return StatusPartiallyAccepted
+}
@@ -0,0 +1,331 @@
+package pain
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"flag"
+	"os"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// testConfig limits the transfers to a payee saved less than a day ago to 1000.00
+var testConfig = util.Config{PayeeCoolingOffPeriod: 24 * time.Hour, PayeeCoolingOffLimit: 100000}
+
+func testDocument(t *testing.T) *Document {
+	file, err := os.Open("testdata/pain001.xml")
+	require.NoError(t, err)
+	defer file.Close()
+
+	doc, err := Parse(file)
+	require.NoError(t, err)
+	return doc
+}
+
+func TestImport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mocks.NewMockStore(ctrl)
+	store.EXPECT().
+		GetPaymentBatch(gomock.Any(), db.GetPaymentBatchParams{UserID: 1, MessageID: "BATCH-2024-0042"}).
+		Return(db.PaymentBatch{}, sql.ErrNoRows)
+	store.EXPECT().
+		CreatePaymentBatch(gomock.Any(), db.CreatePaymentBatchParams{UserID: 1, MessageID: "BATCH-2024-0042", NumberOfTransactions: 4}).
+		Return(db.PaymentBatch{ID: 5}, nil)
+
+	accounts := map[int64]db.Account{
+		1: {ID: 1, UserID: 1, Balance: 15000, Currency: "USD", Kind: "customer"},
+		2: {ID: 2, UserID: 2, Currency: "USD", Kind: "customer"},
+		3: {ID: 3, UserID: 3, Currency: "USD", Kind: "customer"},
+		4: {ID: 4, UserID: 9, Balance: 100000, Currency: "USD", Kind: "customer"},
+	}
+	for id, account := range accounts {
+		store.EXPECT().GetAccount(gomock.Any(), id).Return(account, nil)
+	}
+	store.EXPECT().GetAccount(gomock.Any(), int64(999)).Return(db.Account{}, sql.ErrNoRows)
+	store.EXPECT().
+		GetAccountHolder(gomock.Any(), db.GetAccountHolderParams{AccountID: 1, UserID: 1}).
+		Return(db.AccountHolder{AccountID: 1, UserID: 1, Role: util.AccountRoleOwner}, nil)
+	store.EXPECT().
+		GetAccountHolder(gomock.Any(), db.GetAccountHolderParams{AccountID: 4, UserID: 1}).
+		Return(db.AccountHolder{}, sql.ErrNoRows)
+
+	store.EXPECT().
+		TransferTx(gomock.Any(), db.TransferTxParams{
+			FromAccountID: 1,
+			ToAccountID:   2,
+			Amount:        10000,
+			Description:   "Invoice 1001",
+			Reference:     "E2E-1",
+		}).
+		Return(db.TransferTxResult{Transfer: db.Transfer{ID: 10}}, nil)
+	store.EXPECT().
+		UpdatePaymentBatchResult(gomock.Any(), db.UpdatePaymentBatchResultParams{ID: 5, AcceptedTransactions: 1, RejectedTransactions: 3}).
+		Return(db.PaymentBatch{}, nil)
+
+	importer := NewImporter(store, testConfig)
+	importer.now = func() time.Time { return time.Date(2024, time.March, 1, 8, 5, 0, 0, time.UTC) }
+
+	report, err := importer.Import(context.Background(), 1, testDocument(t))
+	require.NoError(t, err)
+
+	require.Equal(t, StatusPartiallyAccepted, report.GroupStatus)
+	require.Len(t, report.Payments, 2)
+
+	transactions := report.Payments[0].Transactions
+	require.Equal(t, StatusPartiallyAccepted, report.Payments[0].Status)
+	require.Equal(t, StatusAccepted, transactions[0].Status)
+	require.Equal(t, int64(10), transactions[0].TransferID)
+	require.Equal(t, ReasonInsufficientFunds, transactions[1].Reason.Code)
+	require.Equal(t, ReasonIncorrectAccountNumber, transactions[2].Reason.Code)
+
+	require.Equal(t, StatusRejected, report.Payments[1].Status)
+	require.Equal(t, ReasonTransactionForbidden, report.Payments[1].Transactions[0].Reason.Code)
+
+	var buf bytes.Buffer
+	require.NoError(t, WritePain002(&buf, report))
+
+	if *update {
+		require.NoError(t, os.WriteFile("testdata/pain002.golden", buf.Bytes(), 0o644))
+	}
+	golden, err := os.ReadFile("testdata/pain002.golden")
+	require.NoError(t, err)
+	require.Equal(t, string(golden), buf.String())
+}
+
+func TestImportOrganisationDebtor(t *testing.T) {
+	debtor := db.Account{ID: 4, Balance: 100000, Currency: "USD", Kind: "customer", OrganisationID: sql.NullInt64{Int64: 7, Valid: true}}
+	creditor := db.Account{ID: 2, UserID: 2, Currency: "USD", Kind: "customer"}
+
+	testCases := []struct {
+		name           string
+		member         db.OrganisationMember
+		memberErr      error
+		TransferTimes  int
+		HoldTimes      int
+		expectedStatus string
+		expectedReason string
+	}{
+		{
+			name:           "member within the limit",
+			member:         db.OrganisationMember{OrganisationID: 7, UserID: 1, Role: util.OrganisationRoleInitiator, TransferLimit: sql.NullInt64{Int64: 1000, Valid: true}},
+			TransferTimes:  1,
+			expectedStatus: StatusAccepted,
+		},
+		{
+			name:           "member above the limit",
+			member:         db.OrganisationMember{OrganisationID: 7, UserID: 1, Role: util.OrganisationRoleInitiator, TransferLimit: sql.NullInt64{Int64: 999, Valid: true}},
+			HoldTimes:      1,
+			expectedStatus: StatusPending,
+		},
+		{
+			name:           "viewer",
+			member:         db.OrganisationMember{OrganisationID: 7, UserID: 1, Role: util.OrganisationRoleViewer},
+			expectedStatus: StatusRejected,
+			expectedReason: ReasonTransactionForbidden,
+		},
+		{
+			name:           "not a member",
+			memberErr:      sql.ErrNoRows,
+			expectedStatus: StatusRejected,
+			expectedReason: ReasonTransactionForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			doc := testDocument(t)
+			doc.Initiation.Payments = doc.Initiation.Payments[1:]
+			doc.Initiation.GroupHeader.NumberOfTransactions = "1"
+			doc.Initiation.GroupHeader.ControlSum = ""
+
+			store := mocks.NewMockStore(ctrl)
+			store.EXPECT().GetPaymentBatch(gomock.Any(), gomock.Any()).Return(db.PaymentBatch{}, sql.ErrNoRows)
+			store.EXPECT().CreatePaymentBatch(gomock.Any(), gomock.Any()).Return(db.PaymentBatch{ID: 5}, nil)
+			store.EXPECT().GetAccount(gomock.Any(), debtor.ID).Return(debtor, nil)
+			store.EXPECT().GetAccount(gomock.Any(), creditor.ID).Return(creditor, nil).AnyTimes()
+			store.EXPECT().
+				GetOrganisationMember(gomock.Any(), db.GetOrganisationMemberParams{OrganisationID: 7, UserID: 1}).
+				Return(tc.member, tc.memberErr)
+			store.EXPECT().GetAccountHolder(gomock.Any(), gomock.Any()).Times(0)
+			store.EXPECT().
+				TransferTx(gomock.Any(), gomock.Any()).
+				Return(db.TransferTxResult{Transfer: db.Transfer{ID: 10}}, nil).
+				Times(tc.TransferTimes)
+			store.EXPECT().
+				CreateTransferApproval(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.CreateTransferApprovalParams) (db.TransferApproval, error) {
+					require.Equal(t, int64(7), arg.OrganisationID)
+					require.Equal(t, int64(1), arg.InitiatorID)
+					require.Equal(t, int64(1000), arg.Amount)
+					return db.TransferApproval{ID: 3, OrganisationID: arg.OrganisationID, Status: util.TransferApprovalStatusPending}, nil
+				}).
+				Times(tc.HoldTimes)
+			store.EXPECT().UpdatePaymentBatchResult(gomock.Any(), gomock.Any()).Return(db.PaymentBatch{}, nil)
+
+			report, err := NewImporter(store, testConfig).Import(context.Background(), 1, doc)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedStatus, report.GroupStatus)
+
+			tx := report.Payments[0].Transactions[0]
+			require.Equal(t, tc.expectedStatus, tx.Status)
+			if tc.expectedReason != "" {
+				require.Equal(t, tc.expectedReason, tx.Reason.Code)
+			}
+			if tc.HoldTimes > 0 {
+				require.Equal(t, int64(3), tx.ApprovalID)
+			}
+		})
+	}
+}
+
+func TestImportCoolingOff(t *testing.T) {
+	config := util.Config{PayeeCoolingOffPeriod: 24 * time.Hour, PayeeCoolingOffLimit: 500}
+	debtor := db.Account{ID: 4, Balance: 100000, Currency: "USD", Kind: "customer"}
+	creditor := db.Account{ID: 2, UserID: 2, Currency: "USD", Kind: "customer"}
+	payee := db.Payee{ID: 6, UserID: 1, AccountID: sql.NullInt64{Int64: creditor.ID, Valid: true}}
+
+	testCases := []struct {
+		name           string
+		payeeCreatedAt time.Time
+		payeeErr       error
+		TransferTimes  int
+		expectedStatus string
+	}{
+		{name: "new payee", payeeCreatedAt: time.Now().Add(-time.Hour), expectedStatus: StatusRejected},
+		{name: "payee saved long ago", payeeCreatedAt: time.Now().Add(-48 * time.Hour), TransferTimes: 1, expectedStatus: StatusAccepted},
+		{name: "not a payee", payeeErr: sql.ErrNoRows, TransferTimes: 1, expectedStatus: StatusAccepted},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			doc := testDocument(t)
+			doc.Initiation.Payments = doc.Initiation.Payments[1:]
+			doc.Initiation.GroupHeader.NumberOfTransactions = "1"
+			doc.Initiation.GroupHeader.ControlSum = ""
+
+			store := mocks.NewMockStore(ctrl)
+			store.EXPECT().GetPaymentBatch(gomock.Any(), gomock.Any()).Return(db.PaymentBatch{}, sql.ErrNoRows)
+			store.EXPECT().CreatePaymentBatch(gomock.Any(), gomock.Any()).Return(db.PaymentBatch{ID: 5}, nil)
+			store.EXPECT().GetAccount(gomock.Any(), debtor.ID).Return(debtor, nil)
+			store.EXPECT().GetAccount(gomock.Any(), creditor.ID).Return(creditor, nil)
+			store.EXPECT().
+				GetAccountHolder(gomock.Any(), db.GetAccountHolderParams{AccountID: debtor.ID, UserID: 1}).
+				Return(db.AccountHolder{AccountID: debtor.ID, UserID: 1, Role: util.AccountRoleOwner}, nil)
+
+			payee.CreatedAt = tc.payeeCreatedAt
+			store.EXPECT().
+				GetNewestPayeeOfDestination(gomock.Any(), db.GetNewestPayeeOfDestinationParams{
+					UserID:    1,
+					AccountID: sql.NullInt64{Int64: creditor.ID, Valid: true},
+				}).
+				Return(payee, tc.payeeErr)
+			store.EXPECT().
+				TransferTx(gomock.Any(), gomock.Any()).
+				Return(db.TransferTxResult{Transfer: db.Transfer{ID: 10}}, nil).
+				Times(tc.TransferTimes)
+			store.EXPECT().UpdatePaymentBatchResult(gomock.Any(), gomock.Any()).Return(db.PaymentBatch{}, nil)
+
+			report, err := NewImporter(store, config).Import(context.Background(), 1, doc)
+			require.NoError(t, err)
+
+			tx := report.Payments[0].Transactions[0]
+			require.Equal(t, tc.expectedStatus, tx.Status)
+			if tc.expectedStatus == StatusRejected {
+				require.Equal(t, ReasonTransactionForbidden, tx.Reason.Code)
+				require.Equal(t, "creditor saved as a payee less than 24h0m0s ago, amounts limited to 500", tx.Reason.AdditionalInfo)
+			}
+		})
+	}
+}
+
+func TestImportDuplicateMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mocks.NewMockStore(ctrl)
+	store.EXPECT().
+		GetPaymentBatch(gomock.Any(), gomock.Any()).
+		Return(db.PaymentBatch{ID: 5}, nil)
+
+	report, err := NewImporter(store, testConfig).Import(context.Background(), 1, testDocument(t))
+	require.NoError(t, err)
+	require.Equal(t, StatusRejected, report.GroupStatus)
+	require.Equal(t, ReasonDuplicateMessage, report.GroupReason.Code)
+	require.Empty(t, report.Payments)
+}
+
+func TestImportInvalidControlSum(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	doc := testDocument(t)
+	doc.Initiation.GroupHeader.ControlSum = "1.00"
+
+	report, err := NewImporter(mocks.NewMockStore(ctrl), testConfig).Import(context.Background(), 1, doc)
+	require.NoError(t, err)
+	require.Equal(t, StatusRejected, report.GroupStatus)
+	require.Equal(t, ReasonInvalidControlSum, report.GroupReason.Code)
+}
+
+func TestTransferDetails(t *testing.T) {
+	testCases := []struct {
+		name                string
+		cdtTrf              CreditTransfer
+		expectedReference   string
+		expectedDescription string
+		expectedReason      bool
+	}{
+		{
+			name:                "end to end id and remittance information",
+			cdtTrf:              CreditTransfer{EndToEndID: "INV-1001", Remittance: &Remittance{Unstructured: "Invoice 1001"}},
+			expectedReference:   "INV-1001",
+			expectedDescription: "Invoice 1001",
+		},
+		{
+			name:   "not provided",
+			cdtTrf: CreditTransfer{EndToEndID: notProvided},
+		},
+		{
+			name:           "end to end id outside of the SWIFT characters",
+			cdtTrf:         CreditTransfer{EndToEndID: "INV_1001"},
+			expectedReason: true,
+		},
+		{
+			name:           "remittance information on many lines",
+			cdtTrf:         CreditTransfer{EndToEndID: "E2E-1", Remittance: &Remittance{Unstructured: "Invoice\n1001"}},
+			expectedReason: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reference, description, reason := transferDetails(tc.cdtTrf)
+			if tc.expectedReason {
+				require.NotNil(t, reason)
+				require.Equal(t, ReasonNarrative, reason.Code)
+				return
+			}
+
+			require.Nil(t, reason)
+			require.Equal(t, tc.expectedReference, reference)
+			require.Equal(t, tc.expectedDescription, description)
+		})
+	}
+}
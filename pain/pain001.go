@@ -0,0 +1,179 @@
+package pain
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Pain001Namespace is the only version of the customer credit transfer initiation we accept
+const Pain001Namespace = "urn:iso:std:iso:20022:tech:xsd:pain.001.001.09"
+
+// ErrUnsupportedMessage is returned when the file is not a pain.001.001.09 message
+var ErrUnsupportedMessage = errors.New("unsupported message, expected " + Pain001Namespace)
+
+// Document is a pain.001.001.09 customer credit transfer initiation message
+type Document struct {
+	XMLName    xml.Name               `xml:"Document"`
+	Xmlns      string                 `xml:"xmlns,attr,omitempty"`
+	Initiation CustomerCreditTransfer `xml:"CstmrCdtTrfInitn"`
+}
+
+// CustomerCreditTransfer holds the group header and the payment informations of the message
+type CustomerCreditTransfer struct {
+	GroupHeader GroupHeader          `xml:"GrpHdr"`
+	Payments    []PaymentInformation `xml:"PmtInf"`
+}
+
+// GroupHeader identifies the message and carries the totals used to check it is complete
+type GroupHeader struct {
+	MessageID            string `xml:"MsgId"`
+	CreationDateTime     string `xml:"CreDtTm"`
+	NumberOfTransactions string `xml:"NbOfTxs"`
+	ControlSum           string `xml:"CtrlSum,omitempty"`
+	InitiatingPartyName  string `xml:"InitgPty>Nm,omitempty"`
+}
+
+// PaymentInformation is a set of credit transfers debited from the same account
+type PaymentInformation struct {
+	PaymentInformationID   string           `xml:"PmtInfId"`
+	PaymentMethod          string           `xml:"PmtMtd"`
+	BatchBooking           string           `xml:"BtchBookg,omitempty"`
+	NumberOfTransactions   string           `xml:"NbOfTxs,omitempty"`
+	ControlSum             string           `xml:"CtrlSum,omitempty"`
+	ServiceLevel           string           `xml:"PmtTpInf>SvcLvl>Cd,omitempty"`
+	RequestedExecutionDate string           `xml:"ReqdExctnDt>Dt"`
+	DebtorName             string           `xml:"Dbtr>Nm"`
+	DebtorAccount          CashAccount      `xml:"DbtrAcct"`
+	DebtorAgent            Agent            `xml:"DbtrAgt"`
+	ChargeBearer           string           `xml:"ChrgBr,omitempty"`
+	CreditTransfers        []CreditTransfer `xml:"CdtTrfTxInf"`
+}
+
+// CreditTransfer is a single payment to a creditor
+type CreditTransfer struct {
+	InstructionID   string           `xml:"PmtId>InstrId,omitempty"`
+	EndToEndID      string           `xml:"PmtId>EndToEndId"`
+	Amount          InstructedAmount `xml:"Amt>InstdAmt"`
+	CreditorAgent   *Agent           `xml:"CdtrAgt,omitempty"`
+	CreditorName    string           `xml:"Cdtr>Nm"`
+	CreditorAccount CashAccount      `xml:"CdtrAcct"`
+	Remittance      *Remittance      `xml:"RmtInf,omitempty"`
+}
+
+// Remittance is the unstructured information sent to the creditor with the payment
+type Remittance struct {
+	Unstructured string `xml:"Ustrd"`
+}
+
+// InstructedAmount is a decimal amount with its currency
+type InstructedAmount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+// CashAccount identifies an account either by IBAN or by another identifier, our account id
+type CashAccount struct {
+	IBAN     string `xml:"Id>IBAN,omitempty"`
+	Other    string `xml:"Id>Othr>Id,omitempty"`
+	Currency string `xml:"Ccy,omitempty"`
+}
+
+// cashAccountXML is how a cash account is written, only the identification in use is rendered
+type cashAccountXML struct {
+	ID struct {
+		IBAN  string `xml:"IBAN,omitempty"`
+		Other *struct {
+			ID string `xml:"Id"`
+		} `xml:"Othr,omitempty"`
+	} `xml:"Id"`
+	Currency string `xml:"Ccy,omitempty"`
+}
+
+// MarshalXML writes the account without the empty identification, which the schema rejects
+func (account CashAccount) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	var out cashAccountXML
+	out.ID.IBAN = account.IBAN
+	if account.Other != "" {
+		out.ID.Other = &struct {
+			ID string `xml:"Id"`
+		}{ID: account.Other}
+	}
+	out.Currency = account.Currency
+	return e.EncodeElement(out, start)
+}
+
+// Agent identifies a financial institution by BIC
+type Agent struct {
+	BIC string `xml:"FinInstnId>BICFI,omitempty"`
+}
+
+// Parse reads a pain.001.001.09 message
+func Parse(r io.Reader) (*Document, error) {
+	var doc Document
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("cannot parse pain.001 message: %w", err)
+	}
+
+	if doc.XMLName.Space != Pain001Namespace {
+		return nil, ErrUnsupportedMessage
+	}
+	return &doc, nil
+}
+
+// Write renders the message as a pain.001.001.09 XML document
+func Write(w io.Writer, doc *Document) error {
+	out := *doc
+	out.XMLName = xml.Name{Local: "Document"}
+	out.Xmlns = Pain001Namespace
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(out); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// TransactionCount returns the number of credit transfers in the message
+func (doc *Document) TransactionCount() int {
+	count := 0
+	for _, payment := range doc.Initiation.Payments {
+		count += len(payment.CreditTransfers)
+	}
+	return count
+}
+
+var amountPattern = regexp.MustCompile(`^[0-9]{1,16}(\.[0-9]{1,2})?$`)
+
+// ParseAmount converts a decimal amount with at most two decimals to minor units
+func ParseAmount(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if !amountPattern.MatchString(value) {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+
+	units, cents, _ := strings.Cut(value, ".")
+	for len(cents) < 2 {
+		cents += "0"
+	}
+
+	amount, err := strconv.ParseInt(units+cents, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q: %w", value, err)
+	}
+	return amount, nil
+}
+
+// FormatAmount converts minor units to a decimal amount with two decimals
+func FormatAmount(amount int64) string {
+	return fmt.Sprintf("%d.%02d", amount/100, amount%100)
+}
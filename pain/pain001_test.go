@@ -0,0 +1,76 @@
+package pain
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	file, err := os.Open("testdata/pain001.xml")
+	require.NoError(t, err)
+	defer file.Close()
+
+	doc, err := Parse(file)
+	require.NoError(t, err)
+
+	header := doc.Initiation.GroupHeader
+	require.Equal(t, "BATCH-2024-0042", header.MessageID)
+	require.Equal(t, "4", header.NumberOfTransactions)
+	require.Equal(t, "ACME Corp", header.InitiatingPartyName)
+	require.Equal(t, 4, doc.TransactionCount())
+
+	require.Len(t, doc.Initiation.Payments, 2)
+	payment := doc.Initiation.Payments[0]
+	require.Equal(t, "PMT-1", payment.PaymentInformationID)
+	require.Equal(t, "1", payment.DebtorAccount.Other)
+	require.Equal(t, "USD", payment.DebtorAccount.Currency)
+	require.Equal(t, "SMPLUS33XXX", payment.DebtorAgent.BIC)
+
+	cdtTrf := payment.CreditTransfers[0]
+	require.Equal(t, "INSTR-1", cdtTrf.InstructionID)
+	require.Equal(t, "E2E-1", cdtTrf.EndToEndID)
+	require.Equal(t, "USD", cdtTrf.Amount.Currency)
+	require.Equal(t, "100.00", cdtTrf.Amount.Value)
+	require.Equal(t, "2", cdtTrf.CreditorAccount.Other)
+	require.Equal(t, &Remittance{Unstructured: "Invoice 1001"}, cdtTrf.Remittance)
+}
+
+func TestParseUnsupportedMessage(t *testing.T) {
+	xml := `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pain.001.001.03"><CstmrCdtTrfInitn/></Document>`
+	_, err := Parse(strings.NewReader(xml))
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+
+	_, err = Parse(strings.NewReader("not xml"))
+	require.Error(t, err)
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		{value: "100", want: 10000},
+		{value: "100.5", want: 10050},
+		{value: "0.01", want: 1},
+		{value: " 12.34 ", want: 1234},
+		{value: "1.234", wantErr: true},
+		{value: "-1.00", wantErr: true},
+		{value: "1,00", wantErr: true},
+		{value: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseAmount(tt.value)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package pain
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+)
+
+const pain002Namespace = "urn:iso:std:iso:20022:tech:xsd:pain.002.001.10"
+
+// Constants for the group, payment and transaction statuses of a pain.002 report
+const (
+	StatusAccepted          = "ACCP"
+	StatusPartiallyAccepted = "PART"
+	StatusPending           = "PDNG"
+	StatusRejected          = "RJCT"
+)
+
+// Constants for the ISO 20022 external status reason codes we report
+const (
+	ReasonIncorrectAccountNumber = "AC01"
+	ReasonTransactionForbidden   = "AG01"
+	ReasonInsufficientFunds      = "AM04"
+	ReasonInvalidControlSum      = "AM10"
+	ReasonInvalidCurrency        = "AM11"
+	ReasonInvalidAmount          = "AM12"
+	ReasonInvalidNumberOfTxs     = "AM18"
+	ReasonDuplicateMessage       = "DU01"
+	ReasonNarrative              = "NARR"
+)
+
+// StatusReport is the outcome of importing a pain.001 message
+type StatusReport struct {
+	MessageID          string
+	CreatedAt          time.Time
+	OriginalMessageID  string
+	OriginalNbOfTxs    string
+	OriginalControlSum string
+	GroupStatus        string
+	GroupReason        *StatusReason
+	Payments           []PaymentStatus
+}
+
+// PaymentStatus is the outcome of a payment information block
+type PaymentStatus struct {
+	PaymentInformationID string
+	Status               string
+	Transactions         []TransactionStatus
+}
+
+// TransactionStatus is the outcome of a single credit transfer, TransferID is set when it was accepted
+// and ApprovalID when it is pending the approval of another member of the organisation
+type TransactionStatus struct {
+	InstructionID string
+	EndToEndID    string
+	Status        string
+	Reason        *StatusReason
+	TransferID    int64
+	ApprovalID    int64
+}
+
+// StatusReason explains why something was rejected
+type StatusReason struct {
+	Code           string
+	AdditionalInfo string
+}
+
+type pain002Document struct {
+	XMLName xml.Name         `xml:"Document"`
+	Xmlns   string           `xml:"xmlns,attr"`
+	Report  pain002StsReport `xml:"CstmrPmtStsRpt"`
+}
+
+type pain002StsReport struct {
+	GroupHeader   pain002GroupHeader   `xml:"GrpHdr"`
+	OriginalGroup pain002OriginalGroup `xml:"OrgnlGrpInfAndSts"`
+	Payments      []pain002Payment     `xml:"OrgnlPmtInfAndSts"`
+}
+
+type pain002GroupHeader struct {
+	MessageID        string `xml:"MsgId"`
+	CreationDateTime string `xml:"CreDtTm"`
+}
+
+type pain002OriginalGroup struct {
+	OriginalMessageID     string         `xml:"OrgnlMsgId"`
+	OriginalMessageNameID string         `xml:"OrgnlMsgNmId"`
+	OriginalNbOfTxs       string         `xml:"OrgnlNbOfTxs,omitempty"`
+	OriginalControlSum    string         `xml:"OrgnlCtrlSum,omitempty"`
+	GroupStatus           string         `xml:"GrpSts"`
+	Reason                *pain002Reason `xml:"StsRsnInf,omitempty"`
+}
+
+type pain002Payment struct {
+	OriginalPaymentInformationID string               `xml:"OrgnlPmtInfId"`
+	Status                       string               `xml:"PmtInfSts"`
+	Transactions                 []pain002Transaction `xml:"TxInfAndSts"`
+}
+
+type pain002Transaction struct {
+	OriginalInstructionID string         `xml:"OrgnlInstrId,omitempty"`
+	OriginalEndToEndID    string         `xml:"OrgnlEndToEndId"`
+	Status                string         `xml:"TxSts"`
+	Reason                *pain002Reason `xml:"StsRsnInf,omitempty"`
+	AccountServicerRef    string         `xml:"AcctSvcrRef,omitempty"`
+}
+
+type pain002Reason struct {
+	Code           string `xml:"Rsn>Cd"`
+	AdditionalInfo string `xml:"AddtlInf,omitempty"`
+}
+
+func reasonXML(reason *StatusReason) *pain002Reason {
+	if reason == nil {
+		return nil
+	}
+	return &pain002Reason{Code: reason.Code, AdditionalInfo: reason.AdditionalInfo}
+}
+
+// WritePain002 renders the status report as a pain.002.001.10 customer payment status report
+func WritePain002(w io.Writer, report *StatusReport) error {
+	doc := pain002Document{
+		Xmlns: pain002Namespace,
+		Report: pain002StsReport{
+			GroupHeader: pain002GroupHeader{
+				MessageID:        report.MessageID,
+				CreationDateTime: report.CreatedAt.UTC().Format(time.RFC3339),
+			},
+			OriginalGroup: pain002OriginalGroup{
+				OriginalMessageID:     report.OriginalMessageID,
+				OriginalMessageNameID: "pain.001.001.09",
+				OriginalNbOfTxs:       report.OriginalNbOfTxs,
+				OriginalControlSum:    report.OriginalControlSum,
+				GroupStatus:           report.GroupStatus,
+				Reason:                reasonXML(report.GroupReason),
+			},
+		},
+	}
+
+	for _, payment := range report.Payments {
+		paymentXML := pain002Payment{
+			OriginalPaymentInformationID: payment.PaymentInformationID,
+			Status:                       payment.Status,
+		}
+		for _, tx := range payment.Transactions {
+			txXML := pain002Transaction{
+				OriginalInstructionID: tx.InstructionID,
+				OriginalEndToEndID:    tx.EndToEndID,
+				Status:                tx.Status,
+				Reason:                reasonXML(tx.Reason),
+			}
+			if tx.TransferID != 0 {
+				txXML.AccountServicerRef = "TRF" + strconv.FormatInt(tx.TransferID, 10)
+			}
+			if tx.ApprovalID != 0 {
+				txXML.AccountServicerRef = "APR" + strconv.FormatInt(tx.ApprovalID, 10)
+			}
+			paymentXML.Transactions = append(paymentXML.Transactions, txXML)
+		}
+		doc.Report.Payments = append(doc.Report.Payments, paymentXML)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
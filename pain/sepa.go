@@ -0,0 +1,153 @@
+package pain
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+)
+
+// SEPAExporter writes the pending outbound EUR payments to pain.001 SEPA credit transfer batches
+// in the outbox directory, where they are picked up and sent to the bank
+type SEPAExporter struct {
+	store  db.Store
+	config util.Config
+	now    func() time.Time
+}
+
+// NewSEPAExporter creates a new SEPAExporter
+func NewSEPAExporter(store db.Store, config util.Config) *SEPAExporter {
+	return &SEPAExporter{
+		store:  store,
+		config: config,
+		now:    time.Now,
+	}
+}
+
+// Export writes every pending SEPA payment to a new batch in the outbox and marks them as submitted.
+// The file only appears once the payments are marked, nothing is written when there is no pending payment
+func (exporter *SEPAExporter) Export(ctx context.Context) (db.SubmitSEPAPaymentsTxResult, string, error) {
+	now := exporter.now()
+	messageID := "SEPA-" + now.UTC().Format("20060102150405")
+	fileName := messageID + ".xml"
+	path := filepath.Join(exporter.config.SEPAOutboxDir, fileName)
+	tmpPath := filepath.Join(exporter.config.SEPAOutboxDir, "."+fileName+".tmp")
+
+	if err := os.MkdirAll(exporter.config.SEPAOutboxDir, 0o750); err != nil {
+		return db.SubmitSEPAPaymentsTxResult{}, "", err
+	}
+
+	result, err := exporter.store.SubmitSEPAPaymentsTx(ctx, db.SubmitSEPAPaymentsTxParams{
+		MessageID: messageID,
+		FileName:  fileName,
+		WriteFile: func(batch db.SepaBatch, entries []db.OutboundPaymentEntry) error {
+			return exporter.writeBatch(tmpPath, now, batch, entries)
+		},
+	})
+	if err != nil {
+		os.Remove(tmpPath)
+		return result, "", err
+	}
+
+	if len(result.Entries) == 0 {
+		return result, "", nil
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return result, "", fmt.Errorf("sepa batch %s was submitted but cannot be moved to %s: %w", tmpPath, path, err)
+	}
+	return result, path, nil
+}
+
+func (exporter *SEPAExporter) writeBatch(path string, now time.Time, batch db.SepaBatch, entries []db.OutboundPaymentEntry) error {
+	payment := PaymentInformation{
+		PaymentInformationID:   batch.MessageID + "-1",
+		PaymentMethod:          "TRF",
+		BatchBooking:           "true",
+		NumberOfTransactions:   strconv.Itoa(len(entries)),
+		ControlSum:             FormatAmount(batch.TotalAmount),
+		ServiceLevel:           "SEPA",
+		RequestedExecutionDate: now.UTC().Format("2006-01-02"),
+		DebtorName:             exporter.config.SEPADebtorName,
+		DebtorAccount:          CashAccount{IBAN: exporter.config.SEPADebtorIBAN},
+		DebtorAgent:            Agent{BIC: exporter.config.SEPADebtorBIC},
+		ChargeBearer:           "SLEV",
+	}
+
+	for _, entry := range entries {
+		payment.CreditTransfers = append(payment.CreditTransfers, CreditTransfer{
+			EndToEndID:      "OBP" + strconv.FormatInt(entry.Payment.ID, 10),
+			Amount:          InstructedAmount{Currency: entry.Payment.Currency, Value: FormatAmount(entry.Payment.Amount)},
+			CreditorAgent:   &Agent{BIC: entry.Beneficiary.Bic},
+			CreditorName:    truncate(entry.Beneficiary.Name, 70),
+			CreditorAccount: CashAccount{IBAN: entry.Beneficiary.Iban},
+		})
+	}
+
+	doc := &Document{
+		Initiation: CustomerCreditTransfer{
+			GroupHeader: GroupHeader{
+				MessageID:            batch.MessageID,
+				CreationDateTime:     now.UTC().Format(time.RFC3339),
+				NumberOfTransactions: strconv.Itoa(len(entries)),
+				ControlSum:           FormatAmount(batch.TotalAmount),
+				InitiatingPartyName:  exporter.config.SEPADebtorName,
+			},
+			Payments: []PaymentInformation{payment},
+		},
+	}
+
+	out, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := Write(out, doc); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// Run exports the pending SEPA payments every batch interval until the context is done
+func (exporter *SEPAExporter) Run(ctx context.Context) {
+	if exporter.config.SEPABatchInterval <= 0 {
+		log.Printf("sepa batch interval is not set, sepa payments won't be exported")
+		return
+	}
+
+	ticker := time.NewTicker(exporter.config.SEPABatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		result, path, err := exporter.Export(ctx)
+		if err != nil {
+			log.Printf("cannot export sepa batch: %v", err)
+			continue
+		}
+
+		if path != "" {
+			log.Printf("exported %d sepa payments to %s", len(result.Entries), path)
+		}
+	}
+}
+
+func truncate(value string, size int) string {
+	runes := []rune(value)
+	if len(runes) > size {
+		return string(runes[:size])
+	}
+	return value
+}
@@ -0,0 +1,102 @@
+package pain
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func testSEPAExporter(t *testing.T, store db.Store) *SEPAExporter {
+	exporter := NewSEPAExporter(store, util.Config{
+		SEPAOutboxDir:  t.TempDir(),
+		SEPADebtorName: "Simplebank",
+		SEPADebtorIBAN: "DE89370400440532013000",
+		SEPADebtorBIC:  "COBADEFFXXX",
+	})
+	exporter.now = func() time.Time {
+		return time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	}
+	return exporter
+}
+
+func TestSEPAExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	batch := db.SepaBatch{ID: 1, MessageID: "SEPA-20240301100000", EntryCount: 2, TotalAmount: 12650}
+	entries := []db.OutboundPaymentEntry{
+		{
+			Payment:     db.OutboundPayment{ID: 7, Amount: 12550, Currency: util.EUR, Rail: util.OutboundRailSEPA},
+			Beneficiary: db.Beneficiary{Name: "Jean Dupont", Currency: util.EUR, Iban: "FR1420041010050500013M02606", Bic: "PSSTFRPPPAR"},
+		},
+		{
+			Payment:     db.OutboundPayment{ID: 8, Amount: 100, Currency: util.EUR, Rail: util.OutboundRailSEPA},
+			Beneficiary: db.Beneficiary{Name: "Jan de Vries", Currency: util.EUR, Iban: "NL91ABNA0417164300", Bic: "ABNANL2A"},
+		},
+	}
+
+	store := mocks.NewMockStore(ctrl)
+	store.EXPECT().
+		SubmitSEPAPaymentsTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, arg db.SubmitSEPAPaymentsTxParams) (db.SubmitSEPAPaymentsTxResult, error) {
+			require.Equal(t, batch.MessageID, arg.MessageID)
+			require.Equal(t, batch.MessageID+".xml", arg.FileName)
+			return db.SubmitSEPAPaymentsTxResult{SepaBatch: batch, Entries: entries}, arg.WriteFile(batch, entries)
+		})
+
+	exporter := testSEPAExporter(t, store)
+	result, path, err := exporter.Export(context.Background())
+	require.NoError(t, err)
+	require.Len(t, result.Entries, 2)
+	require.Equal(t, filepath.Join(exporter.config.SEPAOutboxDir, "SEPA-20240301100000.xml"), path)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	golden := "testdata/sepa.golden"
+	if *update {
+		require.NoError(t, os.WriteFile(golden, content, 0o644))
+	}
+
+	expected, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(content))
+
+	// the batch must be a valid pain.001 we can read back
+	doc, err := Parse(bytes.NewReader(content))
+	require.NoError(t, err)
+	require.Equal(t, 2, doc.TransactionCount())
+	require.Equal(t, "FR1420041010050500013M02606", doc.Initiation.Payments[0].CreditTransfers[0].CreditorAccount.IBAN)
+	require.Equal(t, "125.50", doc.Initiation.Payments[0].CreditTransfers[0].Amount.Value)
+}
+
+func TestSEPAExportRollback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mocks.NewMockStore(ctrl)
+	store.EXPECT().
+		SubmitSEPAPaymentsTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, arg db.SubmitSEPAPaymentsTxParams) (db.SubmitSEPAPaymentsTxResult, error) {
+			require.NoError(t, arg.WriteFile(db.SepaBatch{MessageID: arg.MessageID}, nil))
+			return db.SubmitSEPAPaymentsTxResult{}, sql.ErrTxDone
+		})
+
+	exporter := testSEPAExporter(t, store)
+	_, _, err := exporter.Export(context.Background())
+	require.ErrorIs(t, err, sql.ErrTxDone)
+
+	files, err := os.ReadDir(exporter.config.SEPAOutboxDir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
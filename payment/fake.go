@@ -0,0 +1,83 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Sinothic/simplebank/util"
+)
+
+const FakeRailName = "fake"
+
+// FakeRail is an in-memory payment rail that settles every request instantly,
+// unless told otherwise with SetNextResult or SetError
+type FakeRail struct {
+	mu       sync.Mutex
+	sequence int
+	next     *Result
+	err      error
+	requests []Request
+}
+
+// NewFakeRail creates a new FakeRail
+func NewFakeRail() *FakeRail {
+	return &FakeRail{}
+}
+
+func (rail *FakeRail) Name() string {
+	return FakeRailName
+}
+
+func (rail *FakeRail) Collect(ctx context.Context, req Request) (Result, error) {
+	return rail.handle(req)
+}
+
+func (rail *FakeRail) Payout(ctx context.Context, req Request) (Result, error) {
+	return rail.handle(req)
+}
+
+// SetNextResult makes the next request answer with result instead of a completed payment
+func (rail *FakeRail) SetNextResult(result Result) {
+	rail.mu.Lock()
+	defer rail.mu.Unlock()
+	rail.next = &result
+}
+
+// SetError makes every following request fail with err, nil restores the default behaviour
+func (rail *FakeRail) SetError(err error) {
+	rail.mu.Lock()
+	defer rail.mu.Unlock()
+	rail.err = err
+}
+
+// Requests returns the requests received so far
+func (rail *FakeRail) Requests() []Request {
+	rail.mu.Lock()
+	defer rail.mu.Unlock()
+	return append([]Request(nil), rail.requests...)
+}
+
+func (rail *FakeRail) handle(req Request) (Result, error) {
+	rail.mu.Lock()
+	defer rail.mu.Unlock()
+
+	rail.requests = append(rail.requests, req)
+	if rail.err != nil {
+		return Result{}, rail.err
+	}
+
+	rail.sequence++
+	result := Result{
+		Reference: fmt.Sprintf("fake-%d", rail.sequence),
+		Status:    util.PaymentStatusCompleted,
+	}
+	if rail.next != nil {
+		if rail.next.Reference == "" {
+			rail.next.Reference = result.Reference
+		}
+		result = *rail.next
+		rail.next = nil
+	}
+	return result, nil
+}
@@ -0,0 +1,52 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeRail(t *testing.T) {
+	rail := NewFakeRail()
+	req := Request{TransferID: 1, AccountID: 2, Amount: 100, Currency: util.USD}
+
+	result, err := rail.Collect(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, util.PaymentStatusCompleted, result.Status)
+	require.Equal(t, "fake-1", result.Reference)
+
+	rail.SetNextResult(Result{Status: util.PaymentStatusFailed, FailureReason: "account closed"})
+	result, err = rail.Payout(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, util.PaymentStatusFailed, result.Status)
+	require.Equal(t, "account closed", result.FailureReason)
+	require.Equal(t, "fake-2", result.Reference)
+
+	rail.SetError(errors.New("connection reset"))
+	_, err = rail.Payout(context.Background(), req)
+	require.EqualError(t, err, "connection reset")
+
+	require.Len(t, rail.Requests(), 3)
+}
+
+func TestNewPaymentRail(t *testing.T) {
+	rail, err := NewPaymentRail(FakeRailName, util.EnvironmentDevelopment)
+	require.NoError(t, err)
+	require.Equal(t, FakeRailName, rail.Name())
+
+	_, err = NewPaymentRail("swift", util.EnvironmentDevelopment)
+	require.Error(t, err)
+
+	// an unset rail doesn't fall back to the fake one
+	_, err = NewPaymentRail("", util.EnvironmentDevelopment)
+	require.Error(t, err)
+
+	// the fake rail would let anyone deposit money out of nowhere
+	for _, environment := range []string{"", "production", "staging"} {
+		_, err = NewPaymentRail(FakeRailName, environment)
+		require.ErrorContains(t, err, "only available in the development environment")
+	}
+}
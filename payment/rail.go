@@ -0,0 +1,50 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Sinothic/simplebank/util"
+)
+
+// Request is the money movement handed to a payment rail
+type Request struct {
+	TransferID int64
+	AccountID  int64
+	Amount     int64
+	Currency   string
+}
+
+// Result is the answer of a payment rail, Status is one of the util.PaymentStatus constants
+type Result struct {
+	Reference     string
+	Status        string
+	FailureReason string
+}
+
+// PaymentRail moves money between the bank and the outside world
+type PaymentRail interface {
+	// Name identifies the rail on the stored external transfers
+	Name() string
+	// Collect pulls money from the outside world into the bank
+	Collect(ctx context.Context, req Request) (Result, error)
+	// Payout sends money from the bank to the outside world
+	Payout(ctx context.Context, req Request) (Result, error)
+}
+
+// NewPaymentRail creates the payment rail configured by name. The fake rail settles every deposit at once,
+// so it has to be asked for by name, is never the default and is refused outside of the development environment
+func NewPaymentRail(name string, environment string) (PaymentRail, error) {
+	switch name {
+	case "":
+		return nil, errors.New("no payment rail configured, set PAYMENT_RAIL")
+	case FakeRailName:
+		if environment != util.EnvironmentDevelopment {
+			return nil, fmt.Errorf("the %s payment rail is only available in the %s environment, ENVIRONMENT is %q",
+				FakeRailName, util.EnvironmentDevelopment, environment)
+		}
+		return NewFakeRail(), nil
+	}
+	return nil, fmt.Errorf("unsupported payment rail %q", name)
+}
@@ -0,0 +1,30 @@
+package statement
+
+import (
+	"fmt"
+	"strings"
+)
+
+// formatAmount renders an absolute amount in minor units with two decimals,
+// every supported currency has cents
+func formatAmount(amount int64, decimalSeparator string) string {
+	if amount < 0 {
+		amount = -amount
+	}
+	return fmt.Sprintf("%d%s%02d", amount/100, decimalSeparator, amount%100)
+}
+
+func creditDebit(amount int64, credit, debit string) string {
+	if amount < 0 {
+		return debit
+	}
+	return credit
+}
+
+func truncate(s string, max int) string {
+	s = strings.TrimSpace(s)
+	if len(s) > max {
+		return s[:max]
+	}
+	return s
+}
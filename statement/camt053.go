@@ -0,0 +1,188 @@
+package statement
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+)
+
+const camt053Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.08"
+
+type camtDocument struct {
+	XMLName xml.Name        `xml:"Document"`
+	Xmlns   string          `xml:"xmlns,attr"`
+	Stmt    camtBkToCstmrSt `xml:"BkToCstmrStmt"`
+}
+
+type camtBkToCstmrSt struct {
+	GrpHdr camtGroupHeader `xml:"GrpHdr"`
+	Stmt   camtStatement   `xml:"Stmt"`
+}
+
+type camtGroupHeader struct {
+	MsgId   string `xml:"MsgId"`
+	CreDtTm string `xml:"CreDtTm"`
+}
+
+type camtStatement struct {
+	Id        string        `xml:"Id"`
+	CreDtTm   string        `xml:"CreDtTm"`
+	FrToDt    camtPeriod    `xml:"FrToDt"`
+	Acct      camtAccount   `xml:"Acct"`
+	Bal       []camtBalance `xml:"Bal"`
+	TxsSummry camtSummary   `xml:"TxsSummry"`
+	Ntry      []camtEntry   `xml:"Ntry"`
+}
+
+type camtPeriod struct {
+	FrDtTm string `xml:"FrDtTm"`
+	ToDtTm string `xml:"ToDtTm"`
+}
+
+type camtAccount struct {
+	Id  string `xml:"Id>Othr>Id"`
+	Ccy string `xml:"Ccy"`
+}
+
+type camtAmount struct {
+	Ccy   string `xml:"Ccy,attr"`
+	Value string `xml:",chardata"`
+}
+
+type camtBalance struct {
+	Code      string     `xml:"Tp>CdOrPrtry>Cd"`
+	Amt       camtAmount `xml:"Amt"`
+	CdtDbtInd string     `xml:"CdtDbtInd"`
+	Dt        string     `xml:"Dt>Dt"`
+}
+
+type camtSummary struct {
+	NbOfNtries string  `xml:"TtlNtries>NbOfNtries"`
+	TtlNetNtry camtNet `xml:"TtlNtries>TtlNetNtry"`
+}
+
+type camtNet struct {
+	Amt       string `xml:"Amt"`
+	CdtDbtInd string `xml:"CdtDbtInd"`
+}
+
+type camtEntry struct {
+	NtryRef     string         `xml:"NtryRef"`
+	Amt         camtAmount     `xml:"Amt"`
+	CdtDbtInd   string         `xml:"CdtDbtInd"`
+	Sts         string         `xml:"Sts>Cd"`
+	BookgDt     string         `xml:"BookgDt>DtTm"`
+	ValDt       string         `xml:"ValDt>Dt"`
+	AcctSvcrRef string         `xml:"AcctSvcrRef"`
+	BkTxCd      string         `xml:"BkTxCd>Prtry>Cd"`
+	NtryDtls    *camtEntryRefs `xml:"NtryDtls>TxDtls>Refs,omitempty"`
+}
+
+type camtEntryRefs struct {
+	EndToEndId string `xml:"EndToEndId"`
+	TxId       string `xml:"TxId"`
+}
+
+// WriteCamt053 renders the statement as an ISO 20022 camt.053.001.08 bank to customer statement
+func WriteCamt053(w io.Writer, statement db.AccountStatement, createdAt time.Time) error {
+	account := statement.Account
+	id := statementID(statement)
+	currency := account.Currency
+
+	doc := camtDocument{
+		Xmlns: camt053Namespace,
+		Stmt: camtBkToCstmrSt{
+			GrpHdr: camtGroupHeader{
+				MsgId:   id,
+				CreDtTm: createdAt.UTC().Format(time.RFC3339),
+			},
+			Stmt: camtStatement{
+				Id:      id,
+				CreDtTm: createdAt.UTC().Format(time.RFC3339),
+				FrToDt: camtPeriod{
+					FrDtTm: statement.From.UTC().Format(time.RFC3339),
+					ToDtTm: periodEnd(statement).UTC().Format(time.RFC3339),
+				},
+				Acct: camtAccount{
+					Id:  strconv.FormatInt(account.ID, 10),
+					Ccy: currency,
+				},
+				Bal: []camtBalance{
+					camtBalanceOf("OPBD", statement.OpeningBalance, currency, statement.From),
+					camtBalanceOf("CLBD", statement.ClosingBalance, currency, periodEnd(statement)),
+				},
+				TxsSummry: camtSummary{
+					NbOfNtries: strconv.Itoa(len(statement.Entries)),
+					TtlNetNtry: camtNet{
+						Amt:       formatAmount(statement.ClosingBalance-statement.OpeningBalance, "."),
+						CdtDbtInd: creditDebit(statement.ClosingBalance-statement.OpeningBalance, "CRDT", "DBIT"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, entry := range statement.Entries {
+		ntry := camtEntry{
+			NtryRef:     strconv.FormatInt(entry.ID, 10),
+			Amt:         camtAmount{Ccy: currency, Value: formatAmount(entry.Amount, ".")},
+			CdtDbtInd:   creditDebit(entry.Amount, "CRDT", "DBIT"),
+			Sts:         "BOOK",
+			BookgDt:     entry.CreatedAt.UTC().Format(time.RFC3339),
+			ValDt:       entry.CreatedAt.UTC().Format(time.DateOnly),
+			AcctSvcrRef: strconv.FormatInt(entry.ID, 10),
+			BkTxCd:      transactionCode(entry),
+		}
+		if entry.TransferID.Valid {
+			ntry.NtryDtls = &camtEntryRefs{
+				EndToEndId: transferReference(entry),
+				TxId:       strconv.FormatInt(entry.TransferID.Int64, 10),
+			}
+		}
+		doc.Stmt.Stmt.Ntry = append(doc.Stmt.Stmt.Ntry, ntry)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func camtBalanceOf(code string, balance int64, currency string, at time.Time) camtBalance {
+	return camtBalance{
+		Code:      code,
+		Amt:       camtAmount{Ccy: currency, Value: formatAmount(balance, ".")},
+		CdtDbtInd: creditDebit(balance, "CRDT", "DBIT"),
+		Dt:        at.UTC().Format(time.DateOnly),
+	}
+}
+
+func statementID(statement db.AccountStatement) string {
+	return fmt.Sprintf("STMT%d-%s", statement.Account.ID, statement.From.UTC().Format("20060102"))
+}
+
+// periodEnd is the last second of the statement period, the end of the statement itself is excluded
+func periodEnd(statement db.AccountStatement) time.Time {
+	return statement.To.Add(-time.Second)
+}
+
+func transferReference(entry db.Entry) string {
+	return fmt.Sprintf("TRF%d", entry.TransferID.Int64)
+}
+
+func transactionCode(entry db.Entry) string {
+	if entry.TransferID.Valid {
+		return "TRANSFER"
+	}
+	return "OTHER"
+}
@@ -0,0 +1,71 @@
+package statement
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+)
+
+const mt940LineBreak = "\r\n"
+
+// WriteMT940 renders the statement as the text block of a SWIFT MT940 customer statement message
+func WriteMT940(w io.Writer, statement db.AccountStatement) error {
+	account := statement.Account
+	currency := account.Currency
+
+	var lines []string
+	lines = append(lines,
+		":20:"+truncate(statementID(statement), 16),
+		":25:"+strconv.FormatInt(account.ID, 10),
+		":28C:1/1",
+		":60F:"+mt940Balance(statement.OpeningBalance, currency, statement.From),
+	)
+
+	for _, entry := range statement.Entries {
+		customerReference := "NONREF"
+		if entry.TransferID.Valid {
+			customerReference = transferReference(entry)
+		}
+
+		lines = append(lines, fmt.Sprintf(":61:%s%s%s%sN%s%s//%d",
+			entry.CreatedAt.UTC().Format("060102"),
+			entry.CreatedAt.UTC().Format("0102"),
+			creditDebit(entry.Amount, "C", "D"),
+			formatAmount(entry.Amount, ","),
+			mt940TransactionType(entry),
+			truncate(customerReference, 16),
+			entry.ID,
+		))
+		lines = append(lines, ":86:"+truncate(mt940Narrative(entry), 65))
+	}
+
+	lines = append(lines,
+		":62F:"+mt940Balance(statement.ClosingBalance, currency, periodEnd(statement)),
+		"-",
+	)
+
+	_, err := io.WriteString(w, strings.Join(lines, mt940LineBreak)+mt940LineBreak)
+	return err
+}
+
+func mt940Balance(balance int64, currency string, at time.Time) string {
+	return creditDebit(balance, "C", "D") + at.UTC().Format("060102") + currency + formatAmount(balance, ",")
+}
+
+func mt940TransactionType(entry db.Entry) string {
+	if entry.TransferID.Valid {
+		return "TRF"
+	}
+	return "MSC"
+}
+
+func mt940Narrative(entry db.Entry) string {
+	if entry.TransferID.Valid {
+		return fmt.Sprintf("TRANSFER %d", entry.TransferID.Int64)
+	}
+	return fmt.Sprintf("ENTRY %d", entry.ID)
+}
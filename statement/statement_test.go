@@ -0,0 +1,93 @@
+package statement
+
+import (
+	"bytes"
+	"database/sql"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func testStatement() db.AccountStatement {
+	from := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	return db.AccountStatement{
+		Account: db.Account{
+			ID:       42,
+			UserID:   7,
+			Balance:  12050,
+			Currency: "USD",
+			Kind:     "customer",
+		},
+		From:           from,
+		To:             from.AddDate(0, 1, 0),
+		OpeningBalance: 10000,
+		ClosingBalance: 12050,
+		Entries: []db.Entry{
+			{
+				ID:         101,
+				AccountID:  42,
+				Amount:     2500,
+				TransferID: sql.NullInt64{Int64: 55, Valid: true},
+				CreatedAt:  time.Date(2024, time.March, 4, 9, 30, 0, 0, time.UTC),
+			},
+			{
+				ID:         102,
+				AccountID:  42,
+				Amount:     -750,
+				TransferID: sql.NullInt64{Int64: 56, Valid: true},
+				CreatedAt:  time.Date(2024, time.March, 18, 16, 5, 0, 0, time.UTC),
+			},
+			{
+				ID:        103,
+				AccountID: 42,
+				Amount:    300,
+				CreatedAt: time.Date(2024, time.March, 31, 23, 59, 0, 0, time.UTC),
+			},
+		},
+	}
+}
+
+func assertGolden(t *testing.T, name string, got []byte) {
+	path := filepath.Join("testdata", name)
+	if *update {
+		require.NoError(t, os.WriteFile(path, got, 0o644))
+	}
+
+	want, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(want), string(got))
+}
+
+func TestWriteCamt053(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteCamt053(&buf, testStatement(), time.Date(2024, time.April, 1, 6, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assertGolden(t, "camt053.golden", buf.Bytes())
+}
+
+func TestWriteMT940(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteMT940(&buf, testStatement())
+	require.NoError(t, err)
+	assertGolden(t, "mt940.golden", buf.Bytes())
+}
+
+func TestWriteMT940Overdrawn(t *testing.T) {
+	statement := testStatement()
+	statement.OpeningBalance = -300
+	statement.ClosingBalance = -50
+	statement.Entries = statement.Entries[:1]
+
+	var buf bytes.Buffer
+	err := WriteMT940(&buf, statement)
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), ":60F:D240301USD3,00\r\n")
+	require.Contains(t, buf.String(), ":62F:D240331USD0,50\r\n")
+}
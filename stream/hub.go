@@ -0,0 +1,77 @@
+package stream
+
+import "sync"
+
+// Hub wakes the streams of an account when entries are written to it. A stream that is already
+// awake when another notification comes is not woken twice, it reads every new entry anyway
+type Hub struct {
+	mu          sync.Mutex
+	subscribers map[int64]map[chan struct{}]struct{}
+}
+
+// NewHub creates a new Hub
+func NewHub() *Hub {
+	return &Hub{subscribers: make(map[int64]map[chan struct{}]struct{})}
+}
+
+// Subscribe returns a channel receiving a value when entries are written to the account
+// and a function ending the subscription
+func (hub *Hub) Subscribe(accountID int64) (<-chan struct{}, func()) {
+	notifications := make(chan struct{}, 1)
+
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	if hub.subscribers[accountID] == nil {
+		hub.subscribers[accountID] = make(map[chan struct{}]struct{})
+	}
+	hub.subscribers[accountID][notifications] = struct{}{}
+
+	unsubscribe := func() {
+		hub.mu.Lock()
+		defer hub.mu.Unlock()
+
+		delete(hub.subscribers[accountID], notifications)
+		if len(hub.subscribers[accountID]) == 0 {
+			delete(hub.subscribers, accountID)
+		}
+	}
+	return notifications, unsubscribe
+}
+
+// Notify wakes the streams of an account
+func (hub *Hub) Notify(accountID int64) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	for notifications := range hub.subscribers[accountID] {
+		wake(notifications)
+	}
+}
+
+// NotifyAll wakes every stream, after notifications may have been lost
+func (hub *Hub) NotifyAll() {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	for _, subscribers := range hub.subscribers {
+		for notifications := range subscribers {
+			wake(notifications)
+		}
+	}
+}
+
+// Subscribers is the number of streams of an account
+func (hub *Hub) Subscribers(accountID int64) int {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	return len(hub.subscribers[accountID])
+}
+
+func wake(notifications chan struct{}) {
+	select {
+	case notifications <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,43 @@
+package stream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func notified(notifications <-chan struct{}) bool {
+	select {
+	case <-notifications:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestHub(t *testing.T) {
+	hub := NewHub()
+	first, unsubscribeFirst := hub.Subscribe(1)
+	second, unsubscribeSecond := hub.Subscribe(1)
+	other, unsubscribeOther := hub.Subscribe(2)
+	defer unsubscribeOther()
+	require.Equal(t, 2, hub.Subscribers(1))
+
+	hub.Notify(1)
+	hub.Notify(1)
+	require.True(t, notified(first))
+	require.False(t, notified(first))
+	require.True(t, notified(second))
+	require.False(t, notified(other))
+
+	unsubscribeFirst()
+	hub.Notify(1)
+	require.False(t, notified(first))
+	require.True(t, notified(second))
+
+	unsubscribeSecond()
+	require.Zero(t, hub.Subscribers(1))
+
+	hub.NotifyAll()
+	require.True(t, notified(other))
+}
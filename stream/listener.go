@@ -0,0 +1,70 @@
+package stream
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Sinothic/simplebank/util"
+	"github.com/lib/pq"
+)
+
+const (
+	// entriesChannel is notified with the account id by the notify_entries trigger
+	entriesChannel = "entries"
+	// pingInterval is how often the connection listening for notifications is checked
+	pingInterval = 90 * time.Second
+)
+
+// Listener feeds a hub with the notifications Postgres sends when entries are committed
+type Listener struct {
+	hub      *Hub
+	dbSource string
+}
+
+// NewListener creates a new Listener
+func NewListener(hub *Hub, config util.Config) *Listener {
+	return &Listener{hub: hub, dbSource: config.DBSource}
+}
+
+// Run listens for the notifications until the context is done. The connection is opened again
+// when it is lost and every stream is woken then, since the notifications sent meanwhile are lost
+func (listener *Listener) Run(ctx context.Context) {
+	pgListener := pq.NewListener(listener.dbSource, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("entry notifications: %v", err)
+		}
+	})
+	defer pgListener.Close()
+
+	if err := pgListener.Listen(entriesChannel); err != nil {
+		log.Printf("cannot listen for entry notifications, balance streams only poll: %v", err)
+		return
+	}
+
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// a failed ping makes the listener reconnect
+			_ = pgListener.Ping()
+		case notification := <-pgListener.Notify:
+			if notification == nil {
+				listener.hub.NotifyAll()
+				continue
+			}
+
+			accountID, err := strconv.ParseInt(notification.Extra, 10, 64)
+			if err != nil {
+				log.Printf("invalid entry notification %q", notification.Extra)
+				continue
+			}
+			listener.hub.Notify(accountID)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package util
+
+// Constants for account kinds, customer accounts and their pots are the only ones owned by real users
+const (
+	AccountKindCustomer = "customer"
+	// AccountKindPot is a savings pot under a customer account, held by the holders of its parent
+	AccountKindPot      = "pot"
+	AccountKindSuspense = "suspense"
+	AccountKindClearing = "clearing"
+	// AccountKindACHClearing holds outbound ACH payments until they settle or are returned
+	AccountKindACHClearing = "ach_clearing"
+	// AccountKindSEPAClearing holds outbound SEPA credit transfers until they leave the bank
+	AccountKindSEPAClearing = "sepa_clearing"
+)
@@ -0,0 +1,39 @@
+package util
+
+// Constants for the role of a holder of a customer account
+const (
+	// AccountRoleOwner is the user who opened the account, accounts.user_id
+	AccountRoleOwner = "owner"
+	// AccountRoleCoOwner can move money but can't manage the holders
+	AccountRoleCoOwner = "co_owner"
+	// AccountRoleViewer can only read the account
+	AccountRoleViewer = "viewer"
+)
+
+// Constants for what a holder can do with an account
+const (
+	AccountPermissionView     = "view"
+	AccountPermissionTransact = "transact"
+	AccountPermissionManage   = "manage"
+)
+
+// Constants for the status of an invitation to become a holder of an account
+const (
+	InvitationStatusPending  = "pending"
+	InvitationStatusAccepted = "accepted"
+	InvitationStatusDeclined = "declined"
+	InvitationStatusRevoked  = "revoked"
+)
+
+// HolderCan tells if a holder with the role has the permission on the account
+func HolderCan(role string, permission string) bool {
+	switch permission {
+	case AccountPermissionView:
+		return role == AccountRoleOwner || role == AccountRoleCoOwner || role == AccountRoleViewer
+	case AccountPermissionTransact:
+		return role == AccountRoleOwner || role == AccountRoleCoOwner
+	case AccountPermissionManage:
+		return role == AccountRoleOwner
+	}
+	return false
+}
@@ -0,0 +1,30 @@
+package util
+
+import "testing"
+
+func TestHolderCan(t *testing.T) {
+	tests := []struct {
+		role       string
+		permission string
+		want       bool
+	}{
+		{role: AccountRoleOwner, permission: AccountPermissionView, want: true},
+		{role: AccountRoleOwner, permission: AccountPermissionTransact, want: true},
+		{role: AccountRoleOwner, permission: AccountPermissionManage, want: true},
+		{role: AccountRoleCoOwner, permission: AccountPermissionView, want: true},
+		{role: AccountRoleCoOwner, permission: AccountPermissionTransact, want: true},
+		{role: AccountRoleCoOwner, permission: AccountPermissionManage, want: false},
+		{role: AccountRoleViewer, permission: AccountPermissionView, want: true},
+		{role: AccountRoleViewer, permission: AccountPermissionTransact, want: false},
+		{role: AccountRoleViewer, permission: AccountPermissionManage, want: false},
+		{role: "admin", permission: AccountPermissionView, want: false},
+		{role: AccountRoleOwner, permission: "delete", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.role+" "+tt.permission, func(t *testing.T) {
+			if got := HolderCan(tt.role, tt.permission); got != tt.want {
+				t.Errorf("HolderCan(%q, %q) = %v, want %v", tt.role, tt.permission, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package util
+
+// Constants for the status of an outbound ACH payment
+const (
+	OutboundPaymentStatusPending   = "pending"
+	OutboundPaymentStatusSubmitted = "submitted"
+	OutboundPaymentStatusReturned  = "returned"
+)
+
+// Constants for the rails outbound payments are sent through
+const (
+	OutboundRailACH  = "ach"
+	OutboundRailSEPA = "sepa"
+)
+
+// OutboundRail returns the rail paying beneficiaries in the currency and the kind of the clearing
+// account holding the money until it leaves the bank, ok is false when the currency can't be paid out
+func OutboundRail(currency string) (rail string, clearingKind string, ok bool) {
+	switch currency {
+	case USD:
+		return OutboundRailACH, AccountKindACHClearing, true
+	case EUR:
+		return OutboundRailSEPA, AccountKindSEPAClearing, true
+	}
+	return "", "", false
+}
+
+// Constants for the type of an external bank account
+const (
+	BankAccountTypeChecking = "checking"
+	BankAccountTypeSavings  = "savings"
+)
+
+// IsSupportedBankAccountType checks if the type of an external bank account is supported
+func IsSupportedBankAccountType(accountType string) bool {
+	switch accountType {
+	case BankAccountTypeChecking, BankAccountTypeSavings:
+		return true
+	}
+	return false
+}
+
+// IsValidRoutingNumber checks the format and the check digit of an ABA routing transit number,
+// 3 * (d1 + d4 + d7) + 7 * (d2 + d5 + d8) + (d3 + d6 + d9) must be a multiple of 10
+func IsValidRoutingNumber(routingNumber string) bool {
+	if len(routingNumber) != 9 {
+		return false
+	}
+
+	weights := [3]int{3, 7, 1}
+	sum := 0
+	for i, c := range routingNumber {
+		if c < '0' || c > '9' {
+			return false
+		}
+		sum += weights[i%3] * int(c-'0')
+	}
+	return sum != 0 && sum%10 == 0
+}
@@ -0,0 +1,26 @@
+package util
+
+import "testing"
+
+func TestIsValidRoutingNumber(t *testing.T) {
+	tests := []struct {
+		name          string
+		routingNumber string
+		want          bool
+	}{
+		{name: "valid", routingNumber: "021000021", want: true},
+		{name: "valid with leading zeros", routingNumber: "011000015", want: true},
+		{name: "wrong check digit", routingNumber: "021000022", want: false},
+		{name: "too short", routingNumber: "02100002", want: false},
+		{name: "too long", routingNumber: "0210000210", want: false},
+		{name: "not numeric", routingNumber: "02100002a", want: false},
+		{name: "all zeros", routingNumber: "000000000", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidRoutingNumber(tt.routingNumber); got != tt.want {
+				t.Errorf("IsValidRoutingNumber(%q) = %v, want %v", tt.routingNumber, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package util
+
+// Constants for supported balance adjustment reason codes
+const (
+	ReasonCorrection = "correction"
+	ReasonFeeRefund  = "fee_refund"
+	ReasonGoodwill   = "goodwill"
+	ReasonChargeback = "chargeback"
+	ReasonWriteOff   = "write_off"
+	ReasonMigration  = "migration"
+)
+
+// IsSupportedAdjustmentReason checks if the reason code can be used for a balance adjustment
+func IsSupportedAdjustmentReason(reason string) bool {
+	switch reason {
+	case ReasonCorrection, ReasonFeeRefund, ReasonGoodwill, ReasonChargeback, ReasonWriteOff, ReasonMigration:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,34 @@
+package util
+
+import "testing"
+
+func TestIsSupportedAdjustmentReason(t *testing.T) {
+	tests := []struct {
+		name   string
+		reason string
+		want   bool
+	}{
+		{
+			name:   "supported reason",
+			reason: ReasonCorrection,
+			want:   true,
+		},
+		{
+			name:   "unsupported reason",
+			reason: "because",
+			want:   false,
+		},
+		{
+			name:   "empty reason",
+			reason: "",
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsSupportedAdjustmentReason(tt.reason); got != tt.want {
+				t.Errorf("IsSupportedAdjustmentReason() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,11 +1,60 @@
 package util
 
-import "github.com/spf13/viper"
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// EnvironmentDevelopment is the environment of a local setup, where the fake integrations are allowed
+const EnvironmentDevelopment = "development"
 
 type Config struct {
-	DBDriver      string `mapstructure:"DB_DRIVER"`
-	DBSource      string `mapstructure:"DB_SOURCE"`
-	ServerAddress string `mapstructure:"SERVER_ADDRESS"`
+	Environment                 string        `mapstructure:"ENVIRONMENT"`
+	DBDriver                    string        `mapstructure:"DB_DRIVER"`
+	DBSource                    string        `mapstructure:"DB_SOURCE"`
+	MigrateOnStart              bool          `mapstructure:"MIGRATE_ON_START"`
+	ServerAddress               string        `mapstructure:"SERVER_ADDRESS"`
+	HTTPReadTimeout             time.Duration `mapstructure:"HTTP_READ_TIMEOUT"`
+	HTTPWriteTimeout            time.Duration `mapstructure:"HTTP_WRITE_TIMEOUT"`
+	HTTPIdleTimeout             time.Duration `mapstructure:"HTTP_IDLE_TIMEOUT"`
+	HTTPMaxHeaderBytes          int           `mapstructure:"HTTP_MAX_HEADER_BYTES"`
+	ShutdownTimeout             time.Duration `mapstructure:"SHUTDOWN_TIMEOUT"`
+	ShutdownDrainDelay          time.Duration `mapstructure:"SHUTDOWN_DRAIN_DELAY"`
+	GRPCServerAddress           string        `mapstructure:"GRPC_SERVER_ADDRESS"`
+	GatewayServerAddress        string        `mapstructure:"GATEWAY_SERVER_ADDRESS"`
+	PaymentRail                 string        `mapstructure:"PAYMENT_RAIL"`
+	TokenSymmetricKey           string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	AccessTokenDuration         time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	ACHOutputDir                string        `mapstructure:"ACH_OUTPUT_DIR"`
+	ACHOriginRoutingNumber      string        `mapstructure:"ACH_ORIGIN_ROUTING_NUMBER"`
+	ACHDestinationRoutingNumber string        `mapstructure:"ACH_DESTINATION_ROUTING_NUMBER"`
+	ACHDestinationName          string        `mapstructure:"ACH_DESTINATION_NAME"`
+	ACHCompanyName              string        `mapstructure:"ACH_COMPANY_NAME"`
+	ACHCompanyID                string        `mapstructure:"ACH_COMPANY_ID"`
+	ACHCutOffTime               time.Duration `mapstructure:"ACH_CUT_OFF_TIME"`
+	SEPAOutboxDir               string        `mapstructure:"SEPA_OUTBOX_DIR"`
+	SEPADebtorName              string        `mapstructure:"SEPA_DEBTOR_NAME"`
+	SEPADebtorIBAN              string        `mapstructure:"SEPA_DEBTOR_IBAN"`
+	SEPADebtorBIC               string        `mapstructure:"SEPA_DEBTOR_BIC"`
+	SEPABatchInterval           time.Duration `mapstructure:"SEPA_BATCH_INTERVAL"`
+	PayeeCoolingOffPeriod       time.Duration `mapstructure:"PAYEE_COOLING_OFF_PERIOD"`
+	PayeeCoolingOffLimit        int64         `mapstructure:"PAYEE_COOLING_OFF_LIMIT"`
+	PaymentRequestTTL           time.Duration `mapstructure:"PAYMENT_REQUEST_TTL"`
+	EventPublisher              string        `mapstructure:"EVENT_PUBLISHER"`
+	EventFilePath               string        `mapstructure:"EVENT_FILE_PATH"`
+	EventNATSURL                string        `mapstructure:"EVENT_NATS_URL"`
+	EventSubjectPrefix          string        `mapstructure:"EVENT_SUBJECT_PREFIX"`
+	EventRelayInterval          time.Duration `mapstructure:"EVENT_RELAY_INTERVAL"`
+	EventRelayBatchSize         int32         `mapstructure:"EVENT_RELAY_BATCH_SIZE"`
+	WebhookInterval             time.Duration `mapstructure:"WEBHOOK_INTERVAL"`
+	WebhookTimeout              time.Duration `mapstructure:"WEBHOOK_TIMEOUT"`
+	WebhookBackoff              time.Duration `mapstructure:"WEBHOOK_BACKOFF"`
+	WebhookMaxAttempts          int32         `mapstructure:"WEBHOOK_MAX_ATTEMPTS"`
+	WebhookDisableAfter         int32         `mapstructure:"WEBHOOK_DISABLE_AFTER"`
+	StreamHeartbeat             time.Duration `mapstructure:"STREAM_HEARTBEAT"`
+	GraphQLMaxDepth             int           `mapstructure:"GRAPHQL_MAX_DEPTH"`
+	GraphQLMaxComplexity        int           `mapstructure:"GRAPHQL_MAX_COMPLEXITY"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -0,0 +1,14 @@
+package util
+
+// Constants for the direction of money moving in or out of the bank
+const (
+	DirectionDeposit    = "deposit"
+	DirectionWithdrawal = "withdrawal"
+)
+
+// Constants for the status of a payment handed to an external rail
+const (
+	PaymentStatusPending   = "pending"
+	PaymentStatusCompleted = "completed"
+	PaymentStatusFailed    = "failed"
+)
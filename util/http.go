@@ -0,0 +1,40 @@
+package util
+
+import (
+	"net/http"
+	"time"
+)
+
+// Defaults of the HTTP servers when the config doesn't set them
+const (
+	DefaultHTTPReadTimeout    = 10 * time.Second
+	DefaultHTTPWriteTimeout   = 30 * time.Second
+	DefaultHTTPIdleTimeout    = 2 * time.Minute
+	DefaultHTTPMaxHeaderBytes = 1 << 20
+)
+
+// NewHTTPServer creates an HTTP server for the handler with the timeouts and the header size limit of the config.
+// The read timeout bounds reading the headers too, so a client sending them slowly can't hold a connection
+func NewHTTPServer(config Config, handler http.Handler) *http.Server {
+	server := &http.Server{
+		Handler:        handler,
+		ReadTimeout:    config.HTTPReadTimeout,
+		WriteTimeout:   config.HTTPWriteTimeout,
+		IdleTimeout:    config.HTTPIdleTimeout,
+		MaxHeaderBytes: config.HTTPMaxHeaderBytes,
+	}
+
+	if server.ReadTimeout <= 0 {
+		server.ReadTimeout = DefaultHTTPReadTimeout
+	}
+	if server.WriteTimeout <= 0 {
+		server.WriteTimeout = DefaultHTTPWriteTimeout
+	}
+	if server.IdleTimeout <= 0 {
+		server.IdleTimeout = DefaultHTTPIdleTimeout
+	}
+	if server.MaxHeaderBytes <= 0 {
+		server.MaxHeaderBytes = DefaultHTTPMaxHeaderBytes
+	}
+	return server
+}
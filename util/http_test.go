@@ -0,0 +1,52 @@
+package util
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTPServer(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		want   *http.Server
+	}{
+		{
+			name: "defaults",
+			want: &http.Server{
+				ReadTimeout:    DefaultHTTPReadTimeout,
+				WriteTimeout:   DefaultHTTPWriteTimeout,
+				IdleTimeout:    DefaultHTTPIdleTimeout,
+				MaxHeaderBytes: DefaultHTTPMaxHeaderBytes,
+			},
+		},
+		{
+			name: "configured",
+			config: Config{
+				HTTPReadTimeout:    time.Second,
+				HTTPWriteTimeout:   2 * time.Second,
+				HTTPIdleTimeout:    3 * time.Second,
+				HTTPMaxHeaderBytes: 4096,
+			},
+			want: &http.Server{
+				ReadTimeout:    time.Second,
+				WriteTimeout:   2 * time.Second,
+				IdleTimeout:    3 * time.Second,
+				MaxHeaderBytes: 4096,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewHTTPServer(tt.config, http.NotFoundHandler())
+			require.NotNil(t, server.Handler)
+			require.Equal(t, tt.want.ReadTimeout, server.ReadTimeout)
+			require.Equal(t, tt.want.WriteTimeout, server.WriteTimeout)
+			require.Equal(t, tt.want.IdleTimeout, server.IdleTimeout)
+			require.Equal(t, tt.want.MaxHeaderBytes, server.MaxHeaderBytes)
+		})
+	}
+}
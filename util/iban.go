@@ -0,0 +1,79 @@
+package util
+
+// ibanLengths is the length of the IBAN of every country using it, by ISO 3166 country code
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22,
+	"BH": 22, "BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22,
+	"DK": 18, "DO": 28, "EE": 20, "EG": 29, "ES": 24, "FI": 18, "FO": 18, "FR": 27,
+	"GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27, "GT": 28, "HR": 21, "HU": 28,
+	"IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27, "JO": 30, "KW": 30, "KZ": 20,
+	"LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "MC": 27, "MD": 24,
+	"ME": 22, "MK": 19, "MR": 27, "MT": 31, "MU": 30, "NL": 18, "NO": 15, "PK": 24,
+	"PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "SA": 24, "SC": 31,
+	"SE": 24, "SI": 19, "SK": 24, "SM": 27, "ST": 25, "SV": 28, "TL": 23, "TN": 24,
+	"TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20,
+}
+
+// sepaCountries are the countries in the geographical scope of the SEPA schemes as published by the EPC,
+// by ISO 3166 country code. Many countries outside of SEPA also use IBANs
+var sepaCountries = map[string]bool{
+	"AD": true, "AT": true, "BE": true, "BG": true, "CH": true, "CY": true, "CZ": true, "DE": true,
+	"DK": true, "EE": true, "ES": true, "FI": true, "FR": true, "GB": true, "GI": true, "GR": true,
+	"HR": true, "HU": true, "IE": true, "IS": true, "IT": true, "LI": true, "LT": true, "LU": true,
+	"LV": true, "MC": true, "MT": true, "NL": true, "NO": true, "PL": true, "PT": true, "RO": true,
+	"SE": true, "SI": true, "SK": true, "SM": true, "VA": true,
+}
+
+// IsSEPAIBAN checks that an IBAN is valid and belongs to a country reachable by SEPA credit transfer
+func IsSEPAIBAN(iban string) bool {
+	return IsValidIBAN(iban) && sepaCountries[iban[:2]]
+}
+
+// IsValidIBAN checks an IBAN in electronic format, upper case without spaces: the country must
+// use IBANs, the length must match the country and the check digits must pass the mod-97 check
+func IsValidIBAN(iban string) bool {
+	if len(iban) < 4 {
+		return false
+	}
+
+	length, ok := ibanLengths[iban[:2]]
+	if !ok || len(iban) != length {
+		return false
+	}
+
+	// move the country code and check digits to the end, then convert letters to numbers (A=10 ... Z=35)
+	// and compute the remainder digit by digit so it never overflows
+	rearranged := iban[4:] + iban[:4]
+	remainder := 0
+	for _, c := range rearranged {
+		switch {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return false
+		}
+	}
+	return remainder == 1
+}
+
+// IsValidBIC checks the format of a BIC: 4 letters for the bank, 2 letters for the country,
+// 2 letters or digits for the location and an optional 3 letters or digits branch code
+func IsValidBIC(bic string) bool {
+	if len(bic) != 8 && len(bic) != 11 {
+		return false
+	}
+
+	for i, c := range bic {
+		isLetter := c >= 'A' && c <= 'Z'
+		isDigit := c >= '0' && c <= '9'
+		if i < 6 && !isLetter {
+			return false
+		}
+		if i >= 6 && !isLetter && !isDigit {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+package util
+
+import "testing"
+
+func TestIsValidIBAN(t *testing.T) {
+	tests := []struct {
+		name string
+		iban string
+		want bool
+	}{
+		{name: "germany", iban: "DE89370400440532013000", want: true},
+		{name: "france", iban: "FR1420041010050500013M02606", want: true},
+		{name: "netherlands", iban: "NL91ABNA0417164300", want: true},
+		{name: "wrong check digits", iban: "DE88370400440532013000", want: false},
+		{name: "wrong length for the country", iban: "DE8937040044053201300", want: false},
+		{name: "unknown country", iban: "XX89370400440532013000", want: false},
+		{name: "lower case", iban: "de89370400440532013000", want: false},
+		{name: "with spaces", iban: "DE89 3704 0044 0532 0130 00", want: false},
+		{name: "too short", iban: "DE8", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidIBAN(tt.iban); got != tt.want {
+				t.Errorf("IsValidIBAN(%q) = %v, want %v", tt.iban, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsSEPAIBAN(t *testing.T) {
+	tests := []struct {
+		name string
+		iban string
+		want bool
+	}{
+		{name: "germany", iban: "DE89370400440532013000", want: true},
+		{name: "switzerland", iban: "CH9300762011623852957", want: true},
+		{name: "brazil", iban: "BR1800360305000010009795493C1", want: false},
+		{name: "saudi arabia", iban: "SA0380000000608010167519", want: false},
+		{name: "wrong check digits", iban: "DE88370400440532013000", want: false},
+		{name: "too short", iban: "DE", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsSEPAIBAN(tt.iban); got != tt.want {
+				t.Errorf("IsSEPAIBAN(%q) = %v, want %v", tt.iban, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidBIC(t *testing.T) {
+	tests := []struct {
+		name string
+		bic  string
+		want bool
+	}{
+		{name: "8 characters", bic: "DEUTDEFF", want: true},
+		{name: "11 characters", bic: "DEUTDEFF500", want: true},
+		{name: "digits in location", bic: "NEDSZAJ1", want: true},
+		{name: "digit in bank code", bic: "DEU1DEFF", want: false},
+		{name: "lower case", bic: "deutdeff", want: false},
+		{name: "wrong length", bic: "DEUTDEFF5", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidBIC(tt.bic); got != tt.want {
+				t.Errorf("IsValidBIC(%q) = %v, want %v", tt.bic, got, tt.want)
+			}
+		})
+	}
+}
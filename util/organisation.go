@@ -0,0 +1,39 @@
+package util
+
+// Constants for the role of a member of an organisation
+const (
+	// OrganisationRoleAdmin manages the members and the accounts of the organisation
+	OrganisationRoleAdmin = "admin"
+	// OrganisationRoleInitiator moves money within their transfer limit
+	OrganisationRoleInitiator = "initiator"
+	// OrganisationRoleApprover moves money and approves the transfers other members made above their limit
+	OrganisationRoleApprover = "approver"
+	// OrganisationRoleViewer can only read the accounts of the organisation
+	OrganisationRoleViewer = "viewer"
+)
+
+// OrganisationPermissionApprove lets a member approve the transfers held above the limit of another member
+const OrganisationPermissionApprove = "approve"
+
+// Constants for the status of a transfer held for approval
+const (
+	TransferApprovalStatusPending  = "pending"
+	TransferApprovalStatusApproved = "approved"
+	TransferApprovalStatusRejected = "rejected"
+)
+
+// MemberCan tells if a member of an organisation with the role has the permission on its accounts
+func MemberCan(role string, permission string) bool {
+	switch permission {
+	case AccountPermissionView:
+		return role == OrganisationRoleAdmin || role == OrganisationRoleInitiator ||
+			role == OrganisationRoleApprover || role == OrganisationRoleViewer
+	case AccountPermissionTransact:
+		return role == OrganisationRoleAdmin || role == OrganisationRoleInitiator || role == OrganisationRoleApprover
+	case OrganisationPermissionApprove:
+		return role == OrganisationRoleAdmin || role == OrganisationRoleApprover
+	case AccountPermissionManage:
+		return role == OrganisationRoleAdmin
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+package util
+
+import "testing"
+
+func TestMemberCan(t *testing.T) {
+	tests := []struct {
+		role       string
+		permission string
+		want       bool
+	}{
+		{role: OrganisationRoleAdmin, permission: AccountPermissionView, want: true},
+		{role: OrganisationRoleAdmin, permission: AccountPermissionTransact, want: true},
+		{role: OrganisationRoleAdmin, permission: OrganisationPermissionApprove, want: true},
+		{role: OrganisationRoleAdmin, permission: AccountPermissionManage, want: true},
+		{role: OrganisationRoleInitiator, permission: AccountPermissionTransact, want: true},
+		{role: OrganisationRoleInitiator, permission: OrganisationPermissionApprove, want: false},
+		{role: OrganisationRoleInitiator, permission: AccountPermissionManage, want: false},
+		{role: OrganisationRoleApprover, permission: AccountPermissionTransact, want: true},
+		{role: OrganisationRoleApprover, permission: OrganisationPermissionApprove, want: true},
+		{role: OrganisationRoleApprover, permission: AccountPermissionManage, want: false},
+		{role: OrganisationRoleViewer, permission: AccountPermissionView, want: true},
+		{role: OrganisationRoleViewer, permission: AccountPermissionTransact, want: false},
+		{role: AccountRoleOwner, permission: AccountPermissionView, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.role+" "+tt.permission, func(t *testing.T) {
+			if got := MemberCan(tt.role, tt.permission); got != tt.want {
+				t.Errorf("MemberCan(%q, %q) = %v, want %v", tt.role, tt.permission, got, tt.want)
+			}
+		})
+	}
+}
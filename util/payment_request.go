@@ -0,0 +1,10 @@
+package util
+
+// Constants for the status of a payment request
+const (
+	PaymentRequestStatusPending   = "pending"
+	PaymentRequestStatusAccepted  = "accepted"
+	PaymentRequestStatusDeclined  = "declined"
+	PaymentRequestStatusCancelled = "cancelled"
+	PaymentRequestStatusExpired   = "expired"
+)
@@ -0,0 +1,12 @@
+package util
+
+// PotProgress returns how much of the goal a pot balance reaches, in percent capped at 100
+func PotProgress(balance, goal int64) int64 {
+	if goal <= 0 || balance <= 0 {
+		return 0
+	}
+	if balance >= goal {
+		return 100
+	}
+	return balance * 100 / goal
+}
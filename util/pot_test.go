@@ -0,0 +1,50 @@
+package util
+
+import "testing"
+
+func TestPotProgress(t *testing.T) {
+	tests := []struct {
+		name    string
+		balance int64
+		goal    int64
+		want    int64
+	}{
+		{
+			name:    "empty pot",
+			balance: 0,
+			goal:    10000,
+			want:    0,
+		},
+		{
+			name:    "rounded down",
+			balance: 3333,
+			goal:    10000,
+			want:    33,
+		},
+		{
+			name:    "goal reached",
+			balance: 10000,
+			goal:    10000,
+			want:    100,
+		},
+		{
+			name:    "goal exceeded",
+			balance: 25000,
+			goal:    10000,
+			want:    100,
+		},
+		{
+			name:    "no goal",
+			balance: 500,
+			goal:    0,
+			want:    0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PotProgress(tt.balance, tt.goal); got != tt.want {
+				t.Errorf("PotProgress() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
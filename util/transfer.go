@@ -0,0 +1,53 @@
+package util
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// IsValidDescription checks a free text description shown to customers: valid UTF-8 without
+// control characters such as new lines, so it renders on a single line everywhere
+func IsValidDescription(description string) bool {
+	if !utf8.ValidString(description) {
+		return false
+	}
+
+	for _, r := range description {
+		if unicode.IsControl(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// referenceCharacters is the SWIFT character set, a reference made of it survives the payment rails unchanged
+const referenceCharacters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789/-?:().,'+ "
+
+// IsValidReference checks a reference only uses the SWIFT character set and doesn't start or end with a space
+func IsValidReference(reference string) bool {
+	if strings.TrimSpace(reference) != reference {
+		return false
+	}
+
+	for _, r := range reference {
+		if !strings.ContainsRune(referenceCharacters, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsValidMetadataKey checks a metadata key is made of letters, digits, '_', '-' and '.'
+func IsValidMetadataKey(key string) bool {
+	if key == "" {
+		return false
+	}
+
+	for _, r := range key {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' || r == '.') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package util
+
+import "testing"
+
+func TestIsValidDescription(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		want        bool
+	}{
+		{name: "empty", description: "", want: true},
+		{name: "plain text", description: "Rent March 2024", want: true},
+		{name: "unicode", description: "Café crème ☕", want: true},
+		{name: "new line", description: "Rent\nMarch", want: false},
+		{name: "tab", description: "Rent\tMarch", want: false},
+		{name: "invalid utf-8", description: "Rent \xff", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidDescription(tt.description); got != tt.want {
+				t.Errorf("IsValidDescription(%q) = %v, want %v", tt.description, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidReference(t *testing.T) {
+	tests := []struct {
+		name      string
+		reference string
+		want      bool
+	}{
+		{name: "empty", reference: "", want: true},
+		{name: "invoice number", reference: "INV-2024/0042", want: true},
+		{name: "with punctuation", reference: "Order (42): paid, thanks+", want: true},
+		{name: "leading space", reference: " INV-1", want: false},
+		{name: "underscore", reference: "INV_1", want: false},
+		{name: "unicode", reference: "Rechnung-Nr.ü", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidReference(tt.reference); got != tt.want {
+				t.Errorf("IsValidReference(%q) = %v, want %v", tt.reference, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidMetadataKey(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+		want bool
+	}{
+		{name: "snake case", key: "order_id", want: true},
+		{name: "dotted", key: "shop.customer-id", want: true},
+		{name: "empty", key: "", want: false},
+		{name: "space", key: "order id", want: false},
+		{name: "unicode letter", key: "größe", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidMetadataKey(tt.key); got != tt.want {
+				t.Errorf("IsValidMetadataKey(%q) = %v, want %v", tt.key, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package util
+
+// Roles of a user, support and admin are staff roles granting access to the back office
+const (
+	UserRoleCustomer = "customer"
+	UserRoleSupport  = "support"
+	UserRoleAdmin    = "admin"
+)
@@ -0,0 +1,15 @@
+package util
+
+// Constants for the status of a webhook subscription, a subscription is disabled by its user
+// or after too many failed deliveries in a row
+const (
+	WebhookStatusActive   = "active"
+	WebhookStatusDisabled = "disabled"
+)
+
+// Constants for the status of a webhook delivery
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusSucceeded = "succeeded"
+	WebhookDeliveryStatusFailed    = "failed"
+)
@@ -0,0 +1,199 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/util"
+)
+
+const (
+	// defaultBatchSize is the number of deliveries claimed at once
+	defaultBatchSize = 50
+	// maxBackoff caps the delay between two attempts of a delivery
+	maxBackoff = 6 * time.Hour
+	// maxErrorSize is the part of the response of a receiver kept on a failed delivery
+	maxErrorSize = 512
+)
+
+// Dispatcher posts the due webhook deliveries to their subscription and schedules the failed ones again
+// with an exponential backoff, until the delivery runs out of attempts
+type Dispatcher struct {
+	store  db.Store
+	config util.Config
+	client *http.Client
+	now    func() time.Time
+	// allowed tells if the dispatcher may connect to an address, the tests allow the loopback of httptest
+	allowed func(ip net.IP) bool
+}
+
+// NewDispatcher creates a new Dispatcher. The receivers are customers' servers: the dispatcher only connects
+// to public addresses, whatever the host of the URL resolves to, and doesn't follow redirects, so that a
+// subscription can't reach the network of the bank and read the answer in its delivery log
+func NewDispatcher(store db.Store, config util.Config) *Dispatcher {
+	dispatcher := &Dispatcher{
+		store:   store,
+		config:  config,
+		now:     time.Now,
+		allowed: isPublicIP,
+	}
+
+	dialer := &net.Dialer{Timeout: config.WebhookTimeout, Control: dispatcher.checkAddress}
+	dispatcher.client = &http.Client{
+		Timeout:   config.WebhookTimeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return dispatcher
+}
+
+// checkAddress refuses to connect to an address the dispatcher isn't allowed to reach, it runs after the
+// host was resolved so a public name pointing at a private address is refused too
+func (dispatcher *Dispatcher) checkAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !dispatcher.allowed(ip) {
+		return fmt.Errorf("webhook receiver address %s is not allowed", host)
+	}
+	return nil
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, which isn't reachable from the internet either
+var sharedAddressSpace = net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// isPublicIP tells if an address can be reached from the internet: not a loopback, private,
+// link-local, shared, multicast or unspecified address
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast() && !ip.IsUnspecified() && !sharedAddressSpace.Contains(ip)
+}
+
+// Backoff is the delay before the next attempt of a delivery that failed attempts times:
+// base, then twice as long after every failure, up to maxBackoff
+func Backoff(base time.Duration, attempts int32) time.Duration {
+	delay := base
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return delay
+}
+
+// DeliverDue claims the due deliveries and attempts each of them once, it returns the number of attempts.
+// A claimed delivery is leased to this dispatcher for twice the timeout, it is attempted again after that
+// if the dispatcher stopped before recording the attempt
+func (dispatcher *Dispatcher) DeliverDue(ctx context.Context) (int, error) {
+	deliveries, err := dispatcher.store.ClaimDueWebhookDeliveries(ctx, db.ClaimDueWebhookDeliveriesParams{
+		LeasedUntil: dispatcher.now().Add(2 * dispatcher.config.WebhookTimeout),
+		PageLimit:   defaultBatchSize,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, delivery := range deliveries {
+		// the lease of a delivery that fails here expires, the rest of the batch is still attempted
+		if err := dispatcher.deliver(ctx, delivery); err != nil {
+			log.Printf("cannot deliver webhook delivery %d: %v", delivery.ID, err)
+		}
+	}
+	return len(deliveries), nil
+}
+
+func (dispatcher *Dispatcher) deliver(ctx context.Context, delivery db.WebhookDelivery) error {
+	subscription, err := dispatcher.store.GetWebhookSubscriptionByID(ctx, delivery.SubscriptionID)
+	if err != nil {
+		return err
+	}
+
+	statusCode, postErr := dispatcher.post(ctx, subscription, delivery)
+	attempts := delivery.Attempts + 1
+	arg := db.RecordWebhookAttemptTxParams{
+		DeliveryID:     delivery.ID,
+		SubscriptionID: subscription.ID,
+		Succeeded:      postErr == nil,
+		StatusCode:     int32(statusCode),
+		NextAttemptAt:  dispatcher.now().Add(Backoff(dispatcher.config.WebhookBackoff, attempts)),
+		GiveUp:         attempts >= dispatcher.config.WebhookMaxAttempts,
+		DisableAfter:   dispatcher.config.WebhookDisableAfter,
+	}
+	if postErr != nil {
+		arg.Error = postErr.Error()
+	}
+
+	result, err := dispatcher.store.RecordWebhookAttemptTx(ctx, arg)
+	if err != nil {
+		return err
+	}
+
+	if result.Subscription.Status == util.WebhookStatusDisabled && subscription.Status != util.WebhookStatusDisabled {
+		log.Printf("webhook subscription %d disabled after %d failed attempts in a row",
+			subscription.ID, result.Subscription.ConsecutiveFailures)
+	}
+	return nil
+}
+
+// post sends the delivery to the url of the subscription, any answer but a 2xx is a failure, a redirect included
+func (dispatcher *Dispatcher) post(ctx context.Context, subscription db.WebhookSubscription, delivery db.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, delivery.EventType)
+	req.Header.Set(DeliveryIDHeader, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(SignatureHeader, Sign(subscription.Secret, dispatcher.now(), delivery.Payload))
+
+	rsp, err := dispatcher.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(rsp.Body, maxErrorSize))
+		return rsp.StatusCode, fmt.Errorf("receiver answered %s: %s", rsp.Status, bytes.TrimSpace(body))
+	}
+	return rsp.StatusCode, nil
+}
+
+// Run delivers the due webhooks every interval until the context is done
+func (dispatcher *Dispatcher) Run(ctx context.Context) {
+	if dispatcher.config.WebhookInterval <= 0 {
+		log.Printf("webhook interval is not set, webhooks won't be delivered")
+		return
+	}
+
+	ticker := time.NewTicker(dispatcher.config.WebhookInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := dispatcher.DeliverDue(ctx); err != nil {
+			log.Printf("cannot deliver webhooks: %v", err)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func testDispatcher(store db.Store, now time.Time) *Dispatcher {
+	dispatcher := NewDispatcher(store, util.Config{
+		WebhookTimeout:      time.Second,
+		WebhookBackoff:      30 * time.Second,
+		WebhookMaxAttempts:  3,
+		WebhookDisableAfter: 10,
+	})
+	dispatcher.now = func() time.Time { return now }
+	dispatcher.allowed = func(net.IP) bool { return true }
+	return dispatcher
+}
+
+func TestDeliverDue(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	payload := []byte(`{"id":42,"type":"TransferPosted","payload":{"transfer_id":3}}`)
+
+	testCases := []struct {
+		name         string
+		attempts     int32
+		receiver     http.HandlerFunc
+		checkAttempt func(t *testing.T, arg db.RecordWebhookAttemptTxParams)
+	}{
+		{
+			name: "delivered",
+			receiver: func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.Equal(t, payload, body)
+				require.Equal(t, "TransferPosted", r.Header.Get(EventTypeHeader))
+				require.Equal(t, "7", r.Header.Get(DeliveryIDHeader))
+				require.NoError(t, Verify("whsec_test", r.Header.Get(SignatureHeader), body, time.Now(), time.Minute))
+				w.WriteHeader(http.StatusNoContent)
+			},
+			checkAttempt: func(t *testing.T, arg db.RecordWebhookAttemptTxParams) {
+				require.True(t, arg.Succeeded)
+				require.Equal(t, int32(http.StatusNoContent), arg.StatusCode)
+				require.Empty(t, arg.Error)
+			},
+		},
+		{
+			name: "receiver fails",
+			receiver: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "try later", http.StatusServiceUnavailable)
+			},
+			attempts: 1,
+			checkAttempt: func(t *testing.T, arg db.RecordWebhookAttemptTxParams) {
+				require.False(t, arg.Succeeded)
+				require.False(t, arg.GiveUp)
+				require.Equal(t, int32(http.StatusServiceUnavailable), arg.StatusCode)
+				require.Equal(t, "receiver answered 503 Service Unavailable: try later", arg.Error)
+				require.Equal(t, now.Add(time.Minute), arg.NextAttemptAt)
+				require.Equal(t, int32(10), arg.DisableAfter)
+			},
+		},
+		{
+			name: "redirect",
+			receiver: func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, "http://169.254.169.254/latest/meta-data/", http.StatusFound)
+			},
+			checkAttempt: func(t *testing.T, arg db.RecordWebhookAttemptTxParams) {
+				require.False(t, arg.Succeeded)
+				require.Equal(t, int32(http.StatusFound), arg.StatusCode)
+			},
+		},
+		{
+			name: "last attempt",
+			receiver: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			attempts: 2,
+			checkAttempt: func(t *testing.T, arg db.RecordWebhookAttemptTxParams) {
+				require.False(t, arg.Succeeded)
+				require.True(t, arg.GiveUp)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			receiver := httptest.NewServer(tc.receiver)
+			defer receiver.Close()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mocks.NewMockStore(ctrl)
+			store.EXPECT().
+				ClaimDueWebhookDeliveries(gomock.Any(), db.ClaimDueWebhookDeliveriesParams{LeasedUntil: now.Add(2 * time.Second), PageLimit: defaultBatchSize}).
+				Times(1).
+				Return([]db.WebhookDelivery{{ID: 7, SubscriptionID: 3, EventType: "TransferPosted", Payload: payload, Attempts: tc.attempts}}, nil)
+			store.EXPECT().
+				GetWebhookSubscriptionByID(gomock.Any(), int64(3)).
+				Times(1).
+				Return(db.WebhookSubscription{ID: 3, Url: receiver.URL, Secret: "whsec_test", Status: util.WebhookStatusActive}, nil)
+			store.EXPECT().
+				RecordWebhookAttemptTx(gomock.Any(), gomock.Any()).
+				Times(1).
+				DoAndReturn(func(_ context.Context, arg db.RecordWebhookAttemptTxParams) (db.RecordWebhookAttemptTxResult, error) {
+					require.Equal(t, int64(7), arg.DeliveryID)
+					require.Equal(t, int64(3), arg.SubscriptionID)
+					tc.checkAttempt(t, arg)
+					return db.RecordWebhookAttemptTxResult{Subscription: db.WebhookSubscription{ID: 3, Status: util.WebhookStatusActive}}, nil
+				})
+
+			attempted, err := testDispatcher(store, now).DeliverDue(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, 1, attempted)
+		})
+	}
+}
+
+func TestDeliverDueUnreachable(t *testing.T) {
+	receiver := httptest.NewServer(http.NotFoundHandler())
+	url := receiver.URL
+	receiver.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mocks.NewMockStore(ctrl)
+	store.EXPECT().
+		ClaimDueWebhookDeliveries(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return([]db.WebhookDelivery{{ID: 7, SubscriptionID: 3, Payload: []byte(`{}`)}}, nil)
+	store.EXPECT().
+		GetWebhookSubscriptionByID(gomock.Any(), int64(3)).
+		Times(1).
+		Return(db.WebhookSubscription{ID: 3, Url: url, Secret: "whsec_test"}, nil)
+	store.EXPECT().
+		RecordWebhookAttemptTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, arg db.RecordWebhookAttemptTxParams) (db.RecordWebhookAttemptTxResult, error) {
+			require.False(t, arg.Succeeded)
+			require.Zero(t, arg.StatusCode)
+			require.NotEmpty(t, arg.Error)
+			return db.RecordWebhookAttemptTxResult{}, nil
+		})
+
+	attempted, err := testDispatcher(store, time.Now()).DeliverDue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, attempted)
+}
+
+func TestDeliverDueStoreError(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mocks.NewMockStore(ctrl)
+	store.EXPECT().
+		ClaimDueWebhookDeliveries(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return([]db.WebhookDelivery{
+			{ID: 7, SubscriptionID: 3, Payload: []byte(`{}`)},
+			{ID: 8, SubscriptionID: 4, Payload: []byte(`{}`)},
+		}, nil)
+	store.EXPECT().
+		GetWebhookSubscriptionByID(gomock.Any(), int64(3)).
+		Times(1).
+		Return(db.WebhookSubscription{}, sql.ErrConnDone)
+	store.EXPECT().
+		GetWebhookSubscriptionByID(gomock.Any(), int64(4)).
+		Times(1).
+		Return(db.WebhookSubscription{ID: 4, Url: receiver.URL, Secret: "whsec_test"}, nil)
+	store.EXPECT().
+		RecordWebhookAttemptTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, arg db.RecordWebhookAttemptTxParams) (db.RecordWebhookAttemptTxResult, error) {
+			require.Equal(t, int64(8), arg.DeliveryID)
+			require.True(t, arg.Succeeded)
+			return db.RecordWebhookAttemptTxResult{}, nil
+		})
+
+	attempted, err := testDispatcher(store, time.Now()).DeliverDue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, attempted)
+}
+
+func TestDeliverDuePrivateAddress(t *testing.T) {
+	received := false
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = true
+	}))
+	defer receiver.Close()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mocks.NewMockStore(ctrl)
+	store.EXPECT().
+		ClaimDueWebhookDeliveries(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return([]db.WebhookDelivery{{ID: 7, SubscriptionID: 3, Payload: []byte(`{}`)}}, nil)
+	store.EXPECT().
+		GetWebhookSubscriptionByID(gomock.Any(), int64(3)).
+		Times(1).
+		Return(db.WebhookSubscription{ID: 3, Url: receiver.URL, Secret: "whsec_test"}, nil)
+	store.EXPECT().
+		RecordWebhookAttemptTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, arg db.RecordWebhookAttemptTxParams) (db.RecordWebhookAttemptTxResult, error) {
+			require.False(t, arg.Succeeded)
+			require.Zero(t, arg.StatusCode)
+			require.Contains(t, arg.Error, "webhook receiver address 127.0.0.1 is not allowed")
+			return db.RecordWebhookAttemptTxResult{}, nil
+		})
+
+	dispatcher := NewDispatcher(store, util.Config{WebhookTimeout: time.Second, WebhookMaxAttempts: 3})
+	attempted, err := dispatcher.DeliverDue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, attempted)
+	require.False(t, received)
+}
+
+func TestIsPublicIP(t *testing.T) {
+	testCases := []struct {
+		ip     string
+		public bool
+	}{
+		{ip: "93.184.216.34", public: true},
+		{ip: "2606:2800:220:1:248:1893:25c8:1946", public: true},
+		{ip: "127.0.0.1"},
+		{ip: "::1"},
+		{ip: "10.1.2.3"},
+		{ip: "172.16.0.1"},
+		{ip: "192.168.1.1"},
+		{ip: "169.254.169.254"},
+		{ip: "100.64.0.1"},
+		{ip: "0.0.0.0"},
+		{ip: "fd00::1"},
+		{ip: "fe80::1"},
+		{ip: "::ffff:127.0.0.1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.ip, func(t *testing.T) {
+			require.Equal(t, tc.public, isPublicIP(net.ParseIP(tc.ip)))
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/event"
+)
+
+// FanOut is the event publisher turning the events into a delivery for every subscription of the users
+// holding an account the event is about. An event relayed twice doesn't create duplicated deliveries
+type FanOut struct {
+	store db.Store
+}
+
+// NewFanOut creates a new FanOut
+func NewFanOut(store db.Store) *FanOut {
+	return &FanOut{store: store}
+}
+
+// accountRefs picks the accounts out of the payload of any event
+type accountRefs struct {
+	AccountID     int64 `json:"account_id"`
+	FromAccountID int64 `json:"from_account_id"`
+	ToAccountID   int64 `json:"to_account_id"`
+}
+
+func (fanOut *FanOut) Publish(ctx context.Context, envelope event.Envelope) error {
+	var refs accountRefs
+	if err := json.Unmarshal(envelope.Payload, &refs); err != nil {
+		return err
+	}
+
+	seen := map[int64]bool{}
+	for _, accountID := range []int64{refs.AccountID, refs.FromAccountID, refs.ToAccountID} {
+		if accountID == 0 || seen[accountID] {
+			continue
+		}
+		seen[accountID] = true
+
+		body, err := accountEnvelope(envelope, refs, accountID)
+		if err != nil {
+			return err
+		}
+
+		_, err = fanOut.store.CreateWebhookDeliveries(ctx, db.CreateWebhookDeliveriesParams{
+			EventID:   envelope.ID,
+			EventType: envelope.Type,
+			Payload:   body,
+			AccountID: accountID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// counterpartyFields are the fields of a transfer that only one side may see, by side of the transfer.
+// The metadata is shared, it is copied on the entries of both sides
+var counterpartyFields = map[string][]string{
+	"from": {"to_balance"},
+	"to":   {"from_balance"},
+}
+
+// accountEnvelope is the envelope delivered to the holders of an account. The holders of each side of
+// a transfer between two accounts don't get the balance of the other side
+func accountEnvelope(envelope event.Envelope, refs accountRefs, accountID int64) ([]byte, error) {
+	if envelope.Type != db.EventTypeTransferPosted || refs.FromAccountID == refs.ToAccountID {
+		return json.Marshal(envelope)
+	}
+
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(envelope.Payload, &payload); err != nil {
+		return nil, err
+	}
+
+	side := "from"
+	if accountID == refs.ToAccountID {
+		side = "to"
+	}
+	for _, field := range counterpartyFields[side] {
+		delete(payload, field)
+	}
+
+	var err error
+	envelope.Payload, err = json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(envelope)
+}
+
+func (fanOut *FanOut) Close() error {
+	return nil
+}
@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	db "github.com/Sinothic/simplebank/db/sqlc"
+	"github.com/Sinothic/simplebank/db/sqlc/mocks"
+	"github.com/Sinothic/simplebank/event"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFanOut(t *testing.T) {
+	transfer := `{"transfer_id":9,"from_account_id":4,"to_account_id":5,"metadata":{"order_id":"42"},"from_balance":100,"to_balance":900}`
+
+	testCases := []struct {
+		name       string
+		eventType  string
+		payload    string
+		accountIDs []int64
+		delivered  map[int64]string
+	}{
+		{
+			name:       "account created",
+			eventType:  db.EventTypeAccountCreated,
+			payload:    `{"account_id":4,"user_id":1}`,
+			accountIDs: []int64{4},
+			delivered:  map[int64]string{4: `{"account_id":4,"user_id":1}`},
+		},
+		{
+			name:       "transfer posted",
+			eventType:  db.EventTypeTransferPosted,
+			payload:    transfer,
+			accountIDs: []int64{4, 5},
+			delivered: map[int64]string{
+				4: `{"transfer_id":9,"from_account_id":4,"to_account_id":5,"metadata":{"order_id":"42"},"from_balance":100}`,
+				5: `{"transfer_id":9,"from_account_id":4,"to_account_id":5,"metadata":{"order_id":"42"},"to_balance":900}`,
+			},
+		},
+		{
+			name:       "move to a pot of the same account",
+			eventType:  db.EventTypeTransferPosted,
+			payload:    `{"transfer_id":9,"from_account_id":4,"to_account_id":4,"from_balance":100,"to_balance":100}`,
+			accountIDs: []int64{4},
+			delivered:  map[int64]string{4: `{"transfer_id":9,"from_account_id":4,"to_account_id":4,"from_balance":100,"to_balance":100}`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			envelope := event.Envelope{ID: 42, Type: tc.eventType, Version: 1, Payload: json.RawMessage(tc.payload)}
+
+			store := mocks.NewMockStore(ctrl)
+			var accountIDs []int64
+			store.EXPECT().
+				CreateWebhookDeliveries(gomock.Any(), gomock.Any()).
+				Times(len(tc.accountIDs)).
+				DoAndReturn(func(_ context.Context, arg db.CreateWebhookDeliveriesParams) ([]db.WebhookDelivery, error) {
+					require.Equal(t, int64(42), arg.EventID)
+					require.Equal(t, tc.eventType, arg.EventType)
+
+					var delivered event.Envelope
+					require.NoError(t, json.Unmarshal(arg.Payload, &delivered))
+					require.Equal(t, envelope.ID, delivered.ID)
+					require.JSONEq(t, tc.delivered[arg.AccountID], string(delivered.Payload))
+
+					accountIDs = append(accountIDs, arg.AccountID)
+					return nil, nil
+				})
+
+			require.NoError(t, NewFanOut(store).Publish(context.Background(), envelope))
+			require.Equal(t, tc.accountIDs, accountIDs)
+		})
+	}
+}
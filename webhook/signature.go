@@ -0,0 +1,90 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers of a delivery, the receivers check SignatureHeader before trusting the body
+const (
+	SignatureHeader  = "Simplebank-Signature"
+	EventTypeHeader  = "Simplebank-Event-Type"
+	DeliveryIDHeader = "Simplebank-Delivery-ID"
+)
+
+const secretPrefix = "whsec_"
+
+var (
+	ErrInvalidSignatureHeader = errors.New("invalid signature header")
+	ErrSignatureMismatch      = errors.New("signature doesn't match the body")
+	ErrSignatureExpired       = errors.New("signature timestamp is outside the tolerance")
+)
+
+// NewSecret generates the secret a subscription signs its deliveries with
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return secretPrefix + hex.EncodeToString(b), nil
+}
+
+// Sign computes the signature header of a body sent at timestamp: t=<unix seconds>,v1=<hex HMAC-SHA256>,
+// the HMAC covers the timestamp and the body joined with a dot so an old delivery can't be replayed as new
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", t, hex.EncodeToString(mac(secret, t, body)))
+}
+
+// Verify checks a signature header was computed with the secret over the body less than tolerance ago
+func Verify(secret string, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var t, v1 string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return ErrInvalidSignatureHeader
+		}
+
+		switch key {
+		case "t":
+			t = value
+		case "v1":
+			v1 = value
+		}
+	}
+
+	timestamp, err := strconv.ParseInt(t, 10, 64)
+	if err != nil || v1 == "" {
+		return ErrInvalidSignatureHeader
+	}
+
+	signature, err := hex.DecodeString(v1)
+	if err != nil {
+		return ErrInvalidSignatureHeader
+	}
+
+	if !hmac.Equal(signature, mac(secret, t, body)) {
+		return ErrSignatureMismatch
+	}
+
+	age := now.Sub(time.Unix(timestamp, 0))
+	if age > tolerance || age < -tolerance {
+		return ErrSignatureExpired
+	}
+	return nil
+}
+
+func mac(secret string, timestamp string, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(timestamp))
+	h.Write([]byte("."))
+	h.Write(body)
+	return h.Sum(nil)
+}
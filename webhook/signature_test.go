@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"id":1,"type":"TransferPosted"}`)
+	now := time.Unix(1709287200, 0)
+
+	header := Sign("whsec_test", now, body)
+	require.True(t, strings.HasPrefix(header, "t=1709287200,v1="))
+	require.Equal(t, header, Sign("whsec_test", now, body))
+
+	testCases := []struct {
+		name   string
+		secret string
+		header string
+		body   []byte
+		now    time.Time
+		err    error
+	}{
+		{name: "OK", secret: "whsec_test", header: header, body: body, now: now.Add(time.Minute)},
+		{name: "other secret", secret: "whsec_other", header: header, body: body, now: now, err: ErrSignatureMismatch},
+		{name: "other body", secret: "whsec_test", header: header, body: []byte(`{"id":2}`), now: now, err: ErrSignatureMismatch},
+		{name: "other timestamp", secret: "whsec_test", header: strings.Replace(header, "t=1709287200", "t=1709287201", 1), body: body, now: now, err: ErrSignatureMismatch},
+		{name: "expired", secret: "whsec_test", header: header, body: body, now: now.Add(10 * time.Minute), err: ErrSignatureExpired},
+		{name: "missing signature", secret: "whsec_test", header: "t=1709287200", body: body, now: now, err: ErrInvalidSignatureHeader},
+		{name: "malformed", secret: "whsec_test", header: "garbage", body: body, now: now, err: ErrInvalidSignatureHeader},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Verify(tc.secret, tc.header, tc.body, tc.now, 5*time.Minute)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestNewSecret(t *testing.T) {
+	secret, err := NewSecret()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(secret, "whsec_"))
+	require.Len(t, secret, len("whsec_")+64)
+
+	other, err := NewSecret()
+	require.NoError(t, err)
+	require.NotEqual(t, secret, other)
+}
+
+func TestBackoff(t *testing.T) {
+	require.Equal(t, 30*time.Second, Backoff(30*time.Second, 1))
+	require.Equal(t, time.Minute, Backoff(30*time.Second, 2))
+	require.Equal(t, 4*time.Minute, Backoff(30*time.Second, 4))
+	require.Equal(t, maxBackoff, Backoff(30*time.Second, 20))
+}